@@ -10,8 +10,8 @@ import (
 
 var evacuateShardCmd = &cobra.Command{
 	Use:   "evacuate",
-	Short: "Evacuate objects from shard",
-	Long:  "Evacuate objects from shard to other shards",
+	Short: "Evacuate objects and trees from shard",
+	Long:  "Evacuate objects and trees from shard to other shards",
 	Run:   evacuateShard,
 }
 
@@ -35,6 +35,7 @@ func evacuateShard(cmd *cobra.Command, _ []string) {
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
 	cmd.Printf("Objects moved: %d\n", resp.GetBody().GetCount())
+	cmd.Printf("Trees moved: %d\n", resp.GetBody().GetTreesCount())
 
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
@@ -0,0 +1,183 @@
+package control
+
+import (
+	"time"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+var evacuationCmd = &cobra.Command{
+	Use:   "evacuation",
+	Short: "Background evacuation of objects and trees from shards",
+	Long:  "Background evacuation of objects and trees from shards to other shards",
+}
+
+var startEvacuationCmd = &cobra.Command{
+	Use:   "start",
+	Short: "Start evacuation",
+	Long:  "Start background evacuation of objects and trees from shards to other shards",
+	Run:   startEvacuation,
+}
+
+var stopEvacuationCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop evacuation",
+	Long:  "Stop running background evacuation, it can be resumed later",
+	Run:   stopEvacuation,
+}
+
+var resumeEvacuationCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume evacuation",
+	Long:  "Resume stopped or failed background evacuation",
+	Run:   resumeEvacuation,
+}
+
+var evacuationStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get evacuation status",
+	Long:  "Get status and progress of the background evacuation",
+	Run:   evacuationStatus,
+}
+
+func startEvacuation(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StartShardEvacuationRequest{Body: new(control.StartShardEvacuationRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StartShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StartShardEvacuation(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Evacuation %s has been started.\n", resp.GetBody().GetId())
+}
+
+func stopEvacuation(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.StopShardEvacuationRequest{Body: new(control.StopShardEvacuationRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.StopShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.StopShardEvacuation(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Evacuation has been stopped.")
+}
+
+func resumeEvacuation(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.ResumeShardEvacuationRequest{Body: new(control.ResumeShardEvacuationRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ResumeShardEvacuationResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.ResumeShardEvacuation(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Evacuation %s has been resumed.\n", resp.GetBody().GetId())
+}
+
+func evacuationStatus(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.GetShardEvacuationStatusRequest{Body: new(control.GetShardEvacuationStatusRequest_Body)}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.GetShardEvacuationStatusResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.GetShardEvacuationStatus(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	body := resp.GetBody()
+
+	cmd.Printf("ID: %s\n", body.GetId())
+	cmd.Printf("Status: %s\n", body.GetStatus())
+	cmd.Println("Shards:")
+	for _, id := range body.GetShard_ID() {
+		cmd.Printf("\t%s\n", base58.Encode(id))
+	}
+
+	cmd.Printf("Objects evacuated: %d\n", body.GetEvacuated())
+	cmd.Printf("Objects failed: %d\n", body.GetFailed())
+	cmd.Printf("Objects remaining: %d\n", evacuationRemaining(body.GetTotal(), body.GetEvacuated(), body.GetFailed()))
+	cmd.Printf("Trees evacuated: %d\n", body.GetTreesEvacuated())
+	cmd.Printf("Trees failed: %d\n", body.GetTreesFailed())
+	cmd.Printf("Trees remaining: %d\n", evacuationRemaining(body.GetTreesTotal(), body.GetTreesEvacuated(), body.GetTreesFailed()))
+	cmd.Printf("Started at: %s\n", time.Unix(body.GetStartedAt(), 0).Format(time.RFC3339))
+	if body.GetFinishedAt() != 0 {
+		cmd.Printf("Finished at: %s\n", time.Unix(body.GetFinishedAt(), 0).Format(time.RFC3339))
+	}
+	if msg := body.GetErrorMessage(); msg != "" {
+		cmd.Printf("Error: %s\n", msg)
+	}
+}
+
+func evacuationRemaining(total, evacuated, failed uint64) uint64 {
+	if done := evacuated + failed; done < total {
+		return total - done
+	}
+	return 0
+}
+
+func initControlEvacuationCmd() {
+	evacuationCmd.AddCommand(startEvacuationCmd)
+	evacuationCmd.AddCommand(stopEvacuationCmd)
+	evacuationCmd.AddCommand(resumeEvacuationCmd)
+	evacuationCmd.AddCommand(evacuationStatusCmd)
+
+	initControlFlags(startEvacuationCmd)
+	initControlFlags(stopEvacuationCmd)
+	initControlFlags(resumeEvacuationCmd)
+	initControlFlags(evacuationStatusCmd)
+
+	flags := startEvacuationCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(shardAllFlag, false, "Process all shards")
+	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+
+	startEvacuationCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+}
@@ -0,0 +1,122 @@
+package control
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/spf13/cobra"
+)
+
+var listContainerQuotasCmd = &cobra.Command{
+	Use:   "quotas",
+	Short: "List container quotas",
+	Long:  "List quota usage of the containers stored on the storage node",
+	Run:   listContainerQuotas,
+}
+
+func initControlListContainerQuotasCmd() {
+	initControlFlags(listContainerQuotasCmd)
+
+	flags := listContainerQuotasCmd.Flags()
+	flags.StringSlice(commonflags.CIDFlag, nil, "Container IDs in base58 encoding, all known containers if empty")
+	flags.Bool(commonflags.JSON, false, "Print quotas as a JSON array")
+}
+
+func listContainerQuotas(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	strIDs, _ := cmd.Flags().GetStringSlice(commonflags.CIDFlag)
+	rawIDs := make([][]byte, 0, len(strIDs))
+
+	for i := range strIDs {
+		var cnr cid.ID
+		common.ExitOnErr(cmd, "can't decode container ID: %w", cnr.DecodeString(strIDs[i]))
+
+		rawCID := make([]byte, sha256.Size)
+		cnr.Encode(rawCID)
+
+		rawIDs = append(rawIDs, rawCID)
+	}
+
+	req := &control.ListContainerQuotasRequest{
+		Body: &control.ListContainerQuotasRequest_Body{
+			Container_ID: rawIDs,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ListContainerQuotasResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ListContainerQuotas(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintQuotasJSON(cmd, resp.GetBody().GetQuotas())
+	} else {
+		prettyPrintQuotas(cmd, resp.GetBody().GetQuotas())
+	}
+}
+
+func quotaContainerID(q *control.ContainerQuota) string {
+	var cnr cid.ID
+	if err := cnr.Decode(q.GetContainer_ID()); err != nil {
+		return "<invalid>"
+	}
+
+	return cnr.EncodeToString()
+}
+
+func prettyPrintQuotasJSON(cmd *cobra.Command, qq []*control.ContainerQuota) {
+	out := make([]map[string]interface{}, 0, len(qq))
+	for _, q := range qq {
+		out = append(out, map[string]interface{}{
+			"container_id": quotaContainerID(q),
+			"size":         q.GetSize(),
+			"soft_limit":   q.GetSoftLimit(),
+			"hard_limit":   q.GetHardLimit(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode quotas to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintQuotas(cmd *cobra.Command, qq []*control.ContainerQuota) {
+	limitToString := func(v uint64) string {
+		if v == 0 {
+			return "unlimited"
+		}
+
+		return fmt.Sprintf("%d B", v)
+	}
+
+	for _, q := range qq {
+		cmd.Printf("Container %s:\nSize: %d B\nSoft limit: %s\nHard limit: %s\n",
+			quotaContainerID(q),
+			q.GetSize(),
+			limitToString(q.GetSoftLimit()),
+			limitToString(q.GetHardLimit()),
+		)
+	}
+}
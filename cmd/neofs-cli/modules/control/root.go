@@ -33,6 +33,8 @@ func init() {
 		dropObjectsCmd,
 		shardsCmd,
 		synchronizeTreeCmd,
+		listContainerQuotasCmd,
+		listOwnerUsageCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -40,4 +42,6 @@ func init() {
 	initControlDropObjectsCmd()
 	initControlShardsCmd()
 	initControlSynchronizeTreeCmd()
+	initControlListContainerQuotasCmd()
+	initControlListOwnerUsageCmd()
 }
@@ -17,6 +17,10 @@ func initControlShardsCmd() {
 	shardsCmd.AddCommand(restoreShardCmd)
 	shardsCmd.AddCommand(evacuateShardCmd)
 	shardsCmd.AddCommand(flushCacheCmd)
+	shardsCmd.AddCommand(evacuationCmd)
+	shardsCmd.AddCommand(addShardCmd)
+	shardsCmd.AddCommand(removeShardCmd)
+	shardsCmd.AddCommand(setShardIOLimitsCmd)
 
 	initControlShardsListCmd()
 	initControlSetShardModeCmd()
@@ -24,4 +28,8 @@ func initControlShardsCmd() {
 	initControlRestoreShardCmd()
 	initControlEvacuateShardCmd()
 	initControlFlushCacheCmd()
+	initControlEvacuationCmd()
+	initControlAddShardCmd()
+	initControlRemoveShardCmd()
+	initControlSetShardIOLimitsCmd()
 }
@@ -0,0 +1,58 @@
+package control
+
+import (
+	"os"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const addShardConfigFlag = "config"
+
+var addShardCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Attach a new shard",
+	Long: "Attach a new shard to the running storage node. The shard configuration file " +
+		"in YAML or JSON format has the same structure as a single shard section " +
+		"of the storage node configuration.",
+	Run: addShard,
+}
+
+func addShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	p, _ := cmd.Flags().GetString(addShardConfigFlag)
+	data, err := os.ReadFile(p)
+	common.ExitOnErr(cmd, "can't read shard configuration: %w", err)
+
+	req := &control.AddShardRequest{Body: new(control.AddShardRequest_Body)}
+	req.Body.Config = data
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.AddShardResponse
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.AddShard(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Printf("Shard %s has been attached.\n", base58.Encode(resp.GetBody().GetShard_ID()))
+}
+
+func initControlAddShardCmd() {
+	initControlFlags(addShardCmd)
+
+	flags := addShardCmd.Flags()
+	flags.String(addShardConfigFlag, "", "Path to the shard configuration file")
+
+	_ = addShardCmd.MarkFlagRequired(addShardConfigFlag)
+}
@@ -11,12 +11,14 @@ import (
 const (
 	dumpFilepathFlag     = "path"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpSinceFlag        = "since"
+	dumpTruncateFlag     = "truncate-changes"
 )
 
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
+	Long:  "Dump objects from shard to a file, read-write shard is dumped as of the moment of the request",
 	Run:   dumpShard,
 }
 
@@ -32,6 +34,12 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	since, _ := cmd.Flags().GetUint64(dumpSinceFlag)
+	body.SetSince(since)
+
+	truncate, _ := cmd.Flags().GetBool(dumpTruncateFlag)
+	body.SetTruncateChanges(truncate)
+
 	req := new(control.DumpShardRequest)
 	req.SetBody(body)
 
@@ -50,6 +58,8 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
 
 	cmd.Println("Shard has been dumped successfully.")
+	cmd.Printf("Objects written: %d\n", resp.GetBody().GetCount())
+	cmd.Printf("Cursor: %d\n", resp.GetBody().GetCursor())
 }
 
 func initControlDumpShardCmd() {
@@ -59,6 +69,8 @@ func initControlDumpShardCmd() {
 	flags.String(shardIDFlag, "", "Shard ID in base58 encoding")
 	flags.String(dumpFilepathFlag, "", "File to write objects to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Uint64(dumpSinceFlag, 0, "Cursor of the previous dump, only changes made after it are written")
+	flags.Bool(dumpTruncateFlag, false, "Remove dumped changes from the change log, next incremental dumps must start from this dump")
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/mr-tron/base58"
 	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
@@ -67,6 +68,11 @@ func prettyPrintShardsJSON(cmd *cobra.Command, ii []*control.ShardInfo) {
 			"blobstor":    i.GetBlobstor(),
 			"writecache":  i.GetWritecachePath(),
 			"error_count": i.GetErrorCount(),
+			"free_space":  i.GetFreeSpace(),
+			"capacity":    i.GetCapacity(),
+			"fill_ratio":  shardFillRatio(i),
+			"io_limits":   ioLimitsToJSON(i.GetIoLimits()),
+			"scrub":       scrubStatusToJSON(i.GetScrub()),
 		})
 	}
 
@@ -100,13 +106,79 @@ func prettyPrintShards(cmd *cobra.Command, ii []*control.ShardInfo) {
 			sb.String()+
 			pathPrinter("Write-cache", i.GetWritecachePath())+
 			pathPrinter("Pilorama", i.GetPiloramaPath())+
-			fmt.Sprintf("Error count: %d\n", i.GetErrorCount()),
+			fmt.Sprintf("Error count: %d\n", i.GetErrorCount())+
+			"Free space: %d KiB\nCapacity: %d KiB\nFill ratio: %.2f%%\n"+
+			ioLimitsToString(i.GetIoLimits())+
+			scrubStatusToString(i.GetScrub()),
 			base58.Encode(i.Shard_ID),
 			shardModeToString(i.GetMode()),
+			i.GetFreeSpace(),
+			i.GetCapacity(),
+			shardFillRatio(i)*100,
 		)
 	}
 }
 
+func ioLimitsToJSON(ll []*control.IOLimit) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(ll))
+	for _, l := range ll {
+		res = append(res, map[string]interface{}{
+			"class":         ioClassToString(l.GetClass()),
+			"ops_per_sec":   l.GetOpsPerSec(),
+			"bytes_per_sec": l.GetBytesPerSec(),
+		})
+	}
+	return res
+}
+
+func ioLimitsToString(ll []*control.IOLimit) string {
+	var sb strings.Builder
+	sb.WriteString("I/O limits:\n")
+
+	for _, l := range ll {
+		ops, bytes := "unlimited", "unlimited"
+		if v := l.GetOpsPerSec(); v != 0 {
+			ops = fmt.Sprintf("%d ops/s", v)
+		}
+		if v := l.GetBytesPerSec(); v != 0 {
+			bytes = fmt.Sprintf("%d B/s", v)
+		}
+
+		sb.WriteString(fmt.Sprintf("\t%s: %s, %s\n", ioClassToString(l.GetClass()), ops, bytes))
+	}
+
+	return sb.String()
+}
+
+func scrubStatusToJSON(st *control.ScrubStatus) map[string]interface{} {
+	return map[string]interface{}{
+		"checked":               st.GetChecked(),
+		"corrupted":             st.GetCorrupted(),
+		"passes":                st.GetPasses(),
+		"last_pass_finished_at": st.GetLastPassFinishedAt(),
+	}
+}
+
+func scrubStatusToString(st *control.ScrubStatus) string {
+	last := "never"
+	if v := st.GetLastPassFinishedAt(); v != 0 {
+		last = time.Unix(v, 0).UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("Scrubbing:\n\tChecked: %d\n\tCorrupted: %d\n\tPasses: %d\n\tLast pass finished: %s\n",
+		st.GetChecked(), st.GetCorrupted(), st.GetPasses(), last)
+}
+
+// shardFillRatio returns the used part of the shard's disk space.
+func shardFillRatio(i *control.ShardInfo) float64 {
+	capacity, free := i.GetCapacity(), i.GetFreeSpace()
+	if capacity == 0 || free >= capacity {
+		return 0
+	}
+
+	return float64(capacity-free) / float64(capacity)
+}
+
 func shardModeToString(m control.ShardMode) string {
 	strMode, ok := lookUpShardModeString(m)
 	if ok {
@@ -0,0 +1,63 @@
+package control
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const removeShardEvacuateFlag = "evacuate"
+
+var removeShardCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Detach shards",
+	Long: "Detach shards from the running storage node. Shard data is left on the disk, " +
+		"it can be moved to the other shards before the detachment with --" + removeShardEvacuateFlag + " flag. " +
+		"The evacuation is run in background, the shards are detached once it is completed. " +
+		"If the evacuation fails or is stopped, the shards are left attached in their previous modes.",
+	Run: removeShard,
+}
+
+func removeShard(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	req := &control.RemoveShardRequest{Body: new(control.RemoveShardRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.Evacuate, _ = cmd.Flags().GetBool(removeShardEvacuateFlag)
+	req.Body.IgnoreErrors, _ = cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.RemoveShardResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.RemoveShard(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	if req.Body.Evacuate {
+		cmd.Println("Shards evacuation has been started, the shards will be detached once it is completed.")
+		cmd.Println("Use `neofs-cli control shards evacuation status` to check the progress.")
+		return
+	}
+
+	cmd.Println("Shards have been detached.")
+}
+
+func initControlRemoveShardCmd() {
+	initControlFlags(removeShardCmd)
+
+	flags := removeShardCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(removeShardEvacuateFlag, false, "Evacuate objects and trees to other shards before the detachment")
+	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects during the evacuation")
+
+	_ = removeShardCmd.MarkFlagRequired(shardIDFlag)
+}
@@ -0,0 +1,99 @@
+package control
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/spf13/cobra"
+)
+
+const (
+	ioClassFlag = "class"
+	ioOpsFlag   = "ops"
+	ioBytesFlag = "bytes"
+)
+
+// maps string command input to control.IOClass.
+var mIOClasses = map[string]control.IOClass{
+	"all":          control.IOClass_IO_CLASS_ALL,
+	"client-read":  control.IOClass_CLIENT_READ,
+	"client-write": control.IOClass_CLIENT_WRITE,
+	"replication":  control.IOClass_REPLICATION,
+	"background":   control.IOClass_BACKGROUND,
+}
+
+var setShardIOLimitsCmd = &cobra.Command{
+	Use:   "set-limits",
+	Short: "Set I/O rate limits of the shards",
+	Long: "Set I/O rate limits of the operation class of the shards. When the limit of all the operations " +
+		"is reached, operations are served in the order of the class priority: client reads, client writes, " +
+		"replication, background. Zero value disables the limit.",
+	Run: setShardIOLimits,
+}
+
+func initControlSetShardIOLimitsCmd() {
+	initControlFlags(setShardIOLimitsCmd)
+
+	classes := make([]string, 0, len(mIOClasses))
+	for c := range mIOClasses {
+		classes = append(classes, "'"+c+"'")
+	}
+	sort.Strings(classes)
+
+	flags := setShardIOLimitsCmd.Flags()
+	flags.StringSlice(shardIDFlag, nil, "List of shard IDs in base58 encoding")
+	flags.Bool(shardAllFlag, false, "Process all shards")
+	flags.String(ioClassFlag, "all", fmt.Sprintf("Class of the limited operations (%s)", strings.Join(classes, ", ")))
+	flags.Uint64(ioOpsFlag, 0, "Maximum number of operations per second")
+	flags.Uint64(ioBytesFlag, 0, "Maximum number of bytes per second")
+
+	setShardIOLimitsCmd.MarkFlagsMutuallyExclusive(shardIDFlag, shardAllFlag)
+}
+
+func setShardIOLimits(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	strClass, _ := cmd.Flags().GetString(ioClassFlag)
+	class, ok := mIOClasses[strClass]
+	if !ok {
+		common.ExitOnErr(cmd, "", fmt.Errorf("unsupported I/O class %s", strClass))
+	}
+
+	l := &control.IOLimit{Class: class}
+	l.OpsPerSec, _ = cmd.Flags().GetUint64(ioOpsFlag)
+	l.BytesPerSec, _ = cmd.Flags().GetUint64(ioBytesFlag)
+
+	req := &control.SetShardIOLimitsRequest{Body: new(control.SetShardIOLimitsRequest_Body)}
+	req.Body.Shard_ID = getShardIDList(cmd)
+	req.Body.Limits = []*control.IOLimit{l}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.SetShardIOLimitsResponse
+	var err error
+	err = cli.ExecRaw(func(client *client.Client) error {
+		resp, err = control.SetShardIOLimits(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	cmd.Println("Shard I/O limits have been updated.")
+}
+
+func ioClassToString(c control.IOClass) string {
+	for str, v := range mIOClasses {
+		if v == c {
+			return str
+		}
+	}
+	return "unknown"
+}
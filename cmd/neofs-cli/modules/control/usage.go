@@ -0,0 +1,111 @@
+package control
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"github.com/spf13/cobra"
+)
+
+const usageLimitFlag = "limit"
+
+var listOwnerUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "List storage usage of the object owners",
+	Long:  "List the object owners using the most of the storage node space",
+	Run:   listOwnerUsage,
+}
+
+func initControlListOwnerUsageCmd() {
+	initControlFlags(listOwnerUsageCmd)
+
+	flags := listOwnerUsageCmd.Flags()
+	flags.Uint32(usageLimitFlag, 10, "Maximum number of the owners to list, 0 means no limit")
+	flags.Bool(commonflags.JSON, false, "Print usage as a JSON array")
+}
+
+func listOwnerUsage(cmd *cobra.Command, _ []string) {
+	pk := key.Get(cmd)
+
+	limit, _ := cmd.Flags().GetUint32(usageLimitFlag)
+
+	req := &control.ListOwnerUsageRequest{
+		Body: &control.ListOwnerUsageRequest_Body{
+			Limit: limit,
+		},
+	}
+
+	signRequest(cmd, pk, req)
+
+	cli := getClient(cmd, pk)
+
+	var resp *control.ListOwnerUsageResponse
+	var err error
+	err = cli.ExecRaw(func(client *rawclient.Client) error {
+		resp, err = control.ListOwnerUsage(client, req)
+		return err
+	})
+	common.ExitOnErr(cmd, "rpc error: %w", err)
+
+	verifyResponse(cmd, resp.GetSignature(), resp.GetBody())
+
+	isJSON, _ := cmd.Flags().GetBool(commonflags.JSON)
+	if isJSON {
+		prettyPrintOwnerUsageJSON(cmd, resp.GetBody().GetUsage())
+	} else {
+		prettyPrintOwnerUsage(cmd, resp.GetBody().GetUsage())
+	}
+}
+
+func usageOwnerID(u *control.OwnerUsage) string {
+	var (
+		m     refs.OwnerID
+		owner user.ID
+	)
+
+	m.SetValue(u.GetOwner_ID())
+	if err := owner.ReadFromV2(m); err != nil {
+		return "<invalid>"
+	}
+
+	return owner.EncodeToString()
+}
+
+func prettyPrintOwnerUsageJSON(cmd *cobra.Command, uu []*control.OwnerUsage) {
+	out := make([]map[string]interface{}, 0, len(uu))
+	for _, u := range uu {
+		out = append(out, map[string]interface{}{
+			"owner_id":         usageOwnerID(u),
+			"logical_objects":  u.GetLogicalObjects(),
+			"logical_size":     u.GetLogicalSize(),
+			"physical_objects": u.GetPhysicalObjects(),
+			"physical_size":    u.GetPhysicalSize(),
+		})
+	}
+
+	buf := bytes.NewBuffer(nil)
+	enc := json.NewEncoder(buf)
+	enc.SetIndent("", "  ")
+	common.ExitOnErr(cmd, "cannot encode usage to JSON: %w", enc.Encode(out))
+
+	cmd.Print(buf.String()) // pretty printer emits newline, to no need for Println
+}
+
+func prettyPrintOwnerUsage(cmd *cobra.Command, uu []*control.OwnerUsage) {
+	for _, u := range uu {
+		cmd.Printf("Owner %s:\nLogical: %d objects, %d B\nPhysical: %d objects, %d B\n",
+			usageOwnerID(u),
+			u.GetLogicalObjects(),
+			u.GetLogicalSize(),
+			u.GetPhysicalObjects(),
+			u.GetPhysicalSize(),
+		)
+	}
+}
@@ -1,20 +1,29 @@
 package object
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/common"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/commonflags"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/key"
+	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/spf13/cobra"
 )
 
+const (
+	searchLimitFlag  = "limit"
+	searchCursorFlag = "cursor"
+)
+
 var (
 	searchFilters []string
 
@@ -41,6 +50,9 @@ func initObjectSearchCmd() {
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(commonflags.OIDFlag, "", "Search object by identifier")
+
+	flags.Uint32(searchLimitFlag, 0, "Maximum number of objects to return, 0 means no limit")
+	flags.String(searchCursorFlag, "", "Identifier of the last object of the previous page")
 }
 
 func searchObject(cmd *cobra.Command, _ []string) {
@@ -61,15 +73,66 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	prm.SetContainerID(cnr)
 	prm.SetFilters(sf)
 
+	limit, _ := cmd.Flags().GetUint32(searchLimitFlag)
+	cursor, _ := cmd.Flags().GetString(searchCursorFlag)
+
+	xs := parseXHeaders(cmd)
+
+	if cursor != "" {
+		var id oidSDK.ID
+		common.ExitOnErr(cmd, "invalid cursor: %w", id.DecodeString(cursor))
+
+		xs = append(xs, searchsvc.XHeaderCursor, cursor)
+	}
+
+	if limit > 0 {
+		xs = append(xs, searchsvc.XHeaderLimit, strconv.FormatUint(uint64(limit), 10))
+	}
+
+	prm.SetXHeaders(xs)
+
 	res, err := internalclient.SearchObjects(prm)
 	common.ExitOnErr(cmd, "rpc error: %w", err)
 
 	ids := res.IDList()
 
+	if limit > 0 {
+		// each container node returns the page of its own objects,
+		// so the pages are merged here
+		ids = mergeSearchPages(ids, limit)
+	}
+
 	cmd.Printf("Found %d objects.\n", len(ids))
 	for i := range ids {
 		cmd.Println(ids[i].String())
 	}
+
+	if limit > 0 && len(ids) == int(limit) {
+		cmd.Printf("Next cursor: %s\n", ids[len(ids)-1])
+	}
+}
+
+// mergeSearchPages sorts the identifiers, removes the duplicates and
+// cuts the result to the limit.
+func mergeSearchPages(ids []oidSDK.ID, limit uint32) []oidSDK.ID {
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+
+	res := ids[:0]
+
+	for i := range ids {
+		if len(res) > 0 && ids[i] == res[len(res)-1] {
+			continue
+		}
+
+		res = append(res, ids[i])
+		if len(res) == int(limit) {
+			break
+		}
+	}
+
+	return res
 }
 
 var searchUnaryOpVocabulary = map[string]object.SearchMatchType{
@@ -0,0 +1,38 @@
+package meta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+var migrateCMD = &cobra.Command{
+	Use:   "migrate",
+	Short: "Schema migration",
+	Long: `Upgrade a metabase schema to the current version in place.
+Storage node must be stopped. Interrupted migration can be continued by running the command again.`,
+	Run: migrateFunc,
+}
+
+func init() {
+	common.AddComponentPathFlag(migrateCMD, &vPath)
+}
+
+func migrateFunc(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, false)
+	defer db.Close()
+
+	from, err := db.Version()
+	common.ExitOnErr(cmd, common.Errf("could not read metabase version: %w", err))
+
+	common.ExitOnErr(cmd, common.Errf("could not migrate metabase: %w", db.Migrate()))
+
+	to, err := db.Version()
+	common.ExitOnErr(cmd, common.Errf("could not read metabase version: %w", err))
+
+	if from == to {
+		cmd.Printf("Metabase is up to date, version: %d\n", to)
+		return
+	}
+
+	cmd.Printf("Metabase has been migrated from version %d to %d\n", from, to)
+}
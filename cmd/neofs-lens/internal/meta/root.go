@@ -31,19 +31,20 @@ func init() {
 		inspectCMD,
 		listGraveyardCMD,
 		listGarbageCMD,
+		migrateCMD,
 	)
 }
 
-func openMeta(cmd *cobra.Command) *meta.DB {
+func openMeta(cmd *cobra.Command, readOnly bool) *meta.DB {
 	db := meta.New(
 		meta.WithPath(vPath),
 		meta.WithBoltDBOptions(&bbolt.Options{
-			ReadOnly: true,
+			ReadOnly: readOnly,
 			Timeout:  100 * time.Millisecond,
 		}),
 		meta.WithEpochState(epochState{}),
 	)
-	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(true)))
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open(readOnly)))
 
 	return db
 }
@@ -25,6 +25,7 @@ import (
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
 	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
+	limitsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/limits"
 	loggerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/logger"
 	metricsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/metrics"
 	nodeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/node"
@@ -39,6 +40,7 @@ import (
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	shardmode "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/metrics"
@@ -51,6 +53,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/network/cache"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/quota"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone"
 	tsourse "github.com/nspcc-dev/neofs-node/pkg/services/object_manager/tombstone/source"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
@@ -61,6 +64,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
@@ -96,22 +100,29 @@ type applicationConfiguration struct {
 	EngineCfg struct {
 		errorThreshold uint32
 		shardPoolSize  uint32
+		highWatermark  uint32
+		evacuationPath string
+		indexes        map[cid.ID][]meta.AttributeIndex
 		shards         []shardCfg
 	}
 }
 
 type shardCfg struct {
 	compress                  bool
+	estimateCompressibility   bool
+	estimateCompressibilityTh float64
 	smallSizeObjectLimit      uint64
+	dedupMinSize              uint64
 	uncompressableContentType []string
 	refillMetabase            bool
 	mode                      shardmode.Mode
 
 	metaCfg struct {
-		path          string
-		perm          fs.FileMode
-		maxBatchSize  int
-		maxBatchDelay time.Duration
+		path           string
+		perm           fs.FileMode
+		maxBatchSize   int
+		maxBatchDelay  time.Duration
+		changeLogLimit int
 	}
 
 	subStorages []subStorageCfg
@@ -121,6 +132,27 @@ type shardCfg struct {
 		removerSleepInterval time.Duration
 	}
 
+	compactionCfg struct {
+		interval           time.Duration
+		deadSpaceThreshold float64
+		rateLimit          uint64
+	}
+
+	scrubCfg struct {
+		interval       time.Duration
+		rateLimit      uint64
+		quarantinePath string
+	}
+
+	tieringCfg struct {
+		interval   time.Duration
+		coldEpochs uint64
+		hotReads   uint32
+		coldTier   int
+	}
+
+	ioLimits limiter.Limits
+
 	writecacheCfg struct {
 		enabled          bool
 		path             string
@@ -131,6 +163,11 @@ type shardCfg struct {
 		flushWorkerCount int
 		sizeLimit        uint64
 		noSync           bool
+
+		flushInterval      time.Duration
+		flushMinAge        time.Duration
+		flushLowWatermark  float64
+		flushHighWatermark float64
 	}
 
 	piloramaCfg struct {
@@ -163,6 +200,10 @@ type subStorageCfg struct {
 	depth  uint64
 	noSync bool
 
+	// compression codec overriding the default one
+	codec      string
+	codecLevel int
+
 	// blobovnicza-specific
 	size            uint64
 	width           uint64
@@ -197,99 +238,164 @@ func (a *applicationConfiguration) readConfig(c *config.Config) error {
 
 	a.EngineCfg.errorThreshold = engineconfig.ShardErrorThreshold(c)
 	a.EngineCfg.shardPoolSize = engineconfig.ShardPoolSize(c)
+	a.EngineCfg.highWatermark = engineconfig.ShardHighWatermark(c)
+	a.EngineCfg.evacuationPath = engineconfig.EvacuationStatePath(c)
+	a.EngineCfg.indexes = engineconfig.Indexes(c)
 
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
-		var sh shardCfg
-
-		sh.refillMetabase = sc.RefillMetabase()
-		sh.mode = sc.Mode()
-		sh.compress = sc.Compress()
-		sh.uncompressableContentType = sc.UncompressableContentTypes()
-		sh.smallSizeObjectLimit = sc.SmallSizeLimit()
-
-		// write-cache
-
-		writeCacheCfg := sc.WriteCache()
-		if writeCacheCfg.Enabled() {
-			wc := &sh.writecacheCfg
-
-			wc.enabled = true
-			wc.path = writeCacheCfg.Path()
-			wc.maxBatchSize = writeCacheCfg.BoltDB().MaxBatchSize()
-			wc.maxBatchDelay = writeCacheCfg.BoltDB().MaxBatchDelay()
-			wc.maxObjSize = writeCacheCfg.MaxObjectSize()
-			wc.smallObjectSize = writeCacheCfg.SmallObjectSize()
-			wc.flushWorkerCount = writeCacheCfg.WorkersNumber()
-			wc.sizeLimit = writeCacheCfg.SizeLimit()
-			wc.noSync = writeCacheCfg.NoSync()
+		sh, err := readShardConfig(c, sc)
+		if err != nil {
+			return err
 		}
 
-		// blobstor with substorages
+		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
 
-		blobStorCfg := sc.BlobStor()
-		storagesCfg := blobStorCfg.Storages()
-		metabaseCfg := sc.Metabase()
-		gcCfg := sc.GC()
+		return nil
+	})
+}
 
-		if config.BoolSafe(c.Sub("tree"), "enabled") {
-			piloramaCfg := sc.Pilorama()
-			pr := &sh.piloramaCfg
+// readShardConfig reads the shard section of the configuration. Root
+// configuration c is used to check whether the tree service is enabled.
+func readShardConfig(c *config.Config, sc *shardconfig.Config) (shardCfg, error) {
+	var sh shardCfg
+
+	sh.refillMetabase = sc.RefillMetabase()
+	sh.mode = sc.Mode()
+	sh.compress = sc.Compress()
+	sh.uncompressableContentType = sc.UncompressableContentTypes()
+	sh.estimateCompressibility = sc.EstimateCompressibility()
+	sh.estimateCompressibilityTh = sc.EstimateCompressibilityThreshold()
+	sh.smallSizeObjectLimit = sc.SmallSizeLimit()
+	sh.dedupMinSize = sc.DedupMinSize()
+
+	// write-cache
+
+	writeCacheCfg := sc.WriteCache()
+	if writeCacheCfg.Enabled() {
+		wc := &sh.writecacheCfg
+
+		wc.enabled = true
+		wc.path = writeCacheCfg.Path()
+		wc.maxBatchSize = writeCacheCfg.BoltDB().MaxBatchSize()
+		wc.maxBatchDelay = writeCacheCfg.BoltDB().MaxBatchDelay()
+		wc.maxObjSize = writeCacheCfg.MaxObjectSize()
+		wc.smallObjectSize = writeCacheCfg.SmallObjectSize()
+		wc.flushWorkerCount = writeCacheCfg.WorkersNumber()
+		wc.sizeLimit = writeCacheCfg.SizeLimit()
+		wc.noSync = writeCacheCfg.NoSync()
+		wc.flushInterval = writeCacheCfg.FlushInterval()
+		wc.flushMinAge = writeCacheCfg.FlushMinAge()
+		wc.flushLowWatermark = writeCacheCfg.FlushLowWatermark()
+		wc.flushHighWatermark = writeCacheCfg.FlushHighWatermark()
+	}
 
-			pr.enabled = true
-			pr.path = piloramaCfg.Path()
-			pr.perm = piloramaCfg.Perm()
-			pr.noSync = piloramaCfg.NoSync()
-			pr.maxBatchSize = piloramaCfg.MaxBatchSize()
-			pr.maxBatchDelay = piloramaCfg.MaxBatchDelay()
-		}
+	// blobstor with substorages
 
-		ss := make([]subStorageCfg, 0, len(storagesCfg))
-		for i := range storagesCfg {
-			var sCfg subStorageCfg
-
-			sCfg.typ = storagesCfg[i].Type()
-			sCfg.path = storagesCfg[i].Path()
-			sCfg.perm = storagesCfg[i].Perm()
-
-			switch storagesCfg[i].Type() {
-			case blobovniczatree.Type:
-				sub := blobovniczaconfig.From((*config.Config)(storagesCfg[i]))
-
-				sCfg.size = sub.Size()
-				sCfg.depth = sub.ShallowDepth()
-				sCfg.width = sub.ShallowWidth()
-				sCfg.openedCacheSize = sub.OpenedCacheSize()
-			case fstree.Type:
-				sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
-				sCfg.depth = sub.Depth()
-				sCfg.noSync = sub.NoSync()
-			default:
-				return fmt.Errorf("invalid storage type: %s", storagesCfg[i].Type())
-			}
+	blobStorCfg := sc.BlobStor()
+	storagesCfg := blobStorCfg.Storages()
+	metabaseCfg := sc.Metabase()
+	gcCfg := sc.GC()
+
+	if config.BoolSafe(c.Sub("tree"), "enabled") {
+		piloramaCfg := sc.Pilorama()
+		pr := &sh.piloramaCfg
 
-			ss = append(ss, sCfg)
+		pr.enabled = true
+		pr.path = piloramaCfg.Path()
+		pr.perm = piloramaCfg.Perm()
+		pr.noSync = piloramaCfg.NoSync()
+		pr.maxBatchSize = piloramaCfg.MaxBatchSize()
+		pr.maxBatchDelay = piloramaCfg.MaxBatchDelay()
+	}
+
+	ss := make([]subStorageCfg, 0, len(storagesCfg))
+	for i := range storagesCfg {
+		var sCfg subStorageCfg
+
+		sCfg.typ = storagesCfg[i].Type()
+		sCfg.path = storagesCfg[i].Path()
+		sCfg.perm = storagesCfg[i].Perm()
+		sCfg.codec = storagesCfg[i].CompressionCodec()
+		sCfg.codecLevel = storagesCfg[i].CompressionLevel()
+
+		switch storagesCfg[i].Type() {
+		case blobovniczatree.Type:
+			sub := blobovniczaconfig.From((*config.Config)(storagesCfg[i]))
+
+			sCfg.size = sub.Size()
+			sCfg.depth = sub.ShallowDepth()
+			sCfg.width = sub.ShallowWidth()
+			sCfg.openedCacheSize = sub.OpenedCacheSize()
+		case fstree.Type:
+			sub := fstreeconfig.From((*config.Config)(storagesCfg[i]))
+			sCfg.depth = sub.Depth()
+			sCfg.noSync = sub.NoSync()
+		default:
+			return sh, fmt.Errorf("invalid storage type: %s", storagesCfg[i].Type())
 		}
 
-		sh.subStorages = ss
+		ss = append(ss, sCfg)
+	}
 
-		// meta
+	sh.subStorages = ss
 
-		m := &sh.metaCfg
+	// meta
 
-		m.path = metabaseCfg.Path()
-		m.perm = metabaseCfg.BoltDB().Perm()
-		m.maxBatchDelay = metabaseCfg.BoltDB().MaxBatchDelay()
-		m.maxBatchSize = metabaseCfg.BoltDB().MaxBatchSize()
+	m := &sh.metaCfg
 
-		// GC
+	m.path = metabaseCfg.Path()
+	m.perm = metabaseCfg.BoltDB().Perm()
+	m.maxBatchDelay = metabaseCfg.BoltDB().MaxBatchDelay()
+	m.maxBatchSize = metabaseCfg.BoltDB().MaxBatchSize()
+	m.changeLogLimit = metabaseCfg.ChangeLogLimit()
 
-		sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
-		sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
+	// GC
 
-		a.EngineCfg.shards = append(a.EngineCfg.shards, sh)
+	sh.gcCfg.removerBatchSize = gcCfg.RemoverBatchSize()
+	sh.gcCfg.removerSleepInterval = gcCfg.RemoverSleepInterval()
 
-		return nil
-	})
+	// compaction
+
+	compactionCfg := sc.Compaction()
+	sh.compactionCfg.interval = compactionCfg.Interval()
+	sh.compactionCfg.deadSpaceThreshold = compactionCfg.DeadSpaceThreshold()
+	sh.compactionCfg.rateLimit = compactionCfg.RateLimit()
+
+	// scrubbing
+
+	scrubCfg := sc.Scrub()
+	sh.scrubCfg.interval = scrubCfg.Interval()
+	sh.scrubCfg.rateLimit = scrubCfg.RateLimit()
+	sh.scrubCfg.quarantinePath = scrubCfg.QuarantinePath()
+
+	// tiering
+
+	tieringCfg := sc.Tiering()
+	sh.tieringCfg.interval = tieringCfg.Interval()
+	sh.tieringCfg.coldEpochs = tieringCfg.ColdEpochs()
+	sh.tieringCfg.hotReads = tieringCfg.HotReads()
+	sh.tieringCfg.coldTier = tieringCfg.ColdTier()
+
+	// I/O limits
+
+	limitsCfg := sc.Limits()
+	for _, l := range []struct {
+		class limiter.Class
+		cfg   *limitsconfig.Limit
+	}{
+		{limiter.Undefined, limitsCfg.Total()},
+		{limiter.ClientRead, limitsCfg.ClientRead()},
+		{limiter.ClientWrite, limitsCfg.ClientWrite()},
+		{limiter.Replication, limitsCfg.Replication()},
+		{limiter.Background, limitsCfg.Background()},
+	} {
+		sh.ioLimits.SetClass(l.class, limiter.Limit{
+			Ops:   l.cfg.OpsPerSec(),
+			Bytes: l.cfg.BytesPerSec(),
+		})
+	}
+
+	return sh, nil
 }
 
 // internals contains application-specific internals that are created
@@ -478,6 +584,8 @@ type cfgObject struct {
 	cfgLocalStorage cfgLocalStorage
 
 	tombstoneLifetime uint64
+
+	quota *quota.Checker
 }
 
 type cfgNotifications struct {
@@ -488,6 +596,10 @@ type cfgNotifications struct {
 
 type cfgLocalStorage struct {
 	localStorage *engine.StorageEngine
+
+	tombstoneSource *tombstone.ExpirationChecker
+
+	runtimeShards runtimeShards
 }
 
 type cfgObjectRoutines struct {
@@ -634,6 +746,8 @@ func (c *cfg) engineOpts() []engine.Option {
 	opts = append(opts,
 		engine.WithShardPoolSize(c.EngineCfg.shardPoolSize),
 		engine.WithErrorThreshold(c.EngineCfg.errorThreshold),
+		engine.WithShardHighWatermark(float64(c.EngineCfg.highWatermark)/100),
+		engine.WithEvacuationStatePath(c.EngineCfg.evacuationPath),
 
 		engine.WithLogger(c.log),
 	)
@@ -654,109 +768,136 @@ func (c *cfg) shardOpts() []shardOptsWithID {
 	shards := make([]shardOptsWithID, 0, len(c.EngineCfg.shards))
 
 	for _, shCfg := range c.EngineCfg.shards {
-		var writeCacheOpts []writecache.Option
-		if wcRead := shCfg.writecacheCfg; wcRead.enabled {
-			writeCacheOpts = append(writeCacheOpts,
-				writecache.WithPath(wcRead.path),
-				writecache.WithMaxBatchSize(wcRead.maxBatchSize),
-				writecache.WithMaxBatchDelay(wcRead.maxBatchDelay),
-				writecache.WithMaxObjectSize(wcRead.maxObjSize),
-				writecache.WithSmallObjectSize(wcRead.smallObjectSize),
-				writecache.WithFlushWorkersCount(wcRead.flushWorkerCount),
-				writecache.WithMaxCacheSize(wcRead.sizeLimit),
-				writecache.WithNoSync(wcRead.noSync),
-				writecache.WithLogger(c.log),
-			)
-		}
+		shards = append(shards, c.shardOptsFromConfig(shCfg))
+	}
 
-		var piloramaOpts []pilorama.Option
-		if prRead := shCfg.piloramaCfg; prRead.enabled {
-			piloramaOpts = append(piloramaOpts,
-				pilorama.WithPath(prRead.path),
-				pilorama.WithPerm(prRead.perm),
-				pilorama.WithNoSync(prRead.noSync),
-				pilorama.WithMaxBatchSize(prRead.maxBatchSize),
-				pilorama.WithMaxBatchDelay(prRead.maxBatchDelay),
-			)
-		}
+	return shards
+}
 
-		var ss []blobstor.SubStorage
-		for _, sRead := range shCfg.subStorages {
-			switch sRead.typ {
-			case blobovniczatree.Type:
-				ss = append(ss, blobstor.SubStorage{
-					Storage: blobovniczatree.NewBlobovniczaTree(
-						blobovniczatree.WithRootPath(sRead.path),
-						blobovniczatree.WithPermissions(sRead.perm),
-						blobovniczatree.WithBlobovniczaSize(sRead.size),
-						blobovniczatree.WithBlobovniczaShallowDepth(sRead.depth),
-						blobovniczatree.WithBlobovniczaShallowWidth(sRead.width),
-						blobovniczatree.WithOpenedCacheSize(sRead.openedCacheSize),
-
-						blobovniczatree.WithLogger(c.log)),
-					Policy: func(_ *objectSDK.Object, data []byte) bool {
-						return uint64(len(data)) < shCfg.smallSizeObjectLimit
-					},
-				})
-			case fstree.Type:
-				ss = append(ss, blobstor.SubStorage{
-					Storage: fstree.New(
-						fstree.WithPath(sRead.path),
-						fstree.WithPerm(sRead.perm),
-						fstree.WithDepth(sRead.depth),
-						fstree.WithNoSync(sRead.noSync)),
-					Policy: func(_ *objectSDK.Object, data []byte) bool {
-						return true
-					},
-				})
-			default:
-				// should never happen, that has already
-				// been handled: when the config was read
-			}
+func (c *cfg) shardOptsFromConfig(shCfg shardCfg) shardOptsWithID {
+	var writeCacheOpts []writecache.Option
+	if wcRead := shCfg.writecacheCfg; wcRead.enabled {
+		writeCacheOpts = append(writeCacheOpts,
+			writecache.WithPath(wcRead.path),
+			writecache.WithMaxBatchSize(wcRead.maxBatchSize),
+			writecache.WithMaxBatchDelay(wcRead.maxBatchDelay),
+			writecache.WithMaxObjectSize(wcRead.maxObjSize),
+			writecache.WithSmallObjectSize(wcRead.smallObjectSize),
+			writecache.WithFlushWorkersCount(wcRead.flushWorkerCount),
+			writecache.WithMaxCacheSize(wcRead.sizeLimit),
+			writecache.WithNoSync(wcRead.noSync),
+			writecache.WithFlushInterval(wcRead.flushInterval),
+			writecache.WithFlushMinAge(wcRead.flushMinAge),
+			writecache.WithFlushWatermarks(wcRead.flushLowWatermark, wcRead.flushHighWatermark),
+			writecache.WithLogger(c.log),
+		)
+	}
+
+	var piloramaOpts []pilorama.Option
+	if prRead := shCfg.piloramaCfg; prRead.enabled {
+		piloramaOpts = append(piloramaOpts,
+			pilorama.WithPath(prRead.path),
+			pilorama.WithPerm(prRead.perm),
+			pilorama.WithNoSync(prRead.noSync),
+			pilorama.WithMaxBatchSize(prRead.maxBatchSize),
+			pilorama.WithMaxBatchDelay(prRead.maxBatchDelay),
+		)
+	}
+
+	var ss []blobstor.SubStorage
+	for _, sRead := range shCfg.subStorages {
+		switch sRead.typ {
+		case blobovniczatree.Type:
+			ss = append(ss, blobstor.SubStorage{
+				Storage: blobovniczatree.NewBlobovniczaTree(
+					blobovniczatree.WithRootPath(sRead.path),
+					blobovniczatree.WithPermissions(sRead.perm),
+					blobovniczatree.WithBlobovniczaSize(sRead.size),
+					blobovniczatree.WithBlobovniczaShallowDepth(sRead.depth),
+					blobovniczatree.WithBlobovniczaShallowWidth(sRead.width),
+					blobovniczatree.WithOpenedCacheSize(sRead.openedCacheSize),
+
+					blobovniczatree.WithLogger(c.log)),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return uint64(len(data)) < shCfg.smallSizeObjectLimit
+				},
+				Codec:      sRead.codec,
+				CodecLevel: sRead.codecLevel,
+			})
+		case fstree.Type:
+			ss = append(ss, blobstor.SubStorage{
+				Storage: fstree.New(
+					fstree.WithPath(sRead.path),
+					fstree.WithPerm(sRead.perm),
+					fstree.WithDepth(sRead.depth),
+					fstree.WithNoSync(sRead.noSync)),
+				Policy: func(_ *objectSDK.Object, data []byte) bool {
+					return true
+				},
+				Codec:      sRead.codec,
+				CodecLevel: sRead.codecLevel,
+			})
+		default:
+			// should never happen, that has already
+			// been handled: when the config was read
 		}
+	}
 
-		var sh shardOptsWithID
-		sh.configID = shCfg.id()
-		sh.shOpts = []shard.Option{
-			shard.WithLogger(c.log),
-			shard.WithRefillMetabase(shCfg.refillMetabase),
-			shard.WithMode(shCfg.mode),
-			shard.WithBlobStorOptions(
-				blobstor.WithCompressObjects(shCfg.compress),
-				blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
-				blobstor.WithStorages(ss),
-
-				blobstor.WithLogger(c.log),
-			),
-			shard.WithMetaBaseOptions(
-				meta.WithPath(shCfg.metaCfg.path),
-				meta.WithPermissions(shCfg.metaCfg.perm),
-				meta.WithMaxBatchSize(shCfg.metaCfg.maxBatchSize),
-				meta.WithMaxBatchDelay(shCfg.metaCfg.maxBatchDelay),
-				meta.WithBoltDBOptions(&bbolt.Options{
-					Timeout: 100 * time.Millisecond,
-				}),
-
-				meta.WithLogger(c.log),
-				meta.WithEpochState(c.cfgNetmap.state),
-			),
-			shard.WithPiloramaOptions(piloramaOpts...),
-			shard.WithWriteCache(shCfg.writecacheCfg.enabled),
-			shard.WithWriteCacheOptions(writeCacheOpts...),
-			shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
-			shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
-			shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
-				pool, err := ants.NewPool(sz)
-				fatalOnErr(err)
-
-				return pool
+	var sh shardOptsWithID
+	sh.configID = shCfg.id()
+	sh.shOpts = []shard.Option{
+		shard.WithLogger(c.log),
+		shard.WithRefillMetabase(shCfg.refillMetabase),
+		shard.WithMode(shCfg.mode),
+		shard.WithBlobStorOptions(
+			blobstor.WithCompressObjects(shCfg.compress),
+			blobstor.WithUncompressableContentTypes(shCfg.uncompressableContentType),
+			blobstor.WithCompressibilityEstimate(shCfg.estimateCompressibility),
+			blobstor.WithCompressibilityEstimateThreshold(shCfg.estimateCompressibilityTh),
+			blobstor.WithStorages(ss),
+
+			blobstor.WithLogger(c.log),
+		),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(shCfg.metaCfg.path),
+			meta.WithPermissions(shCfg.metaCfg.perm),
+			meta.WithMaxBatchSize(shCfg.metaCfg.maxBatchSize),
+			meta.WithMaxBatchDelay(shCfg.metaCfg.maxBatchDelay),
+			meta.WithChangeLogLimit(shCfg.metaCfg.changeLogLimit),
+			meta.WithBoltDBOptions(&bbolt.Options{
+				Timeout: 100 * time.Millisecond,
 			}),
-		}
 
-		shards = append(shards, sh)
+			meta.WithLogger(c.log),
+			meta.WithEpochState(c.cfgNetmap.state),
+		),
+		shard.WithPiloramaOptions(piloramaOpts...),
+		shard.WithWriteCache(shCfg.writecacheCfg.enabled),
+		shard.WithWriteCacheOptions(writeCacheOpts...),
+		shard.WithRemoverBatchSize(shCfg.gcCfg.removerBatchSize),
+		shard.WithGCRemoverSleepInterval(shCfg.gcCfg.removerSleepInterval),
+		shard.WithCompactionInterval(shCfg.compactionCfg.interval),
+		shard.WithCompactionDeadSpaceThreshold(shCfg.compactionCfg.deadSpaceThreshold),
+		shard.WithCompactionRateLimit(shCfg.compactionCfg.rateLimit),
+		shard.WithScrubInterval(shCfg.scrubCfg.interval),
+		shard.WithScrubRateLimit(shCfg.scrubCfg.rateLimit),
+		shard.WithScrubQuarantinePath(shCfg.scrubCfg.quarantinePath),
+		shard.WithTieringInterval(shCfg.tieringCfg.interval),
+		shard.WithTieringColdEpochs(shCfg.tieringCfg.coldEpochs),
+		shard.WithTieringHotReads(shCfg.tieringCfg.hotReads),
+		shard.WithTieringColdTier(shCfg.tieringCfg.coldTier),
+		shard.WithPayloadDeduplication(shCfg.dedupMinSize),
+		shard.WithIOLimits(shCfg.ioLimits),
+		shard.WithIndexSettings(c.EngineCfg.indexes),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			fatalOnErr(err)
+
+			return pool
+		}),
 	}
 
-	return shards
+	return sh
 }
 
 func (c *cfg) loggerPrm() (*logger.Prm, error) {
@@ -814,6 +955,7 @@ func initLocalStorage(c *cfg) {
 	}
 
 	c.cfgObject.cfgLocalStorage.localStorage = ls
+	c.cfgObject.cfgLocalStorage.tombstoneSource = tombstoneSource
 
 	c.onShutdown(func() {
 		c.log.Info("closing components of the storage engine...")
@@ -957,12 +1099,7 @@ func (c *cfg) configWatcher(ctx context.Context) {
 
 			// Storage Engine
 
-			var rcfg engine.ReConfiguration
-			for _, optsWithID := range c.shardOpts() {
-				rcfg.AddShard(optsWithID.configID, optsWithID.shOpts)
-			}
-
-			err = c.cfgObject.cfgLocalStorage.localStorage.Reload(rcfg)
+			err = c.reloadStorageEngine()
 			if err != nil {
 				c.log.Error("storage engine configuration update", zap.Error(err))
 				continue
@@ -77,3 +77,17 @@ func TestConfig_SetDefault(t *testing.T) {
 		require.Equal(t, "y", config.String(s, "overridden"))
 	})
 }
+
+func TestFromData(t *testing.T) {
+	for _, data := range []string{
+		"section:\n  sub:\n    key: val\n",
+		`{"section": {"sub": {"key": "val"}}}`,
+	} {
+		c, err := config.FromData([]byte(data))
+		require.NoError(t, err)
+		require.Equal(t, "val", config.String(c.Sub("section").Sub("sub"), "key"))
+	}
+
+	_, err := config.FromData([]byte("{invalid"))
+	require.Error(t, err)
+}
@@ -113,6 +113,10 @@ func TestNumbers(t *testing.T) {
 
 		require.Zero(t, config.IntSafe(c, incorrect))
 		require.Zero(t, config.UintSafe(c, incorrect))
+
+		require.EqualValues(t, 2.5, config.FloatSafe(c, fractPos))
+		require.EqualValues(t, -2.5, config.FloatSafe(c, fractNeg))
+		require.Zero(t, config.FloatSafe(c, incorrect))
 	})
 }
 
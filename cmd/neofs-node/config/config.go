@@ -1,6 +1,7 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 
@@ -60,6 +61,23 @@ func New(_ Prm, opts ...Option) *Config {
 	}
 }
 
+// FromData creates a new Config instance with the configuration values
+// read from the data in YAML or JSON format. Unlike New, environment
+// variables are not taken into account.
+func FromData(data []byte) (*Config, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	err := v.ReadConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	return &Config{
+		v: v,
+	}, nil
+}
+
 // Reload reads configuration path if it was provided to New.
 func (x *Config) Reload() error {
 	if x.opts.path != "" {
@@ -2,11 +2,15 @@ package engineconfig
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 )
 
 const (
@@ -77,9 +81,72 @@ func ShardPoolSize(c *config.Config) uint32 {
 	return ShardPoolSizeDefault
 }
 
+// ShardHighWatermark returns the value of "shard_high_watermark" config parameter from "storage" section.
+//
+// Returns 0 if the value is missing.
+func ShardHighWatermark(c *config.Config) uint32 {
+	return config.Uint32Safe(c.Sub(subsection), "shard_high_watermark")
+}
+
+// EvacuationStatePath returns the value of "evacuation_state" config parameter from "storage" section.
+//
+// Returns empty string if the value is missing.
+func EvacuationStatePath(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection), "evacuation_state")
+}
+
 // ShardErrorThreshold returns the value of "shard_ro_error_threshold" config parameter from "storage" section.
 //
 // Returns 0 if the the value is missing.
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// Indexes returns the attribute index settings of the containers from
+// "indexes" subsection of "storage" section. Every entry has "container"
+// (container ID) and "attributes" (list of "<attribute>:<kind>" strings,
+// kind is one of "exact", "prefix" and "numeric") parameters.
+//
+// Throws panic if container ID or attribute index is invalid.
+func Indexes(c *config.Config) map[cid.ID][]meta.AttributeIndex {
+	res := make(map[cid.ID][]meta.AttributeIndex)
+
+	sub := c.Sub(subsection).Sub("indexes")
+	for i := 0; ; i++ {
+		s := sub.Sub(strconv.Itoa(i))
+
+		strID := config.StringSafe(s, "container")
+		if strID == "" {
+			break
+		}
+
+		var id cid.ID
+		if err := id.DecodeString(strID); err != nil {
+			panic(fmt.Errorf("invalid container ID %q in attribute indexes: %w", strID, err))
+		}
+
+		attrs := config.StringSliceSafe(s, "attributes")
+		indexes := make([]meta.AttributeIndex, 0, len(attrs))
+
+		for _, a := range attrs {
+			sep := strings.LastIndexByte(a, ':')
+			if sep <= 0 {
+				panic(fmt.Errorf("invalid attribute index %q of the container %s, <attribute>:<kind> expected", a, strID))
+			}
+
+			var kind meta.IndexKind
+			if !kind.FromString(a[sep+1:]) {
+				panic(fmt.Errorf("unknown kind of the attribute index %q of the container %s", a, strID))
+			}
+
+			indexes = append(indexes, meta.AttributeIndex{
+				Attribute: a[:sep],
+				Kind:      kind,
+			})
+		}
+
+		res[id] = indexes
+	}
+
+	return res
+}
@@ -10,9 +10,15 @@ import (
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
 	blobovniczaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/blobovnicza"
 	fstreeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor/fstree"
+	compactionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/compaction"
+	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
+	tieringconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/tiering"
+	writecacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/writecache"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,7 +41,10 @@ func TestEngineSection(t *testing.T) {
 		require.False(t, handlerCalled)
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
+		require.EqualValues(t, 0, engineconfig.ShardHighWatermark(empty))
+		require.Empty(t, engineconfig.EvacuationStatePath(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.Empty(t, engineconfig.Indexes(empty))
 		require.EqualValues(t, mode.ReadWrite, shardconfig.From(empty).Mode())
 	})
 
@@ -46,6 +55,18 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.EqualValues(t, 95, engineconfig.ShardHighWatermark(c))
+		require.Equal(t, "/srv/neofs/evacuation.state", engineconfig.EvacuationStatePath(c))
+
+		var cnr cid.ID
+		require.NoError(t, cnr.DecodeString("EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk"))
+		require.Equal(t, map[cid.ID][]meta.AttributeIndex{
+			cnr: {
+				{Attribute: "FileName", Kind: meta.IndexExact},
+				{Attribute: "FilePath", Kind: meta.IndexPrefix},
+				{Attribute: "Timestamp", Kind: meta.IndexNumeric},
+			},
+		}, engineconfig.Indexes(c))
 
 		err := engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) error {
 			defer func() {
@@ -75,14 +96,22 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 3221225472, wc.SizeLimit())
+				require.Equal(t, writecacheconfig.FlushIntervalDefault, wc.FlushInterval())
+				require.Equal(t, time.Duration(0), wc.FlushMinAge())
+				require.Equal(t, 0.0, wc.FlushLowWatermark())
+				require.Equal(t, 0.0, wc.FlushHighWatermark())
 
 				require.Equal(t, "tmp/0/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
 				require.Equal(t, 100, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 10*time.Millisecond, meta.BoltDB().MaxBatchDelay())
+				require.Equal(t, metabaseconfig.ChangeLogLimitDefault, meta.ChangeLogLimit())
 
 				require.Equal(t, true, sc.Compress())
 				require.Equal(t, []string{"audio/*", "video/*"}, sc.UncompressableContentTypes())
+				require.Equal(t, true, sc.EstimateCompressibility())
+				require.Equal(t, 0.7, sc.EstimateCompressibilityThreshold())
+				require.EqualValues(t, 64<<10, sc.DedupMinSize())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -93,9 +122,13 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 1, blz.ShallowDepth())
 				require.EqualValues(t, 4, blz.ShallowWidth())
 				require.EqualValues(t, 50, blz.OpenedCacheSize())
+				require.Equal(t, "lz4", ss[0].CompressionCodec())
+				require.Equal(t, 0, ss[0].CompressionLevel())
 
 				require.Equal(t, "tmp/0/blob", ss[1].Path())
 				require.EqualValues(t, 0644, ss[1].Perm())
+				require.Equal(t, "zstd", ss[1].CompressionCodec())
+				require.Equal(t, 9, ss[1].CompressionLevel())
 
 				fst := fstreeconfig.From((*config.Config)(ss[1]))
 				require.EqualValues(t, 5, fst.Depth())
@@ -104,6 +137,32 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 150, gc.RemoverBatchSize())
 				require.Equal(t, 2*time.Minute, gc.RemoverSleepInterval())
 
+				compaction := sc.Compaction()
+				require.Equal(t, time.Hour, compaction.Interval())
+				require.Equal(t, 0.6, compaction.DeadSpaceThreshold())
+				require.EqualValues(t, 16<<20, compaction.RateLimit())
+
+				scrub := sc.Scrub()
+				require.Equal(t, 24*time.Hour, scrub.Interval())
+				require.EqualValues(t, 8<<20, scrub.RateLimit())
+				require.Equal(t, "tmp/0/quarantine", scrub.QuarantinePath())
+
+				tiering := sc.Tiering()
+				require.Equal(t, time.Hour, tiering.Interval())
+				require.EqualValues(t, 20, tiering.ColdEpochs())
+				require.EqualValues(t, 5, tiering.HotReads())
+				require.Equal(t, 1, tiering.ColdTier())
+
+				limits := sc.Limits()
+				require.EqualValues(t, 10000, limits.Total().OpsPerSec())
+				require.EqualValues(t, 0, limits.Total().BytesPerSec())
+				require.EqualValues(t, 0, limits.ClientRead().OpsPerSec())
+				require.EqualValues(t, 512<<20, limits.ClientRead().BytesPerSec())
+				require.EqualValues(t, 0, limits.ClientWrite().OpsPerSec())
+				require.EqualValues(t, 0, limits.Replication().BytesPerSec())
+				require.EqualValues(t, 100, limits.Background().OpsPerSec())
+				require.EqualValues(t, 10<<20, limits.Background().BytesPerSec())
+
 				require.Equal(t, false, sc.RefillMetabase())
 				require.Equal(t, mode.ReadOnly, sc.Mode())
 			case 1:
@@ -121,14 +180,24 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 134217728, wc.MaxObjectSize())
 				require.EqualValues(t, 30, wc.WorkersNumber())
 				require.EqualValues(t, 4294967296, wc.SizeLimit())
+				require.Equal(t, 128, wc.BoltDB().MaxBatchSize())
+				require.Equal(t, 2*time.Millisecond, wc.BoltDB().MaxBatchDelay())
+				require.Equal(t, 500*time.Millisecond, wc.FlushInterval())
+				require.Equal(t, 30*time.Second, wc.FlushMinAge())
+				require.Equal(t, 0.2, wc.FlushLowWatermark())
+				require.Equal(t, 0.8, wc.FlushHighWatermark())
 
 				require.Equal(t, "tmp/1/meta", meta.Path())
 				require.Equal(t, fs.FileMode(0644), meta.BoltDB().Perm())
 				require.Equal(t, 200, meta.BoltDB().MaxBatchSize())
 				require.Equal(t, 20*time.Millisecond, meta.BoltDB().MaxBatchDelay())
+				require.Equal(t, 100000, meta.ChangeLogLimit())
 
 				require.Equal(t, false, sc.Compress())
 				require.Equal(t, []string(nil), sc.UncompressableContentTypes())
+				require.Equal(t, false, sc.EstimateCompressibility())
+				require.Equal(t, shardconfig.EstimateCompressibilityThresholdDefault, sc.EstimateCompressibilityThreshold())
+				require.EqualValues(t, 0, sc.DedupMinSize())
 				require.EqualValues(t, 102400, sc.SmallSizeLimit())
 
 				require.Equal(t, 2, len(ss))
@@ -142,6 +211,7 @@ func TestEngineSection(t *testing.T) {
 
 				require.Equal(t, "tmp/1/blob", ss[1].Path())
 				require.EqualValues(t, 0644, ss[1].Perm())
+				require.Equal(t, "", ss[1].CompressionCodec())
 
 				fst := fstreeconfig.From((*config.Config)(ss[1]))
 				require.EqualValues(t, 5, fst.Depth())
@@ -150,6 +220,22 @@ func TestEngineSection(t *testing.T) {
 				require.EqualValues(t, 200, gc.RemoverBatchSize())
 				require.Equal(t, 5*time.Minute, gc.RemoverSleepInterval())
 
+				require.Equal(t, time.Duration(0), sc.Compaction().Interval())
+				require.Equal(t, compactionconfig.DeadSpaceThresholdDefault, sc.Compaction().DeadSpaceThreshold())
+				require.EqualValues(t, 0, sc.Compaction().RateLimit())
+
+				require.Equal(t, time.Duration(0), sc.Scrub().Interval())
+				require.EqualValues(t, 0, sc.Scrub().RateLimit())
+				require.Equal(t, "", sc.Scrub().QuarantinePath())
+
+				require.Equal(t, time.Duration(0), sc.Tiering().Interval())
+				require.EqualValues(t, tieringconfig.ColdEpochsDefault, sc.Tiering().ColdEpochs())
+				require.EqualValues(t, tieringconfig.HotReadsDefault, sc.Tiering().HotReads())
+				require.Equal(t, 0, sc.Tiering().ColdTier())
+
+				require.EqualValues(t, 0, sc.Limits().Total().OpsPerSec())
+				require.EqualValues(t, 0, sc.Limits().Background().BytesPerSec())
+
 				require.Equal(t, true, sc.RefillMetabase())
 				require.Equal(t, mode.ReadWrite, sc.Mode())
 			}
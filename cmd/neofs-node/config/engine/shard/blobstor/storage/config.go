@@ -53,3 +53,25 @@ func (x *Config) Perm() fs.FileMode {
 
 	return fs.FileMode(p)
 }
+
+// CompressionCodec returns the value of "compression_codec" config parameter.
+//
+// Returns empty string if the value is missing, in this case
+// the default shard codec is used.
+func (x *Config) CompressionCodec() string {
+	return config.StringSafe(
+		(*config.Config)(x),
+		"compression_codec",
+	)
+}
+
+// CompressionLevel returns the value of "compression_level" config parameter.
+//
+// Returns 0 if the value is not a valid integer, in this case
+// the default codec level is used.
+func (x *Config) CompressionLevel() int {
+	return int(config.IntSafe(
+		(*config.Config)(x),
+		"compression_level",
+	))
+}
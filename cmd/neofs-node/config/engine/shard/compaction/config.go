@@ -0,0 +1,62 @@
+package compactionconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's blobstor compaction configurations.
+type Config config.Config
+
+// DeadSpaceThresholdDefault is a default minimum fraction of the
+// blobovnicza occupied by the removed objects to compact it.
+const DeadSpaceThresholdDefault = 0.5
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Interval returns the value of "interval" config parameter.
+//
+// Returns 0 (compaction is disabled) if the value is not a positive duration.
+func (x *Config) Interval() time.Duration {
+	v := config.DurationSafe(
+		(*config.Config)(x),
+		"interval",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// DeadSpaceThreshold returns the value of "dead_space_threshold" config parameter.
+//
+// Returns DeadSpaceThresholdDefault if the value is not in (0:1) range.
+func (x *Config) DeadSpaceThreshold() float64 {
+	v := config.FloatSafe(
+		(*config.Config)(x),
+		"dead_space_threshold",
+	)
+
+	if v > 0 && v < 1 {
+		return v
+	}
+
+	return DeadSpaceThresholdDefault
+}
+
+// RateLimit returns the value of "rate_limit" config parameter.
+//
+// Returns 0 (no limit) if the value is not a valid size.
+func (x *Config) RateLimit() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"rate_limit",
+	)
+}
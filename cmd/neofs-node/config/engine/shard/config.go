@@ -5,9 +5,13 @@ import (
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	blobstorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/blobstor"
+	compactionconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/compaction"
 	gcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/gc"
+	limitsconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/limits"
 	metabaseconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/metabase"
 	piloramaconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/pilorama"
+	scrubconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/scrub"
+	tieringconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/tiering"
 	writecacheconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 )
@@ -16,8 +20,14 @@ import (
 // which provides access to Shard configurations.
 type Config config.Config
 
-// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
-const SmallSizeLimitDefault = 1 << 20
+const (
+	// SmallSizeLimitDefault is a default limit of small objects payload in bytes.
+	SmallSizeLimitDefault = 1 << 20
+
+	// EstimateCompressibilityThresholdDefault is a default minimum fraction
+	// of space saved by compression for an object to be stored compressed.
+	EstimateCompressibilityThresholdDefault = 0.1
+)
 
 // From wraps config section into Config.
 func From(c *config.Config) *Config {
@@ -43,6 +53,32 @@ func (x *Config) UncompressableContentTypes() []string {
 		"compression_exclude_content_types")
 }
 
+// EstimateCompressibility returns the value of "compression_estimate_compressibility" config parameter.
+//
+// Returns false if the value is not a valid bool.
+func (x *Config) EstimateCompressibility() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility",
+	)
+}
+
+// EstimateCompressibilityThreshold returns the value of "compression_estimate_compressibility_threshold" config parameter.
+//
+// Returns EstimateCompressibilityThresholdDefault if the value is not in (0:1) range.
+func (x *Config) EstimateCompressibilityThreshold() float64 {
+	v := config.FloatSafe(
+		(*config.Config)(x),
+		"compression_estimate_compressibility_threshold",
+	)
+
+	if v > 0 && v < 1 {
+		return v
+	}
+
+	return EstimateCompressibilityThresholdDefault
+}
+
 // SmallSizeLimit returns the value of "small_object_size" config parameter.
 //
 // Returns SmallSizeLimitDefault if the value is not a positive number.
@@ -59,6 +95,16 @@ func (x *Config) SmallSizeLimit() uint64 {
 	return SmallSizeLimitDefault
 }
 
+// DedupMinSize returns the value of "dedup_min_size" config parameter.
+//
+// Returns 0 if the value is not a positive number.
+func (x *Config) DedupMinSize() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"dedup_min_size",
+	)
+}
+
 // BlobStor returns "blobstor" subsection as a blobstorconfig.Config.
 func (x *Config) BlobStor() *blobstorconfig.Config {
 	return blobstorconfig.From(
@@ -99,6 +145,38 @@ func (x *Config) GC() *gcconfig.Config {
 	)
 }
 
+// Compaction returns "compaction" subsection as a compactionconfig.Config.
+func (x *Config) Compaction() *compactionconfig.Config {
+	return compactionconfig.From(
+		(*config.Config)(x).
+			Sub("compaction"),
+	)
+}
+
+// Scrub returns "scrub" subsection as a scrubconfig.Config.
+func (x *Config) Scrub() *scrubconfig.Config {
+	return scrubconfig.From(
+		(*config.Config)(x).
+			Sub("scrub"),
+	)
+}
+
+// Tiering returns "tiering" subsection as a tieringconfig.Config.
+func (x *Config) Tiering() *tieringconfig.Config {
+	return tieringconfig.From(
+		(*config.Config)(x).
+			Sub("tiering"),
+	)
+}
+
+// Limits returns "limits" subsection as a limitsconfig.Config.
+func (x *Config) Limits() *limitsconfig.Config {
+	return limitsconfig.From(
+		(*config.Config)(x).
+			Sub("limits"),
+	)
+}
+
 // RefillMetabase returns the value of "resync_metabase" config parameter.
 //
 // Returns false if the value is not a valid bool.
@@ -0,0 +1,61 @@
+package limitsconfig
+
+import (
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's I/O limits configurations.
+type Config config.Config
+
+// Limit is a wrapper over the config section
+// which provides access to the I/O limit of the operation class.
+type Limit config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Total returns "total" subsection as a Limit.
+func (x *Config) Total() *Limit {
+	return x.limit("total")
+}
+
+// ClientRead returns "client_read" subsection as a Limit.
+func (x *Config) ClientRead() *Limit {
+	return x.limit("client_read")
+}
+
+// ClientWrite returns "client_write" subsection as a Limit.
+func (x *Config) ClientWrite() *Limit {
+	return x.limit("client_write")
+}
+
+// Replication returns "replication" subsection as a Limit.
+func (x *Config) Replication() *Limit {
+	return x.limit("replication")
+}
+
+// Background returns "background" subsection as a Limit.
+func (x *Config) Background() *Limit {
+	return x.limit("background")
+}
+
+func (x *Config) limit(name string) *Limit {
+	return (*Limit)((*config.Config)(x).Sub(name))
+}
+
+// OpsPerSec returns the value of "ops_per_sec" config parameter.
+//
+// Returns 0 (no limit) if the value is missing.
+func (x *Limit) OpsPerSec() uint64 {
+	return config.UintSafe((*config.Config)(x), "ops_per_sec")
+}
+
+// BytesPerSec returns the value of "bytes_per_sec" config parameter.
+//
+// Returns 0 (no limit) if the value is missing.
+func (x *Limit) BytesPerSec() uint64 {
+	return config.SizeInBytesSafe((*config.Config)(x), "bytes_per_sec")
+}
@@ -5,6 +5,12 @@ import (
 	boltdbconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/boltdb"
 )
 
+const (
+	// ChangeLogLimitDefault is a default number of the latest changes
+	// kept in the metabase change log.
+	ChangeLogLimitDefault = 1 << 20
+)
+
 // Config is a wrapper over the config section
 // which provides access to Metabase configurations.
 type Config config.Config
@@ -30,6 +36,22 @@ func (x *Config) Path() string {
 	return p
 }
 
+// ChangeLogLimit returns the value of "change_log_limit" config parameter.
+//
+// Returns ChangeLogLimitDefault if the value is not a positive number.
+func (x *Config) ChangeLogLimit() int {
+	v := config.IntSafe(
+		(*config.Config)(x),
+		"change_log_limit",
+	)
+
+	if v > 0 {
+		return int(v)
+	}
+
+	return ChangeLogLimitDefault
+}
+
 // BoltDB returns config instance for querying bolt db specific parameters.
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
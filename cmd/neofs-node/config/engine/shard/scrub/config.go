@@ -0,0 +1,52 @@
+package scrubconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's data scrubbing configurations.
+type Config config.Config
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Interval returns the value of "interval" config parameter.
+//
+// Returns 0 (scrubbing is disabled) if the value is not a positive duration.
+func (x *Config) Interval() time.Duration {
+	v := config.DurationSafe(
+		(*config.Config)(x),
+		"interval",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// RateLimit returns the value of "rate_limit" config parameter.
+//
+// Returns 0 (no limit) if the value is not a valid size.
+func (x *Config) RateLimit() uint64 {
+	return config.SizeInBytesSafe(
+		(*config.Config)(x),
+		"rate_limit",
+	)
+}
+
+// QuarantinePath returns the value of "quarantine_path" config parameter.
+//
+// Returns empty string (corrupted objects are not saved) if the value is missing.
+func (x *Config) QuarantinePath() string {
+	return config.StringSafe(
+		(*config.Config)(x),
+		"quarantine_path",
+	)
+}
@@ -0,0 +1,90 @@
+package tieringconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+// Config is a wrapper over the config section
+// which provides access to Shard's tiered storage configurations.
+type Config config.Config
+
+const (
+	// ColdEpochsDefault is a default number of epochs without reads
+	// after which the object is moved to the cold sub-storage.
+	ColdEpochsDefault = 10
+
+	// HotReadsDefault is a default number of reads between the tiering
+	// runs after which the object is moved to the hot sub-storage.
+	HotReadsDefault = 10
+)
+
+// From wraps config section into Config.
+func From(c *config.Config) *Config {
+	return (*Config)(c)
+}
+
+// Interval returns the value of "interval" config parameter.
+//
+// Returns 0 (tiering is disabled) if the value is not a positive duration.
+func (x *Config) Interval() time.Duration {
+	v := config.DurationSafe(
+		(*config.Config)(x),
+		"interval",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
+// ColdEpochs returns the value of "cold_epochs" config parameter.
+//
+// Returns ColdEpochsDefault if the value is not a positive number.
+func (x *Config) ColdEpochs() uint64 {
+	v := config.UintSafe(
+		(*config.Config)(x),
+		"cold_epochs",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return ColdEpochsDefault
+}
+
+// HotReads returns the value of "hot_reads" config parameter.
+//
+// Returns HotReadsDefault if the value is not a positive number.
+func (x *Config) HotReads() uint32 {
+	v := config.Uint32Safe(
+		(*config.Config)(x),
+		"hot_reads",
+	)
+
+	if v > 0 {
+		return v
+	}
+
+	return HotReadsDefault
+}
+
+// ColdTier returns the value of "cold_tier" config parameter.
+//
+// Returns 0 (the last sub-storage is cold) if the value is not a positive number.
+func (x *Config) ColdTier() int {
+	v := config.IntSafe(
+		(*config.Config)(x),
+		"cold_tier",
+	)
+
+	if v > 0 {
+		return int(v)
+	}
+
+	return 0
+}
@@ -1,6 +1,8 @@
 package writecacheconfig
 
 import (
+	"time"
+
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	boltdbconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard/boltdb"
 )
@@ -21,6 +23,9 @@ const (
 
 	// SizeLimitDefault is a default write-cache size limit.
 	SizeLimitDefault = 1 << 30
+
+	// FlushIntervalDefault is a default time interval between the flushes.
+	FlushIntervalDefault = time.Second
 )
 
 // From wraps config section into Config.
@@ -122,6 +127,59 @@ func (x *Config) NoSync() bool {
 	return config.BoolSafe((*config.Config)(x), "no_sync")
 }
 
+// FlushInterval returns the value of "flush_interval" config parameter.
+//
+// Returns FlushIntervalDefault if the value is not a positive duration.
+func (x *Config) FlushInterval() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"flush_interval",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return FlushIntervalDefault
+}
+
+// FlushMinAge returns the value of "flush_min_age" config parameter.
+//
+// Returns 0 (objects are flushed regardless of their age) if the value
+// is not a positive duration.
+func (x *Config) FlushMinAge() time.Duration {
+	d := config.DurationSafe(
+		(*config.Config)(x),
+		"flush_min_age",
+	)
+
+	if d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// FlushLowWatermark returns the value of "flush_low_watermark" config parameter.
+//
+// Returns 0 if the value is not a valid float.
+func (x *Config) FlushLowWatermark() float64 {
+	return config.FloatSafe(
+		(*config.Config)(x),
+		"flush_low_watermark",
+	)
+}
+
+// FlushHighWatermark returns the value of "flush_high_watermark" config parameter.
+//
+// Returns 0 (watermarks are disabled) if the value is not a valid float.
+func (x *Config) FlushHighWatermark() float64 {
+	return config.FloatSafe(
+		(*config.Config)(x),
+		"flush_high_watermark",
+	)
+}
+
 // BoltDB returns config instance for querying bolt db specific parameters.
 func (x *Config) BoltDB() *boltdbconfig.Config {
 	return (*boltdbconfig.Config)(x)
@@ -6,6 +6,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/quota"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/stretchr/testify/require"
 )
 
@@ -15,6 +17,8 @@ func TestObjectSection(t *testing.T) {
 
 		require.Equal(t, objectconfig.PutPoolSizeDefault, objectconfig.Put(empty).PoolSizeRemote())
 		require.EqualValues(t, objectconfig.DefaultTombstoneLifetime, objectconfig.TombstoneLifetime(empty))
+		require.Zero(t, objectconfig.Put(empty).Quota().Default())
+		require.Empty(t, objectconfig.Put(empty).Quota().Containers())
 	})
 
 	const path = "../../../../config/example/node"
@@ -22,6 +26,15 @@ func TestObjectSection(t *testing.T) {
 	var fileConfigTest = func(c *config.Config) {
 		require.Equal(t, 100, objectconfig.Put(c).PoolSizeRemote())
 		require.EqualValues(t, 10, objectconfig.TombstoneLifetime(c))
+
+		q := objectconfig.Put(c).Quota()
+		require.Equal(t, quota.Limits{Soft: 1 << 40, Hard: 2 << 40}, q.Default())
+
+		var id cid.ID
+		require.NoError(t, id.DecodeString("EutHBsdT1YCzHxjCfQHnLPL1vFrkSyLSio4vkphfnEk"))
+		require.Equal(t, map[cid.ID]quota.Limits{
+			id: {Soft: 100 << 30, Hard: 200 << 30},
+		}, q.Containers())
 	}
 
 	configtest.ForEachFileType(path, fileConfigTest)
@@ -0,0 +1,69 @@
+package objectconfig
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/quota"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+)
+
+const quotaSubsection = "quota"
+
+// QuotaConfig is a wrapper over "quota" config section which provides access
+// to the container quotas enforced by the object put pipeline.
+type QuotaConfig struct {
+	cfg *config.Config
+}
+
+// Quota returns structure that provides access to "quota" subsection of
+// "object.put" section.
+func (g PutConfig) Quota() QuotaConfig {
+	return QuotaConfig{
+		g.cfg.Sub(quotaSubsection),
+	}
+}
+
+// Default returns the values of "soft" and "hard" config parameters.
+// These quotas are applied to all containers without explicitly
+// configured ones.
+//
+// Returns zero limits if the values are not set.
+func (q QuotaConfig) Default() quota.Limits {
+	return readLimits(q.cfg)
+}
+
+// Containers returns the list of the container quotas from "containers"
+// config parameter.
+//
+// Throws panic if container ID is invalid.
+func (q QuotaConfig) Containers() map[cid.ID]quota.Limits {
+	res := make(map[cid.ID]quota.Limits)
+
+	sub := q.cfg.Sub("containers")
+	for i := 0; ; i++ {
+		s := sub.Sub(strconv.Itoa(i))
+
+		strID := config.StringSafe(s, "container")
+		if strID == "" {
+			break
+		}
+
+		var id cid.ID
+		if err := id.DecodeString(strID); err != nil {
+			panic(fmt.Errorf("invalid container ID %q in container quotas: %w", strID, err))
+		}
+
+		res[id] = readLimits(s)
+	}
+
+	return res
+}
+
+func readLimits(c *config.Config) quota.Limits {
+	return quota.Limits{
+		Soft: config.SizeInBytesSafe(c, "soft"),
+		Hard: config.SizeInBytesSafe(c, "hard"),
+	}
+}
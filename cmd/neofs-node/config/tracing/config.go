@@ -0,0 +1,92 @@
+package tracingconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+)
+
+const (
+	subsection = "tracing"
+
+	// ExporterDefault is a default span exporter.
+	ExporterDefault = tracing.ExporterOTLP
+
+	// EndpointDefault is a default value for OTLP collector endpoint.
+	EndpointDefault = "http://localhost:4318"
+
+	// TimeoutDefault is a default value for OTLP export request timeout.
+	TimeoutDefault = 10 * time.Second
+
+	// SamplingRatioDefault is a default fraction of the sampled traces.
+	SamplingRatioDefault = 1.0
+)
+
+// Enabled returns the value of "enabled" config parameter
+// from "tracing" section.
+//
+// Returns false if the value is missing or invalid.
+func Enabled(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "enabled")
+}
+
+// Exporter returns the value of "exporter" config parameter
+// from "tracing" section.
+//
+// Returns ExporterDefault if the value is not set.
+func Exporter(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "exporter")
+	if v != "" {
+		return v
+	}
+
+	return ExporterDefault
+}
+
+// Endpoint returns the value of "endpoint" config parameter
+// from "tracing" section.
+//
+// Returns EndpointDefault if the value is not set.
+func Endpoint(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "endpoint")
+	if v != "" {
+		return v
+	}
+
+	return EndpointDefault
+}
+
+// Timeout returns the value of "timeout" config parameter
+// from "tracing" section.
+//
+// Returns TimeoutDefault if the value is not positive duration.
+func Timeout(c *config.Config) time.Duration {
+	v := config.DurationSafe(c.Sub(subsection), "timeout")
+	if v > 0 {
+		return v
+	}
+
+	return TimeoutDefault
+}
+
+// Path returns the value of "path" config parameter
+// from "tracing" section.
+//
+// Returns empty string if the value is not set.
+func Path(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection), "path")
+}
+
+// SamplingRatio returns the value of "sampling_ratio" config parameter
+// from "tracing" section.
+//
+// Returns SamplingRatioDefault if the value is not in (0; 1] range.
+func SamplingRatio(c *config.Config) float64 {
+	v := config.FloatSafe(c.Sub(subsection), "sampling_ratio")
+	if v > 0 && v <= 1 {
+		return v
+	}
+
+	return SamplingRatioDefault
+}
@@ -5,6 +5,7 @@ import (
 	"net"
 
 	controlconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/control"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-node/pkg/services/tree"
@@ -21,6 +22,10 @@ func (t treeSynchronizer) Synchronize(ctx context.Context, cnr cid.ID, treeID st
 	return t.treeSvc.SynchronizeTree(ctx, cnr, treeID)
 }
 
+func (t treeSynchronizer) ReplicateTreeOp(ctx context.Context, cnr cid.ID, treeID string, op *pilorama.Move) error {
+	return t.treeSvc.ReplicateTreeOp(ctx, cnr, treeID, op)
+}
+
 func initControlService(c *cfg) {
 	endpoint := controlconfig.GRPC(c.appCfg).Endpoint()
 	if endpoint == controlconfig.GRPCEndpointDefault {
@@ -36,7 +41,8 @@ func initControlService(c *cfg) {
 		rawPubs = append(rawPubs, pubs[i].Bytes())
 	}
 
-	ctlSvc := controlSvc.New(
+	opts := []controlSvc.Option{
+		controlSvc.WithLogger(c.log),
 		controlSvc.WithKey(&c.key.PrivateKey),
 		controlSvc.WithAuthorizedKeys(rawPubs),
 		controlSvc.WithHealthChecker(c),
@@ -45,10 +51,23 @@ func initControlService(c *cfg) {
 		controlSvc.WithReplicator(c.replicator),
 		controlSvc.WithNodeState(c),
 		controlSvc.WithLocalStorage(c.cfgObject.cfgLocalStorage.localStorage),
-		controlSvc.WithTreeService(treeSynchronizer{
+		controlSvc.WithShardManager(c),
+		controlSvc.WithQuotaSource(c.cfgObject.quota),
+	}
+
+	if c.treeService != nil {
+		opts = append(opts, controlSvc.WithTreeService(treeSynchronizer{
 			c.treeService,
-		}),
-	)
+		}))
+	}
+
+	ctlSvc := controlSvc.New(opts...)
+
+	c.workers = append(c.workers, newWorkerFromFunc(func(context.Context) {
+		if err := ctlSvc.ResumeInterruptedEvacuation(); err != nil {
+			c.log.Error("could not resume interrupted evacuation", zap.Error(err))
+		}
+	}))
 
 	lis, err := net.Listen("tcp", endpoint)
 	if err != nil {
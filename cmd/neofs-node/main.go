@@ -100,6 +100,7 @@ func initApp(c *cfg) {
 	initAndLog(c, "prometheus", initMetrics)
 	initAndLog(c, "tree", initTreeService)
 	initAndLog(c, "control", initControlService)
+	initAndLog(c, "tracing", initTracing)
 
 	initAndLog(c, "morph notifications", listenMorphNotifications)
 
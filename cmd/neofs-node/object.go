@@ -8,6 +8,7 @@ import (
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
 	objectGRPC "github.com/nspcc-dev/neofs-api-go/v2/object/grpc"
+	objectconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/object"
 	policerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/policer"
 	replicatorconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/replicator"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -29,6 +30,7 @@ import (
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	putsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/put/v2"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/quota"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	searchsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/search/v2"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
@@ -168,6 +170,41 @@ func (c *cfg) IsLocalNodeInNetmap() bool {
 	return c.localNodeInNetmap.Load()
 }
 
+type engineQuotaUsage struct {
+	engine *engine.StorageEngine
+}
+
+func (e engineQuotaUsage) ContainerSize(id cid.ID) (uint64, error) {
+	return engine.ContainerSize(e.engine, id)
+}
+
+func (e engineQuotaUsage) ListContainers() ([]cid.ID, error) {
+	return engine.ListContainers(e.engine)
+}
+
+func newQuotaChecker(c *cfg) *quota.Checker {
+	quotaCfg := objectconfig.Put(c.appCfg).Quota()
+
+	opts := []quota.Option{
+		quota.WithLogger(c.log),
+		quota.WithContainerSource(c.cfgObject.cnrSource),
+		quota.WithUsageSource(engineQuotaUsage{
+			engine: c.cfgObject.cfgLocalStorage.localStorage,
+		}),
+		quota.WithDefaultLimits(quotaCfg.Default()),
+	}
+
+	for id, limits := range quotaCfg.Containers() {
+		opts = append(opts, quota.WithContainerLimits(id, limits))
+	}
+
+	if c.metricsCollector != nil {
+		opts = append(opts, quota.WithMetrics(c.metricsCollector))
+	}
+
+	return quota.NewChecker(opts...)
+}
+
 func initObjectService(c *cfg) {
 	ls := c.cfgObject.cfgLocalStorage.localStorage
 	keyStorage := util.NewKeyStorage(&c.key.PrivateKey, c.privateTokenStore, c.cfgNetmap.state)
@@ -249,6 +286,9 @@ func initObjectService(c *cfg) {
 		policer.WithPool(c.cfgObject.pool.replication),
 		policer.WithNodeLoader(c),
 		policer.WithNetwork(c),
+		policer.WithObjectSource(objectSource{
+			svc: c.cfgObject.getSvc,
+		}),
 	)
 
 	traverseGen := util.NewTraverserGenerator(c.netMapSource, c.cfgObject.cnrSource, c)
@@ -268,6 +308,8 @@ func initObjectService(c *cfg) {
 		}
 	}
 
+	c.cfgObject.quota = newQuotaChecker(c)
+
 	sPut := putsvc.NewService(
 		putsvc.WithKeyStorage(keyStorage),
 		putsvc.WithClientConstructor(putConstructor),
@@ -278,6 +320,7 @@ func initObjectService(c *cfg) {
 		putsvc.WithNetmapKeys(c),
 		putsvc.WithNetworkState(c.cfgNetmap.state),
 		putsvc.WithWorkerPools(c.cfgObject.pool.putRemote),
+		putsvc.WithQuotaChecker(c.cfgObject.quota),
 		putsvc.WithLogger(c.log),
 	)
 
@@ -307,6 +350,7 @@ func initObjectService(c *cfg) {
 	sGet := getsvc.New(
 		getsvc.WithLogger(c.log),
 		getsvc.WithLocalStorageEngine(ls),
+		getsvc.WithContainerSource(c.cfgObject.cnrSource),
 		getsvc.WithClientConstructor(coreConstructor),
 		getsvc.WithTraverserGenerator(
 			traverseGen.WithTraverseOptions(
@@ -551,6 +595,26 @@ func (c *reputationClientConstructor) Get(info coreclient.NodeInfo) (coreclient.
 	return cl, nil
 }
 
+// objectSource is a policer.ObjectSource over the object GET service.
+type objectSource struct {
+	svc *getsvc.Service
+}
+
+func (s objectSource) Get(ctx context.Context, addr oid.Address) (*objectSDK.Object, error) {
+	w := getsvc.NewSimpleObjectWriter()
+
+	var prm getsvc.Prm
+	prm.WithAddress(addr)
+	prm.SetObjectWriter(w)
+	prm.SetCommonParameters(&util.CommonPrm{}) // default values are ok for that operation
+
+	if err := s.svc.Get(ctx, prm); err != nil {
+		return nil, err
+	}
+
+	return w.Object(), nil
+}
+
 type engineWithNotifications struct {
 	base putsvc.ObjectStorage
 	nw   notificationWriter
@@ -559,16 +623,16 @@ type engineWithNotifications struct {
 	defaultTopic string
 }
 
-func (e engineWithNotifications) Delete(tombstone oid.Address, toDelete []oid.ID) error {
-	return e.base.Delete(tombstone, toDelete)
+func (e engineWithNotifications) Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error {
+	return e.base.Delete(ctx, tombstone, toDelete)
 }
 
-func (e engineWithNotifications) Lock(locker oid.Address, toLock []oid.ID) error {
-	return e.base.Lock(locker, toLock)
+func (e engineWithNotifications) Lock(ctx context.Context, locker oid.Address, toLock []oid.ID) error {
+	return e.base.Lock(ctx, locker, toLock)
 }
 
-func (e engineWithNotifications) Put(o *objectSDK.Object) error {
-	if err := e.base.Put(o); err != nil {
+func (e engineWithNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	if err := e.base.Put(ctx, o); err != nil {
 		return err
 	}
 
@@ -592,7 +656,7 @@ type engineWithoutNotifications struct {
 	engine *engine.StorageEngine
 }
 
-func (e engineWithoutNotifications) Delete(tombstone oid.Address, toDelete []oid.ID) error {
+func (e engineWithoutNotifications) Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error {
 	var prm engine.InhumePrm
 
 	addrs := make([]oid.Address, len(toDelete))
@@ -602,15 +666,22 @@ func (e engineWithoutNotifications) Delete(tombstone oid.Address, toDelete []oid
 	}
 
 	prm.WithTarget(tombstone, addrs...)
+	prm.WithContext(ctx)
 
 	_, err := e.engine.Inhume(prm)
 	return err
 }
 
-func (e engineWithoutNotifications) Lock(locker oid.Address, toLock []oid.ID) error {
+func (e engineWithoutNotifications) Lock(_ context.Context, locker oid.Address, toLock []oid.ID) error {
 	return e.engine.Lock(locker.Container(), locker.Object(), toLock)
 }
 
-func (e engineWithoutNotifications) Put(o *objectSDK.Object) error {
-	return engine.Put(e.engine, o)
+func (e engineWithoutNotifications) Put(ctx context.Context, o *objectSDK.Object) error {
+	var prm engine.PutPrm
+
+	prm.WithObject(o)
+	prm.WithContext(ctx)
+
+	_, err := e.engine.Put(prm)
+	return err
 }
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+// runtimeShards contains the changes of the shard list made via
+// the control service. They are applied on top of the configuration
+// file on reload, so that SIGHUP does not revert them.
+type runtimeShards struct {
+	mtx sync.Mutex
+
+	// added contains the configurations of the attached shards by their config IDs.
+	added map[string]shardCfg
+	// removed contains the config IDs of the detached shards.
+	removed map[string]struct{}
+}
+
+// AddShard parses the shard configuration and attaches the shard
+// to the storage engine. The configuration has the same format as
+// a single shard section of the node configuration file.
+func (c *cfg) AddShard(data []byte) (*shard.ID, error) {
+	shCfg, err := c.readRuntimeShardConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard configuration: %w", err)
+	}
+
+	opts := c.shardOptsFromConfig(shCfg)
+
+	ls := &c.cfgObject.cfgLocalStorage
+	ls.runtimeShards.mtx.Lock()
+	defer ls.runtimeShards.mtx.Unlock()
+
+	id, err := ls.localStorage.AttachShard(append(opts.shOpts, shard.WithTombstoneSource(ls.tombstoneSource))...)
+	if err != nil {
+		return nil, err
+	}
+
+	if ls.runtimeShards.added == nil {
+		ls.runtimeShards.added = make(map[string]shardCfg)
+	}
+	ls.runtimeShards.added[opts.configID] = shCfg
+	delete(ls.runtimeShards.removed, opts.configID)
+
+	c.log.Info("shard attached to engine via control service", zap.Stringer("id", id))
+
+	return id, nil
+}
+
+// RemoveShards detaches the shards from the storage engine.
+func (c *cfg) RemoveShards(ids []*shard.ID) error {
+	ls := &c.cfgObject.cfgLocalStorage
+	ls.runtimeShards.mtx.Lock()
+	defer ls.runtimeShards.mtx.Unlock()
+
+	configIDs := make(map[string]string, len(ids))
+	for _, info := range ls.localStorage.DumpInfo().Shards {
+		configIDs[info.ID.String()] = shardConfigID(info)
+	}
+
+	err := ls.localStorage.DetachShards(ids)
+	if err != nil {
+		return err
+	}
+
+	if ls.runtimeShards.removed == nil {
+		ls.runtimeShards.removed = make(map[string]struct{})
+	}
+
+	for i := range ids {
+		configID := configIDs[ids[i].String()]
+
+		delete(ls.runtimeShards.added, configID)
+		ls.runtimeShards.removed[configID] = struct{}{}
+
+		c.log.Info("shard detached from engine via control service", zap.Stringer("id", ids[i]))
+	}
+
+	return nil
+}
+
+// readRuntimeShardConfig reads and validates the shard configuration
+// in YAML or JSON format.
+func (c *cfg) readRuntimeShardConfig(data []byte) (sh shardCfg, err error) {
+	raw, err := config.FromData(data)
+	if err != nil {
+		return sh, err
+	}
+
+	// config readers panic on invalid values
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	sc := shardconfig.From(raw)
+
+	if err := validateShardConfig(c.appCfg, sc, 0, make(map[string]pathDescription)); err != nil {
+		return sh, err
+	}
+
+	if sc.Mode() == mode.Disabled {
+		return sh, fmt.Errorf("shard is disabled")
+	}
+
+	return readShardConfig(c.appCfg, sc)
+}
+
+// reloadStorageEngine applies the shard configuration read from the
+// configuration file with the changes made via the control service.
+func (c *cfg) reloadStorageEngine() error {
+	ls := &c.cfgObject.cfgLocalStorage
+	ls.runtimeShards.mtx.Lock()
+	defer ls.runtimeShards.mtx.Unlock()
+
+	var rcfg engine.ReConfiguration
+	for _, optsWithID := range c.shardOpts() {
+		if _, ok := ls.runtimeShards.removed[optsWithID.configID]; ok {
+			continue
+		}
+		rcfg.AddShard(optsWithID.configID, optsWithID.shOpts)
+	}
+
+	for _, shCfg := range ls.runtimeShards.added {
+		optsWithID := c.shardOptsFromConfig(shCfg)
+		rcfg.AddShard(optsWithID.configID, append(optsWithID.shOpts, shard.WithTombstoneSource(ls.tombstoneSource)))
+	}
+
+	return ls.localStorage.Reload(rcfg)
+}
+
+// shardConfigID returns the identifier of the shard configuration.
+func shardConfigID(info shard.Info) string {
+	// This calculation should be kept in sync with shardCfg.id().
+	var sb strings.Builder
+	for _, sub := range info.BlobStorInfo.SubStorages {
+		sb.WriteString(filepath.Clean(sub.Path))
+	}
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRuntimeShardConfig(t *testing.T) {
+	var c cfg
+	c.appCfg = configtest.EmptyConfig()
+
+	const valid = `
+metabase:
+  path: /storage/metabase
+blobstor:
+  - type: blobovnicza
+    path: /storage/blobovnicza
+  - type: fstree
+    path: /storage/fstree
+`
+
+	sh, err := c.readRuntimeShardConfig([]byte(valid))
+	require.NoError(t, err)
+	require.Equal(t, "/storage/metabase", sh.metaCfg.path)
+	require.Len(t, sh.subStorages, 2)
+	require.Equal(t, "/storage/blobovnicza/storage/fstree", sh.id())
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, data := range map[string]string{
+			"format":       "{metabase",
+			"disabled":     valid + "mode: disabled\n",
+			"no metabase":  "blobstor:\n  - type: fstree\n    path: /a\n  - type: fstree\n    path: /b\n",
+			"same paths":   "metabase:\n  path: /a\nblobstor:\n  - type: fstree\n    path: /a\n  - type: fstree\n    path: /b\n",
+			"unknown type": "metabase:\n  path: /m\nblobstor:\n  - type: unknown\n    path: /a\n  - type: fstree\n    path: /b\n",
+		} {
+			_, err := c.readRuntimeShardConfig([]byte(data))
+			require.Error(t, err, name)
+		}
+	})
+}
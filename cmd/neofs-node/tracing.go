@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/nspcc-dev/neofs-node/misc"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.uber.org/zap"
+)
+
+func initTracing(c *cfg) {
+	if !tracingconfig.Enabled(c.appCfg) {
+		c.log.Info("tracing is disabled")
+		return
+	}
+
+	shutdown, err := tracing.Setup(tracing.Config{
+		Exporter:      tracingconfig.Exporter(c.appCfg),
+		Endpoint:      tracingconfig.Endpoint(c.appCfg),
+		Timeout:       tracingconfig.Timeout(c.appCfg),
+		Path:          tracingconfig.Path(c.appCfg),
+		SamplingRatio: tracingconfig.SamplingRatio(c.appCfg),
+		Service:       "neofs-node",
+		Version:       misc.Version,
+	})
+	fatalOnErr(err)
+
+	// registered after the gRPC servers, so the spans of
+	// the requests served during the shutdown are flushed
+	c.onShutdown(func() {
+		c.log.Debug("shutting down tracing")
+
+		err := shutdown(context.Background())
+		if err != nil {
+			c.log.Debug("could not shutdown tracing",
+				zap.String("error", err.Error()),
+			)
+		}
+	})
+}
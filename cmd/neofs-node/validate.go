@@ -10,6 +10,7 @@ import (
 	loggerconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/logger"
 	treeconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 )
@@ -25,61 +26,81 @@ func validateConfig(c *config.Config) error {
 		return fmt.Errorf("invalid logger level: %w", err)
 	}
 
+	// storage engine configuration validation
+
+	if wm := engineconfig.ShardHighWatermark(c); wm > 100 {
+		return fmt.Errorf("invalid shard high watermark: %d%% is greater than 100%%", wm)
+	}
+
 	// shard configuration validation
 
 	shardNum := 0
 	paths := make(map[string]pathDescription)
 	return engineconfig.IterateShards(c, false, func(sc *shardconfig.Config) error {
-		if sc.WriteCache().Enabled() {
-			err := addPath(paths, "writecache", shardNum, sc.WriteCache().Path())
-			if err != nil {
-				return err
-			}
+		if err := validateShardConfig(c, sc, shardNum, paths); err != nil {
+			return err
 		}
 
-		if err := addPath(paths, "metabase", shardNum, sc.Metabase().Path()); err != nil {
+		shardNum++
+		return nil
+	})
+}
+
+// validateShardConfig validates the shard section of the configuration.
+// Paths of the shard components are checked to be unique and added to paths.
+func validateShardConfig(c *config.Config, sc *shardconfig.Config, shardNum int, paths map[string]pathDescription) error {
+	if sc.WriteCache().Enabled() {
+		err := addPath(paths, "writecache", shardNum, sc.WriteCache().Path())
+		if err != nil {
 			return err
 		}
+	}
+
+	if err := addPath(paths, "metabase", shardNum, sc.Metabase().Path()); err != nil {
+		return err
+	}
 
-		treeConfig := treeconfig.Tree(c)
-		if treeConfig.Enabled() {
-			err := addPath(paths, "pilorama", shardNum, sc.Pilorama().Path())
-			if err != nil {
-				return err
-			}
+	treeConfig := treeconfig.Tree(c)
+	if treeConfig.Enabled() {
+		err := addPath(paths, "pilorama", shardNum, sc.Pilorama().Path())
+		if err != nil {
+			return err
 		}
+	}
 
-		blobstor := sc.BlobStor().Storages()
-		if len(blobstor) != 2 {
-			// TODO (@fyrcik): remove after #1522
-			return fmt.Errorf("blobstor section must have 2 components, got: %d", len(blobstor))
+	blobstor := sc.BlobStor().Storages()
+	if len(blobstor) != 2 {
+		// TODO (@fyrcik): remove after #1522
+		return fmt.Errorf("blobstor section must have 2 components, got: %d", len(blobstor))
+	}
+	for i := range blobstor {
+		switch blobstor[i].Type() {
+		case fstree.Type, blobovniczatree.Type:
+		default:
+			// FIXME #1764 (@fyrchik): this line is currently unreachable,
+			//   because we panic in `sc.BlobStor().Storages()`.
+			return fmt.Errorf("unexpected storage type: %s (shard %d)",
+				blobstor[i].Type(), shardNum)
+		}
+		if blobstor[i].Perm()&0600 != 0600 {
+			return fmt.Errorf("invalid permissions for blobstor component: %s, "+
+				"expected at least rw- for the owner (shard %d)",
+				blobstor[i].Perm(), shardNum)
+		}
+		if codec := blobstor[i].CompressionCodec(); codec != "" && !compression.IsKnownCodec(codec) {
+			return fmt.Errorf("unknown compression codec for blobstor component: %s (shard %d)",
+				codec, shardNum)
+		}
+		if blobstor[i].Path() == "" {
+			return fmt.Errorf("blobstor component path is empty (shard %d)", shardNum)
 		}
-		for i := range blobstor {
-			switch blobstor[i].Type() {
-			case fstree.Type, blobovniczatree.Type:
-			default:
-				// FIXME #1764 (@fyrchik): this line is currently unreachable,
-				//   because we panic in `sc.BlobStor().Storages()`.
-				return fmt.Errorf("unexpected storage type: %s (shard %d)",
-					blobstor[i].Type(), shardNum)
-			}
-			if blobstor[i].Perm()&0600 != 0600 {
-				return fmt.Errorf("invalid permissions for blobstor component: %s, "+
-					"expected at least rw- for the owner (shard %d)",
-					blobstor[i].Perm(), shardNum)
-			}
-			if blobstor[i].Path() == "" {
-				return fmt.Errorf("blobstor component path is empty (shard %d)", shardNum)
-			}
-			err := addPath(paths, fmt.Sprintf("blobstor[%d]", i), shardNum, blobstor[i].Path())
-			if err != nil {
-				return err
-			}
+		err := addPath(paths, fmt.Sprintf("blobstor[%d]", i), shardNum, blobstor[i].Path())
+		if err != nil {
+			return err
 		}
+	}
 
-		shardNum++
-		return nil
-	})
+	return nil
 }
 
 type pathDescription struct {
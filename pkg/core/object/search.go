@@ -0,0 +1,47 @@
+package object
+
+import (
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// Numeric search matchers. They compare the header values as decimal
+// integers. The values follow the string matchers in the NeoFS API
+// enumeration, SDK doesn't provide them yet.
+//
+// The matchers are node-local: they are kept only by SearchFiltersFromV2,
+// while SDK conversions (object.SearchMatchFromV2 and so on) turn them into
+// object.MatchUnknown. Filters with unknown matchers match no objects, so
+// numeric filters converted by the SDK silently select nothing.
+const (
+	// MatchNumGT matches the values greater than the filter value.
+	MatchNumGT object.SearchMatchType = object.MatchCommonPrefix + 1 + iota
+	// MatchNumGE matches the values greater than or equal to the filter value.
+	MatchNumGE
+	// MatchNumLT matches the values less than the filter value.
+	MatchNumLT
+	// MatchNumLE matches the values less than or equal to the filter value.
+	MatchNumLE
+)
+
+// IsNumericMatcher checks whether the matcher compares the values as integers.
+func IsNumericMatcher(m object.SearchMatchType) bool {
+	return m >= MatchNumGT && m <= MatchNumLE
+}
+
+// SearchFiltersFromV2 converts search filters from the NeoFS API V2 format.
+// Unlike object.NewSearchFiltersFromV2, it keeps the numeric matchers.
+func SearchFiltersFromV2(fs []v2object.SearchFilter) object.SearchFilters {
+	res := make(object.SearchFilters, 0, len(fs))
+
+	for i := range fs {
+		m := object.SearchMatchType(fs[i].GetMatchType())
+		if !IsNumericMatcher(m) {
+			m = object.SearchMatchFromV2(fs[i].GetMatchType())
+		}
+
+		res.AddFilter(fs[i].GetKey(), fs[i].GetValue(), m)
+	}
+
+	return res
+}
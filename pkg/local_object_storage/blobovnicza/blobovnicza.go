@@ -3,6 +3,7 @@ package blobovnicza
 import (
 	"io/fs"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
@@ -17,7 +18,20 @@ type Blobovnicza struct {
 
 	filled atomic.Uint64
 
-	boltDB *bbolt.DB
+	// boltMtx protects boltDB from being replaced
+	// by the compaction while it is in use.
+	boltMtx sync.RWMutex
+	boltDB  *bbolt.DB
+	closed  bool
+
+	// live is the size of the stored objects along with their keys,
+	// it is calculated on open and is maintained by Put and Delete.
+	live atomic.Uint64
+
+	// changes contains the objects modified during the compaction,
+	// nil if there is no compaction in progress.
+	changesMtx sync.Mutex
+	changes    map[compactionChange]struct{}
 }
 
 // Option is an option of Blobovnicza's constructor.
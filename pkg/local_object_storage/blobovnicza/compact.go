@@ -0,0 +1,414 @@
+package blobovnicza
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// CompactPrm groups the parameters of Compact operation.
+type CompactPrm struct {
+	ctx context.Context
+
+	rateLimit uint64
+}
+
+// CompactRes groups the resulting values of Compact operation.
+type CompactRes struct {
+	sizeBefore, sizeAfter uint64
+}
+
+// SetContext sets the context to interrupt the compaction.
+func (p *CompactPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
+// SetRateLimit sets the maximum number of bytes rewritten per second.
+// Zero value means no limit.
+func (p *CompactPrm) SetRateLimit(bytesPerSec uint64) {
+	p.rateLimit = bytesPerSec
+}
+
+// Reclaimed returns the number of bytes of the disk space freed by the compaction.
+func (r CompactRes) Reclaimed() uint64 {
+	if r.sizeAfter < r.sizeBefore {
+		return r.sizeBefore - r.sizeAfter
+	}
+	return 0
+}
+
+// Usage describes the disk space usage of Blobovnicza.
+type Usage struct {
+	size, live uint64
+}
+
+// Size returns the size of the database file.
+func (u Usage) Size() uint64 {
+	return u.size
+}
+
+// Live returns the size of the stored objects along with their keys.
+// The size is estimated from the database pages on open and is kept
+// up to date by the subsequent changes.
+func (u Usage) Live() uint64 {
+	return u.live
+}
+
+// Dead returns the size of the database file not occupied by the stored objects.
+func (u Usage) Dead() uint64 {
+	if u.live < u.size {
+		return u.size - u.live
+	}
+	return 0
+}
+
+// DeadRatio returns the fraction of the database file not occupied by the stored objects.
+func (u Usage) DeadRatio() float64 {
+	if u.size == 0 {
+		return 0
+	}
+	return float64(u.Dead()) / float64(u.size)
+}
+
+// compactionChunkSize is the amount of data rewritten
+// in a single transaction during the compaction.
+const compactionChunkSize = 4 << 20
+
+// errClosed is returned when Blobovnicza is closed during the compaction.
+var errClosed = errors.New("blobovnicza is closed")
+
+// Usage returns the disk space usage of Blobovnicza.
+func (b *Blobovnicza) Usage() (Usage, error) {
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return Usage{}, fmt.Errorf("can't determine DB size: %w", err)
+	}
+
+	return Usage{
+		size: uint64(info.Size()),
+		live: b.live.Load(),
+	}, nil
+}
+
+// liveSize returns the size of the data stored in the buckets. Bucket
+// statistics are collected from the page headers, so the values
+// are not read.
+func liveSize(tx *bbolt.Tx) uint64 {
+	var res uint64
+
+	_ = tx.ForEach(func(_ []byte, buck *bbolt.Bucket) error {
+		st := buck.Stats()
+		res += uint64(st.LeafInuse + st.InlineBucketInuse)
+		return nil
+	})
+
+	return res
+}
+
+// compactionChange is an object modified during the compaction.
+type compactionChange struct {
+	bucket, key string
+}
+
+// trackChange remembers the object modified during the compaction,
+// so it is synchronized with the compacted database. Must be called
+// with the read lock of boltMtx held.
+func (b *Blobovnicza) trackChange(bucket, key []byte) {
+	b.changesMtx.Lock()
+	if b.changes != nil {
+		b.changes[compactionChange{bucket: string(bucket), key: string(key)}] = struct{}{}
+	}
+	b.changesMtx.Unlock()
+}
+
+func (b *Blobovnicza) setCompactionChanges(m map[compactionChange]struct{}) {
+	b.changesMtx.Lock()
+	b.changes = m
+	b.changesMtx.Unlock()
+}
+
+// Compact rewrites all the stored objects into a fresh database and replaces
+// the database file with it. It allows to return the space occupied by the
+// removed objects to the file system.
+//
+// Objects are rewritten in chunks, so Blobovnicza is available for reading
+// and writing during the compaction. The objects modified after the
+// compaction start are synchronized with the new database before the
+// replacement.
+//
+// Should not be called in read-only configuration.
+func (b *Blobovnicza) Compact(prm CompactPrm) (CompactRes, error) {
+	ctx := prm.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	tmpPath := b.path + ".compact"
+
+	// leftover of the interrupted compaction
+	if err := os.Remove(tmpPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return CompactRes{}, fmt.Errorf("could not remove temporary database: %w", err)
+	}
+
+	dst, err := bbolt.Open(tmpPath, b.perm, &bbolt.Options{
+		Timeout: b.boltOptions.Timeout,
+		NoSync:  true,
+	})
+	if err != nil {
+		return CompactRes{}, fmt.Errorf("could not open temporary database: %w", err)
+	}
+
+	swapped := false
+	defer func() {
+		if !swapped {
+			_ = dst.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	b.setCompactionChanges(make(map[compactionChange]struct{}))
+	defer b.setCompactionChanges(nil)
+
+	var (
+		cur     compactionCursor
+		copied  uint64
+		started = time.Now()
+	)
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return CompactRes{}, err
+		}
+
+		n, done, err := b.copyChunk(dst, &cur)
+		if err != nil {
+			return CompactRes{}, fmt.Errorf("could not rewrite objects: %w", err)
+		} else if done {
+			break
+		}
+
+		copied += n
+
+		if prm.rateLimit > 0 {
+			expected := time.Duration(float64(copied) / float64(prm.rateLimit) * float64(time.Second))
+			if d := expected - time.Since(started); d > 0 {
+				select {
+				case <-ctx.Done():
+					return CompactRes{}, ctx.Err()
+				case <-time.After(d):
+				}
+			}
+		}
+	}
+
+	b.boltMtx.Lock()
+	defer b.boltMtx.Unlock()
+
+	if b.closed {
+		return CompactRes{}, errClosed
+	}
+
+	// no changes are made while the lock is held
+	if changes := b.changes; len(changes) != 0 {
+		err = b.boltDB.View(func(tx *bbolt.Tx) error {
+			return dst.Update(func(dstTx *bbolt.Tx) error {
+				return syncChanges(tx, dstTx, changes)
+			})
+		})
+		if err != nil {
+			return CompactRes{}, fmt.Errorf("could not apply concurrent changes: %w", err)
+		}
+	}
+
+	var res CompactRes
+
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return CompactRes{}, fmt.Errorf("can't determine DB size: %w", err)
+	}
+	res.sizeBefore = uint64(info.Size())
+
+	if err := dst.Sync(); err != nil {
+		return CompactRes{}, fmt.Errorf("could not sync temporary database: %w", err)
+	}
+
+	swapped = true
+
+	if err := dst.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return CompactRes{}, fmt.Errorf("could not close temporary database: %w", err)
+	}
+
+	if err := b.boltDB.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		b.closed = true
+		return CompactRes{}, fmt.Errorf("could not close database: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, b.path); err != nil {
+		_ = os.Remove(tmpPath)
+		// database file is untouched, bring it back
+		if db, openErr := bbolt.Open(b.path, b.perm, b.boltOptions); openErr == nil {
+			b.boltDB = db
+		} else {
+			b.closed = true
+		}
+		return CompactRes{}, fmt.Errorf("could not replace database file: %w", err)
+	}
+
+	// the rename is not durable until the directory entry is synchronized
+	if err := syncDir(filepath.Dir(b.path)); err != nil {
+		b.log.Warn("could not sync blobovnicza directory",
+			zap.String("path", b.path),
+			zap.Error(err),
+		)
+	}
+
+	db, err := bbolt.Open(b.path, b.perm, b.boltOptions)
+	if err != nil {
+		b.closed = true
+		return CompactRes{}, fmt.Errorf("could not open compacted database: %w", err)
+	}
+
+	b.boltDB = db
+
+	info, err = os.Stat(b.path)
+	if err != nil {
+		return CompactRes{}, fmt.Errorf("can't determine DB size: %w", err)
+	}
+	res.sizeAfter = uint64(info.Size())
+
+	b.filled.Store(res.sizeAfter)
+
+	b.log.Debug("blobovnicza compacted",
+		zap.String("path", b.path),
+		zap.Uint64("size before", res.sizeBefore),
+		zap.Uint64("size after", res.sizeAfter),
+	)
+
+	return res, nil
+}
+
+// syncDir flushes the directory entries to the disk.
+func syncDir(p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+
+	err = f.Sync()
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// compactionCursor points to the last rewritten object.
+type compactionCursor struct {
+	bucket, key []byte
+}
+
+// copyChunk rewrites up to compactionChunkSize bytes of objects following
+// the cursor into dst and moves the cursor. Returns true if there are
+// no more objects to rewrite.
+func (b *Blobovnicza) copyChunk(dst *bbolt.DB, cur *compactionCursor) (uint64, bool, error) {
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
+	if b.closed {
+		return 0, false, errClosed
+	}
+
+	var (
+		n    uint64
+		done bool
+	)
+
+	err := b.boltDB.View(func(tx *bbolt.Tx) error {
+		return dst.Update(func(dstTx *bbolt.Tx) error {
+			c := tx.Cursor()
+
+			name, _ := c.First()
+			if cur.bucket != nil {
+				name, _ = c.Seek(cur.bucket)
+			}
+
+			for ; name != nil; name, _ = c.Next() {
+				buck := tx.Bucket(name)
+				if buck == nil {
+					continue
+				}
+
+				dstBuck, err := dstTx.CreateBucketIfNotExists(name)
+				if err != nil {
+					return fmt.Errorf("could not create bucket: %w", err)
+				}
+				// buckets are filled sequentially and mostly receive removals after the compaction
+				dstBuck.FillPercent = 1
+
+				bc := buck.Cursor()
+
+				k, v := bc.First()
+				if bytes.Equal(name, cur.bucket) && cur.key != nil {
+					if k, v = bc.Seek(cur.key); bytes.Equal(k, cur.key) {
+						k, v = bc.Next()
+					}
+				}
+
+				for ; k != nil; k, v = bc.Next() {
+					if err := dstBuck.Put(k, v); err != nil {
+						return fmt.Errorf("could not save object: %w", err)
+					}
+
+					if n += uint64(len(k) + len(v)); n >= compactionChunkSize {
+						cur.bucket = slice.Copy(name)
+						cur.key = slice.Copy(k)
+						return nil
+					}
+				}
+			}
+
+			done = true
+			return nil
+		})
+	})
+
+	return n, done, err
+}
+
+// syncChanges makes the changed objects in dst equal to the src ones.
+func syncChanges(src, dst *bbolt.Tx, changes map[compactionChange]struct{}) error {
+	for ch := range changes {
+		bucket, key := []byte(ch.bucket), []byte(ch.key)
+
+		dstBuck, err := dst.CreateBucketIfNotExists(bucket)
+		if err != nil {
+			return fmt.Errorf("could not create bucket: %w", err)
+		}
+
+		var v []byte
+		if buck := src.Bucket(bucket); buck != nil {
+			v = buck.Get(key)
+		}
+
+		if v != nil {
+			err = dstBuck.Put(key, v)
+		} else {
+			err = dstBuck.Delete(key)
+		}
+		if err != nil {
+			return fmt.Errorf("could not save object: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package blobovnicza
+
+import (
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobovnicza_Compact(t *testing.T) {
+	const objSize = 64 << 10
+
+	blz := New(
+		WithPath(filepath.Join(t.TempDir(), "blz")),
+		WithObjectSizeLimit(objSize),
+		WithFullSizeLimit(1<<30),
+		WithLogger(test.NewLogger(false)),
+	)
+	require.NoError(t, blz.Open())
+	require.NoError(t, blz.Init())
+	t.Cleanup(func() { require.NoError(t, blz.Close()) })
+
+	objects := make(map[oid.Address][]byte)
+	for i := 0; i < 200; i++ {
+		data := make([]byte, objSize)
+		rand.Read(data)
+
+		addr := oidtest.Address()
+		putObject(t, blz, addr, data)
+		objects[addr] = data
+	}
+
+	var removed []oid.Address
+	for addr := range objects {
+		if len(removed) == 150 {
+			break
+		}
+		deleteObject(t, blz, addr)
+		removed = append(removed, addr)
+		delete(objects, addr)
+	}
+
+	usage, err := blz.Usage()
+	require.NoError(t, err)
+	require.Greater(t, usage.DeadRatio(), 0.5)
+	require.GreaterOrEqual(t, usage.Live(), uint64(len(objects)*objSize))
+	require.Less(t, usage.Live(), uint64((len(objects)+1)*objSize))
+
+	// changes made concurrently with the compaction must not be lost
+	var prm CompactPrm
+	prm.SetRateLimit(8 << 20)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for addr := range objects {
+			deleteObject(t, blz, addr)
+			removed = append(removed, addr)
+			delete(objects, addr)
+			break
+		}
+
+		data := make([]byte, objSize)
+		rand.Read(data)
+
+		addr := oidtest.Address()
+		putObject(t, blz, addr, data)
+		objects[addr] = data
+	}()
+
+	res, err := blz.Compact(prm)
+	require.NoError(t, err)
+	require.Greater(t, res.Reclaimed(), uint64(0))
+	<-done
+
+	newUsage, err := blz.Usage()
+	require.NoError(t, err)
+	require.Less(t, newUsage.Size(), usage.Size())
+	require.GreaterOrEqual(t, newUsage.Live(), uint64(len(objects)*objSize))
+	require.Less(t, newUsage.Live(), uint64((len(objects)+1)*objSize))
+
+	for addr, data := range objects {
+		testGet(t, blz, addr, data, nil)
+	}
+	for i := range removed {
+		testGet(t, blz, removed[i], nil, IsErrNotFound)
+	}
+
+	t.Run("closed", func(t *testing.T) {
+		blz := New(WithPath(filepath.Join(t.TempDir(), "blz")))
+		require.NoError(t, blz.Open())
+		require.NoError(t, blz.Init())
+		require.NoError(t, blz.Close())
+
+		_, err := blz.Compact(CompactPrm{})
+		require.ErrorIs(t, err, errClosed)
+	})
+}
+
+func putObject(t *testing.T, blz *Blobovnicza, addr oid.Address, data []byte) {
+	var prm PutPrm
+	prm.SetAddress(addr)
+	prm.SetMarshaledObject(data)
+
+	_, err := blz.Put(prm)
+	require.NoError(t, err)
+}
+
+func deleteObject(t *testing.T, blz *Blobovnicza, addr oid.Address) {
+	var prm DeletePrm
+	prm.SetAddress(addr)
+
+	_, err := blz.Delete(prm)
+	require.NoError(t, err)
+}
@@ -33,9 +33,19 @@ func (b *Blobovnicza) Open() error {
 		zap.Stringer("permissions", b.perm),
 	)
 
+	b.boltMtx.Lock()
+	defer b.boltMtx.Unlock()
+
 	b.boltDB, err = bbolt.Open(b.path, b.perm, b.boltOptions)
+	b.closed = err != nil
+	if err != nil {
+		return err
+	}
 
-	return err
+	return b.boltDB.View(func(tx *bbolt.Tx) error {
+		b.live.Store(liveSize(tx))
+		return nil
+	})
 }
 
 // Init initializes internal database structure.
@@ -54,6 +64,9 @@ func (b *Blobovnicza) Init() error {
 		return nil
 	}
 
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
 	err := b.boltDB.Update(func(tx *bbolt.Tx) error {
 		return b.iterateBucketKeys(func(lower, upper uint64, key []byte) (bool, error) {
 			// create size range bucket
@@ -90,5 +103,10 @@ func (b *Blobovnicza) Close() error {
 		zap.String("path", b.path),
 	)
 
+	b.boltMtx.Lock()
+	defer b.boltMtx.Unlock()
+
+	b.closed = true
+
 	return b.boltDB.Close()
 }
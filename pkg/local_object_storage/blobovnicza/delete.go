@@ -32,7 +32,13 @@ func (p *DeletePrm) SetAddress(addr oid.Address) {
 func (b *Blobovnicza) Delete(prm DeletePrm) (DeleteRes, error) {
 	addrKey := addressKey(prm.addr)
 
-	removed := false
+	var (
+		removed    bool
+		bucketName []byte
+	)
+
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
 
 	err := b.boltDB.Update(func(tx *bbolt.Tx) error {
 		return b.iterateBuckets(tx, func(lower, upper uint64, buck *bbolt.Bucket) (bool, error) {
@@ -58,12 +64,18 @@ func (b *Blobovnicza) Delete(prm DeletePrm) (DeleteRes, error) {
 			}
 
 			removed = true
+			bucketName = bucketKeyFromBounds(upper)
+			b.live.Sub(uint64(len(addrKey)) + sz)
 
 			// stop iteration
 			return true, err
 		})
 	})
 
+	if removed {
+		b.trackChange(bucketName, addrKey)
+	}
+
 	if err == nil && !removed {
 		var errNotFound apistatus.ObjectNotFound
 
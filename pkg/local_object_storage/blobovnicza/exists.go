@@ -7,6 +7,9 @@ import (
 
 // Exists check if object with the specified address is stored in b.
 func (b *Blobovnicza) Exists(addr oid.Address) (bool, error) {
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
 	var (
 		exists  bool
 		addrKey = addressKey(addr)
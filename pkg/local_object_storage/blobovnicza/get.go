@@ -40,6 +40,9 @@ var errInterruptForEach = errors.New("interrupt for-each")
 // Returns an error of type apistatus.ObjectNotFound if the requested object is not
 // presented in Blobovnicza.
 func (b *Blobovnicza) Get(prm GetPrm) (GetRes, error) {
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
 	var (
 		data    []byte
 		addrKey = addressKey(prm.addr)
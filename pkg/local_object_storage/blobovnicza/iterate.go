@@ -118,6 +118,9 @@ type IterateRes struct {
 //
 // Handler should not retain object data. Handler must not be nil.
 func (b *Blobovnicza) Iterate(prm IteratePrm) (IterateRes, error) {
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
 	var elem IterationElement
 
 	if err := b.boltDB.View(func(tx *bbolt.Tx) error {
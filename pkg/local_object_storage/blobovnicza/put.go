@@ -52,6 +52,11 @@ func (b *Blobovnicza) Put(prm PutPrm) (PutRes, error) {
 	bucketName := bucketForSize(sz)
 	key := addressKey(prm.addr)
 
+	b.boltMtx.RLock()
+	defer b.boltMtx.RUnlock()
+
+	var replaced uint64
+
 	err := b.boltDB.Batch(func(tx *bbolt.Tx) error {
 		if b.full() {
 			return ErrFull
@@ -65,6 +70,11 @@ func (b *Blobovnicza) Put(prm PutPrm) (PutRes, error) {
 			return logicerr.Wrap(fmt.Errorf("(%T) bucket for size %d not created", b, sz))
 		}
 
+		replaced = 0
+		if old := buck.Get(key); old != nil {
+			replaced = uint64(len(key) + len(old))
+		}
+
 		// save the object in bucket
 		if err := buck.Put(key, prm.objData); err != nil {
 			return fmt.Errorf("(%T) could not save object in bucket: %w", b, err)
@@ -74,6 +84,8 @@ func (b *Blobovnicza) Put(prm PutPrm) (PutRes, error) {
 	})
 	if err == nil {
 		b.incSize(sz)
+		b.live.Add(uint64(len(key)) + sz - replaced)
+		b.trackChange(bucketName, key)
 	}
 
 	return PutRes{}, err
@@ -46,6 +46,12 @@ func (b *Blobovnicza) decSize(sz uint64) {
 	b.filled.Sub(sz)
 }
 
+// IsFull checks whether the size of the stored objects reached the
+// configured limit, so no more objects can be put.
+func (b *Blobovnicza) IsFull() bool {
+	return b.full()
+}
+
 func (b *Blobovnicza) full() bool {
 	return b.filled.Load() >= b.fullSizeLimit
 }
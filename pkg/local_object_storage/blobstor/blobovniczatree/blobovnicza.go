@@ -76,6 +76,10 @@ type Blobovniczas struct {
 	// list of active (opened, non-filled) Blobovniczas
 	activeMtx sync.RWMutex
 	active    map[string]blobovniczaWithIndex
+	// indices of the filled Blobovniczas which have free space after
+	// the compaction, they are activated before the new ones.
+	// Protected by activeMtx.
+	reclaimed map[string]map[uint64]struct{}
 }
 
 type blobovniczaWithIndex struct {
@@ -84,7 +88,10 @@ type blobovniczaWithIndex struct {
 	blz *blobovnicza.Blobovnicza
 }
 
-var _ common.Storage = (*Blobovniczas)(nil)
+var (
+	_ common.Storage   = (*Blobovniczas)(nil)
+	_ common.Compactor = (*Blobovniczas)(nil)
+)
 
 var errPutFailed = errors.New("could not save the object in any blobovnicza")
 
@@ -127,6 +134,7 @@ func NewBlobovniczaTree(opts ...Option) (blz *Blobovniczas) {
 
 	blz.opened = cache
 	blz.active = make(map[string]blobovniczaWithIndex, cp)
+	blz.reclaimed = make(map[string]map[uint64]struct{})
 
 	return blz
 }
@@ -159,24 +167,32 @@ func (b *Blobovniczas) updateAndGet(lvlPath string, old *uint64) (blobovniczaWit
 	active, ok := b.active[lvlPath]
 	b.activeMtx.RUnlock()
 
+	var fromReclaimed bool
+
 	if ok {
-		if old != nil {
-			if active.ind == b.blzShallowWidth-1 {
-				return active, logicerr.New("no more Blobovniczas")
-			} else if active.ind != *old {
-				// sort of CAS in order to control concurrent
-				// updateActive calls
-				return active, nil
-			}
-		} else {
+		if old == nil {
+			return active, nil
+		} else if active.ind != *old {
+			// sort of CAS in order to control concurrent
+			// updateActive calls
 			return active, nil
 		}
 
-		active.ind++
+		var ind uint64
+		if ind, fromReclaimed = b.takeReclaimed(lvlPath); fromReclaimed {
+			active.ind = ind
+		} else if active.ind == b.blzShallowWidth-1 {
+			return active, logicerr.New("no more Blobovniczas")
+		} else {
+			active.ind++
+		}
 	}
 
 	var err error
 	if active.blz, err = b.openBlobovnicza(filepath.Join(lvlPath, u64ToHexString(active.ind))); err != nil {
+		if fromReclaimed {
+			b.reclaim(lvlPath, active.ind)
+		}
 		return active, err
 	}
 
@@ -185,10 +201,16 @@ func (b *Blobovniczas) updateAndGet(lvlPath string, old *uint64) (blobovniczaWit
 
 	// check 2nd time to find out if it blobovnicza was activated while thread was locked
 	tryActive, ok := b.active[lvlPath]
-	if ok && tryActive.blz == active.blz {
+	if ok && (tryActive.blz == active.blz || old != nil && tryActive.ind != *old) {
+		if fromReclaimed {
+			b.addReclaimed(lvlPath, active.ind)
+		}
 		return tryActive, nil
 	}
 
+	// the blobovnicza is active now, so it is not reused once more
+	delete(b.reclaimed[lvlPath], active.ind)
+
 	// Remove from opened cache (active blobovnicza should always be opened).
 	// Because `onEvict` callback is called in `Remove`, we need to update
 	// active map beforehand.
@@ -208,6 +230,55 @@ func (b *Blobovniczas) updateAndGet(lvlPath string, old *uint64) (blobovniczaWit
 	return active, nil
 }
 
+// reclaim remembers that the filled blobovnicza with index ind on the lvlPath
+// level has free space, so it is activated once the active one is filled.
+// Blobovniczas following the active one are activated in order anyway.
+func (b *Blobovniczas) reclaim(lvlPath string, ind uint64) {
+	b.activeMtx.Lock()
+	defer b.activeMtx.Unlock()
+
+	if active, ok := b.active[lvlPath]; !ok || active.ind <= ind {
+		return
+	}
+
+	b.addReclaimed(lvlPath, ind)
+}
+
+// addReclaimed must be called with activeMtx held.
+func (b *Blobovniczas) addReclaimed(lvlPath string, ind uint64) {
+	m, ok := b.reclaimed[lvlPath]
+	if !ok {
+		m = make(map[uint64]struct{})
+		b.reclaimed[lvlPath] = m
+	}
+
+	m[ind] = struct{}{}
+}
+
+// takeReclaimed removes and returns the lowest index of the reclaimed
+// blobovnicza on the lvlPath level. Returns false if there is none.
+func (b *Blobovniczas) takeReclaimed(lvlPath string) (uint64, bool) {
+	b.activeMtx.Lock()
+	defer b.activeMtx.Unlock()
+
+	var (
+		res   uint64
+		found bool
+	)
+
+	for ind := range b.reclaimed[lvlPath] {
+		if !found || ind < res {
+			res, found = ind, true
+		}
+	}
+
+	if found {
+		delete(b.reclaimed[lvlPath], res)
+	}
+
+	return res, found
+}
+
 // returns hash of the object address.
 func addressHash(addr *oid.Address, path string) uint64 {
 	var a string
@@ -0,0 +1,122 @@
+package blobovniczatree
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"go.uber.org/zap"
+)
+
+// compactionMinDeadSpace is the minimum size of the space to be reclaimed
+// for a blobovnicza to be compacted. Compaction of the small blobovniczas
+// doesn't make much sense.
+const compactionMinDeadSpace = 1 << 20
+
+// Compact implements common.Compactor. It rewrites the blobovniczas with
+// the dead space ratio exceeding the threshold. Active blobovniczas are
+// skipped since they are still being filled.
+//
+// Compacted database replaces the original one under the same path, so
+// storage IDs of the objects are not changed and the metabase remains
+// valid. Compacted blobovniczas below the size limit are activated again
+// once the active ones are filled.
+//
+// Errors of the particular blobovniczas are logged and skipped.
+func (b *Blobovniczas) Compact(prm common.CompactPrm) (common.CompactRes, error) {
+	if b.readOnly {
+		return common.CompactRes{}, common.ErrReadOnly
+	}
+
+	ctx := prm.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var res common.CompactRes
+
+	err := b.iterateLeaves(func(p string) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return true, err
+		}
+
+		if b.isActive(p) {
+			return false, nil
+		}
+
+		// do not create blobovniczas which have not been used yet
+		if _, err := os.Stat(filepath.Join(b.rootPath, p)); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				b.log.Debug("could not stat blobovnicza",
+					zap.String("path", p),
+					zap.Error(err))
+			}
+			return false, nil
+		}
+
+		blz, err := b.openBlobovnicza(p)
+		if err != nil {
+			b.log.Warn("could not open blobovnicza for compaction",
+				zap.String("path", p),
+				zap.Error(err))
+			return false, nil
+		}
+
+		usage, err := blz.Usage()
+		if err != nil {
+			b.log.Warn("could not get blobovnicza usage",
+				zap.String("path", p),
+				zap.Error(err))
+			return false, nil
+		}
+
+		if usage.Dead() < compactionMinDeadSpace || usage.DeadRatio() < prm.DeadSpaceThreshold {
+			return false, nil
+		}
+
+		var cPrm blobovnicza.CompactPrm
+		cPrm.SetContext(ctx)
+		cPrm.SetRateLimit(prm.RateLimit)
+
+		cRes, err := blz.Compact(cPrm)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return true, ctxErr
+			}
+
+			b.log.Warn("could not compact blobovnicza",
+				zap.String("path", p),
+				zap.Error(err))
+			return false, nil
+		}
+
+		res.Compacted++
+		res.Reclaimed += cRes.Reclaimed()
+
+		// filled blobovnicza can accept objects again
+		if !blz.IsFull() {
+			b.reclaim(filepath.Dir(p), u64FromHexString(filepath.Base(p)))
+		}
+
+		b.log.Info("blobovnicza compacted",
+			zap.String("path", p),
+			zap.Float64("dead space ratio", usage.DeadRatio()),
+			zap.Uint64("reclaimed", cRes.Reclaimed()))
+
+		return false, nil
+	})
+
+	return res, err
+}
+
+// isActive checks whether the blobovnicza with path p is active on its level.
+func (b *Blobovniczas) isActive(p string) bool {
+	b.activeMtx.RLock()
+	defer b.activeMtx.RUnlock()
+
+	active, ok := b.active[filepath.Dir(p)]
+	return ok && active.ind == u64FromHexString(filepath.Base(p))
+}
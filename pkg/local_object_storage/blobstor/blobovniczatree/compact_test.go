@@ -0,0 +1,97 @@
+package blobovniczatree
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/internal/blobstortest"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestBlobovniczas_Compact(t *testing.T) {
+	b := NewBlobovniczaTree(
+		WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		WithObjectSizeLimit(128<<10),
+		WithBlobovniczaShallowWidth(2),
+		WithBlobovniczaShallowDepth(1),
+		WithRootPath(t.TempDir()),
+		WithBlobovniczaSize(2<<20))
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { _ = b.Close() })
+
+	type storedObject struct {
+		common.PutPrm
+		storageID []byte
+	}
+
+	var objects []storedObject
+	for i := 0; i < 80; i++ {
+		obj := blobstortest.NewObject(64 << 10)
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		prm := common.PutPrm{Address: object.AddressOf(obj), RawData: data, DontCompress: true}
+		res, err := b.Put(prm)
+		require.NoError(t, err)
+
+		objects = append(objects, storedObject{PutPrm: prm, storageID: res.StorageID})
+	}
+
+	// filled blobovnicza is not active anymore
+	var filled string
+	for i := range objects {
+		if !b.isActive(string(objects[i].storageID)) {
+			filled = string(objects[i].storageID)
+			break
+		}
+	}
+	require.NotEmpty(t, filled)
+
+	var kept []storedObject
+	for i := range objects {
+		if string(objects[i].storageID) == filled && i%5 != 0 {
+			_, err := b.Delete(common.DeletePrm{Address: objects[i].Address, StorageID: objects[i].storageID})
+			require.NoError(t, err)
+			continue
+		}
+		kept = append(kept, objects[i])
+	}
+
+	res, err := b.Compact(common.CompactPrm{DeadSpaceThreshold: 0.5})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Compacted)
+	require.Greater(t, res.Reclaimed, uint64(1<<20))
+
+	for i := range kept {
+		getRes, err := b.Get(common.GetPrm{Address: kept[i].Address, StorageID: kept[i].storageID, Raw: true})
+		require.NoError(t, err)
+		require.Equal(t, kept[i].RawData, getRes.RawData)
+	}
+
+	t.Run("nothing to compact", func(t *testing.T) {
+		res, err := b.Compact(common.CompactPrm{DeadSpaceThreshold: 0.5})
+		require.NoError(t, err)
+		require.Zero(t, res.Compacted)
+	})
+
+	t.Run("compacted blobovnicza is reused", func(t *testing.T) {
+		for i := 0; i < 80; i++ {
+			obj := blobstortest.NewObject(64 << 10)
+			data, err := obj.Marshal()
+			require.NoError(t, err)
+
+			res, err := b.Put(common.PutPrm{Address: object.AddressOf(obj), RawData: data, DontCompress: true})
+			require.NoError(t, err)
+
+			if string(res.StorageID) == filled {
+				return
+			}
+		}
+
+		t.Fatalf("blobovnicza %s is not activated after the compaction", filled)
+	})
+}
@@ -69,6 +69,7 @@ func (b *Blobovniczas) Close() error {
 	}
 
 	b.active = make(map[string]blobovniczaWithIndex)
+	b.reclaimed = make(map[string]map[uint64]struct{})
 
 	b.lruMtx.Unlock()
 
@@ -1,11 +1,13 @@
 package blobovniczatree
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/compression"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
@@ -130,13 +132,16 @@ func (b *Blobovniczas) getObject(blz *blobovnicza.Blobovnicza, prm blobovnicza.G
 	// decompress the data
 	data, err := b.compression.Decompress(res.Object())
 	if err != nil {
-		return common.GetRes{}, fmt.Errorf("could not decompress object data: %w", err)
+		if errors.Is(err, compression.ErrUnknownCodec) {
+			return common.GetRes{}, fmt.Errorf("could not decompress object data: %w", err)
+		}
+		return common.GetRes{}, fmt.Errorf("%w: could not decompress object data: %v", common.ErrInvalidData, err)
 	}
 
 	// unmarshal the object
 	obj := objectSDK.New()
 	if err := obj.Unmarshal(data); err != nil {
-		return common.GetRes{}, fmt.Errorf("could not unmarshal the object: %w", err)
+		return common.GetRes{}, fmt.Errorf("%w: could not unmarshal the object: %v", common.ErrInvalidData, err)
 	}
 
 	return common.GetRes{Object: obj, RawData: data}, nil
@@ -15,6 +15,12 @@ import (
 type SubStorage struct {
 	Storage common.Storage
 	Policy  func(*objectSDK.Object, []byte) bool
+
+	// Codec overrides compression codec of the BlobStor
+	// for this storage component if not empty.
+	Codec string
+	// CodecLevel is a compression level used with Codec.
+	CodecLevel int
 }
 
 // BlobStor represents NeoFS local BLOB storage.
@@ -23,6 +29,8 @@ type BlobStor struct {
 
 	modeMtx sync.RWMutex
 	mode    mode.Mode
+
+	dedupLocks [dedupLockNum]sync.Mutex
 }
 
 // Info contains information about blobstor.
@@ -43,6 +51,15 @@ type cfg struct {
 	compression compression.Config
 	log         *logger.Logger
 	storage     []SubStorage
+
+	// compressors contains compression configurations
+	// of sub-storages overriding the default codec.
+	compressors []*compression.Config
+
+	dedupMinSize uint64
+	dedupIndex   DedupIndex
+
+	metrics MetricsWriter
 }
 
 func initConfig(c *cfg) {
@@ -59,7 +76,23 @@ func New(opts ...Option) *BlobStor {
 	}
 
 	for i := range bs.storage {
-		bs.storage[i].Storage.SetCompressor(&bs.compression)
+		cc := &bs.compression
+		if bs.storage[i].Codec != "" {
+			cc = new(compression.Config)
+			*cc = bs.compression
+			cc.Codec = bs.storage[i].Codec
+			cc.Level = bs.storage[i].CodecLevel
+
+			bs.compressors = append(bs.compressors, cc)
+		}
+		bs.storage[i].Storage.SetCompressor(cc)
+
+		if bs.metrics != nil {
+			bs.storage[i].Storage = meteredStorage{
+				Storage: bs.storage[i].Storage,
+				metrics: bs.metrics,
+			}
+		}
 	}
 
 	return bs
@@ -87,7 +120,8 @@ func WithLogger(l *logger.Logger) Option {
 // WithCompressObjects returns option to toggle
 // compression of the stored objects.
 //
-// If true, Zstandard algorithm is used for data compression.
+// If true, the configured codec (Zstandard by default)
+// is used for data compression.
 //
 // If compressor (decompressor) creation failed,
 // the uncompressed option will be used, and the error
@@ -98,6 +132,44 @@ func WithCompressObjects(comp bool) Option {
 	}
 }
 
+// WithCompressionCodec returns option to specify the codec
+// and the compression level used by default for all sub-storages.
+// Zstandard with the default level is used if not set.
+func WithCompressionCodec(codec string, level int) Option {
+	return func(c *cfg) {
+		c.compression.Codec = codec
+		c.compression.Level = level
+	}
+}
+
+// WithCompressibilityEstimate returns option to toggle
+// estimation of the data compressibility before compression.
+//
+// If true, data which can't be compressed better than the threshold
+// set by WithCompressibilityEstimateThreshold is stored uncompressed.
+func WithCompressibilityEstimate(v bool) Option {
+	return func(c *cfg) {
+		c.compression.EstimateCompressibility = v
+	}
+}
+
+// WithCompressibilityEstimateThreshold returns option to specify the minimum
+// fraction of space that must be saved by compression for the data to be
+// stored compressed. Makes sense only with WithCompressibilityEstimate.
+func WithCompressibilityEstimateThreshold(threshold float64) Option {
+	return func(c *cfg) {
+		c.compression.EstimateCompressibilityThreshold = threshold
+	}
+}
+
+// WithCompressionMetrics returns option to specify the writer
+// of compression statistics.
+func WithCompressionMetrics(m compression.MetricsWriter) Option {
+	return func(c *cfg) {
+		c.compression.Metrics = m
+	}
+}
+
 // WithUncompressableContentTypes returns option to disable decompression
 // for specific content types as seen by object.AttributeContentType attribute.
 func WithUncompressableContentTypes(values []string) Option {
@@ -0,0 +1,29 @@
+package common
+
+import "context"
+
+// CompactPrm groups the parameters of Compact operation.
+type CompactPrm struct {
+	// Context allows to interrupt the compaction, may be nil.
+	Context context.Context
+	// DeadSpaceThreshold is the minimum fraction of the storage file
+	// not occupied by the stored objects for the file to be compacted.
+	DeadSpaceThreshold float64
+	// RateLimit is the maximum number of bytes rewritten per second,
+	// zero value means no limit.
+	RateLimit uint64
+}
+
+// CompactRes groups the resulting values of Compact operation.
+type CompactRes struct {
+	// Compacted is the number of compacted storage files.
+	Compacted int
+	// Reclaimed is the number of bytes of the disk space freed by the compaction.
+	Reclaimed uint64
+}
+
+// Compactor is implemented by the storages which can return
+// the space occupied by the removed objects to the file system.
+type Compactor interface {
+	Compact(CompactPrm) (CompactRes, error)
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"errors"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 )
 
@@ -10,3 +12,7 @@ var ErrReadOnly = logicerr.New("opened as read-only")
 
 // ErrNoSpace MUST be returned when there is no space to put an object on the device.
 var ErrNoSpace = logicerr.New("no free space")
+
+// ErrInvalidData MUST be returned when the stored data has been read
+// but can't be decoded into an object, i.e. it is corrupted.
+var ErrInvalidData = errors.New("invalid object data")
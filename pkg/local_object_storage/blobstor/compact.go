@@ -0,0 +1,39 @@
+package blobstor
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+)
+
+// Compact compacts all the sub-storages implementing common.Compactor.
+//
+// Returns the total number of compacted storage files and the reclaimed space
+// even if the compaction of some sub-storage failed.
+func (b *BlobStor) Compact(prm common.CompactPrm) (common.CompactRes, error) {
+	b.modeMtx.RLock()
+	defer b.modeMtx.RUnlock()
+
+	if b.mode.ReadOnly() {
+		return common.CompactRes{}, common.ErrReadOnly
+	}
+
+	var res common.CompactRes
+
+	for i := range b.storage {
+		c, ok := b.storage[i].Storage.(common.Compactor)
+		if !ok {
+			continue
+		}
+
+		r, err := c.Compact(prm)
+		res.Compacted += r.Compacted
+		res.Reclaimed += r.Reclaimed
+
+		if err != nil {
+			return res, fmt.Errorf("could not compact %s storage: %w", b.storage[i].Storage.Type(), err)
+		}
+	}
+
+	return res, nil
+}
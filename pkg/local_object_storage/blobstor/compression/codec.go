@@ -0,0 +1,258 @@
+package compression
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+)
+
+// Names of the supported compression codecs.
+const (
+	// CodecNone disables compression.
+	CodecNone = "none"
+	// CodecZstd is a Zstandard compression, the default one.
+	CodecZstd = "zstd"
+	// CodecLZ4 is an LZ4 block compression.
+	CodecLZ4 = "lz4"
+	// CodecS2 is an S2 (Snappy-compatible) block compression.
+	CodecS2 = "s2"
+)
+
+// Codec represents a single compression algorithm.
+type Codec interface {
+	// Compress appends compressed src to dst and returns the result.
+	Compress(dst, src []byte) []byte
+	// Decompress restores data compressed with Compress.
+	Decompress(src []byte) ([]byte, error)
+	// Close releases all resources allocated by the codec.
+	Close() error
+}
+
+// codecDescriptor describes a codec in the registry.
+type codecDescriptor struct {
+	// id is written to the header of the compressed data.
+	id byte
+	// newCodec creates new codec instance. If compress is false,
+	// the codec is only used for decompression and must not allocate
+	// encoder-related resources.
+	newCodec func(level int, compress bool) (Codec, error)
+}
+
+// Codec identifiers used in the header. They must never be changed
+// or reused, because they are stored on disk.
+const (
+	_ byte = iota // reserved
+	codecIDZstd
+	codecIDLZ4
+	codecIDS2
+)
+
+var registry = map[string]codecDescriptor{
+	CodecZstd: {id: codecIDZstd, newCodec: newZstdCodec},
+	CodecLZ4:  {id: codecIDLZ4, newCodec: newLZ4Codec},
+	CodecS2:   {id: codecIDS2, newCodec: newS2Codec},
+}
+
+// IsKnownCodec checks whether the codec with the given name is supported.
+func IsKnownCodec(name string) bool {
+	_, ok := registry[name]
+	return ok || name == CodecNone
+}
+
+// codecMagic is a prefix of the header of the data compressed by any codec
+// except zstd. Zstandard frames are self-describing and are stored without
+// the header, so that data written before codecs were introduced can be read.
+//
+// The first byte is 0x4e which is an invalid protobuf tag (wire type 6),
+// so the header can't be confused with an uncompressed object.
+var codecMagic = []byte{0x4e, 0x45, 0x4f}
+
+// headerSize is the size of the codec header: magic followed by the codec ID.
+var headerSize = len(codecMagic) + 1
+
+// ErrUnknownCodec is returned when the data or configuration refers
+// to the codec that is not supported.
+var ErrUnknownCodec = errors.New("unknown compression codec")
+
+// zstdCodec uses klauspost/compress Zstandard implementation.
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCodec(level int, compress bool) (Codec, error) {
+	var (
+		c   = new(zstdCodec)
+		err error
+	)
+
+	if compress {
+		opts := []zstd.EOption{}
+		if level != 0 {
+			opts = append(opts, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+		}
+
+		c.encoder, err = zstd.NewWriter(nil, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	c.decoder, err = zstd.NewReader(nil)
+	if err != nil {
+		if c.encoder != nil {
+			_ = c.encoder.Close()
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *zstdCodec) Compress(dst, src []byte) []byte {
+	if cap(dst)-len(dst) < c.encoder.MaxEncodedSize(len(src)) {
+		buf := make([]byte, len(dst), len(dst)+c.encoder.MaxEncodedSize(len(src)))
+		copy(buf, dst)
+		dst = buf
+	}
+	return c.encoder.EncodeAll(src, dst)
+}
+
+func (c *zstdCodec) Decompress(src []byte) ([]byte, error) {
+	return c.decoder.DecodeAll(src, nil)
+}
+
+func (c *zstdCodec) Close() error {
+	var err error
+	if c.encoder != nil {
+		err = c.encoder.Close()
+	}
+	c.decoder.Close()
+	return err
+}
+
+// lz4Codec uses LZ4 block format prefixed with the varint-encoded
+// length of the uncompressed data. Levels above zero enable high
+// compression mode with the corresponding search depth.
+type lz4Codec struct {
+	level lz4.CompressionLevel
+}
+
+func newLZ4Codec(level int, _ bool) (Codec, error) {
+	if level < 0 || level > 9 {
+		return nil, fmt.Errorf("invalid lz4 compression level %d, expected value in [0:9]", level)
+	}
+
+	var c lz4Codec
+	if level > 0 {
+		c.level = lz4.CompressionLevel(1 << (8 + level))
+	}
+	return c, nil
+}
+
+func (c lz4Codec) Compress(dst, src []byte) []byte {
+	offset := len(dst)
+	buf := make([]byte, offset+binary.MaxVarintLen64+lz4.CompressBlockBound(len(src)))
+	copy(buf, dst)
+
+	offset += binary.PutUvarint(buf[offset:], uint64(len(src)))
+
+	// Compression always succeeds because the buffer
+	// is at least CompressBlockBound bytes long.
+	var n int
+	if c.level == 0 {
+		n, _ = lz4.CompressBlock(src, buf[offset:], nil)
+	} else {
+		n, _ = lz4.CompressBlockHC(src, buf[offset:], c.level, nil, nil)
+	}
+	return buf[:offset+n]
+}
+
+// lz4MaxRatio is the maximum ratio of the uncompressed size to the size of
+// the LZ4 block: a single byte of a block can't expand to more than 255 bytes.
+const lz4MaxRatio = 255
+
+func (lz4Codec) Decompress(src []byte) ([]byte, error) {
+	size, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, errors.New("invalid lz4 data: can't read uncompressed size")
+	}
+	if size > uint64(len(src)-n)*lz4MaxRatio {
+		return nil, fmt.Errorf("invalid lz4 data: uncompressed size %d is too big for %d bytes block", size, len(src)-n)
+	}
+
+	data := make([]byte, size)
+	read, err := lz4.UncompressBlock(src[n:], data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lz4 data: %w", err)
+	}
+	if uint64(read) != size {
+		return nil, fmt.Errorf("invalid lz4 data: expected %d bytes, got %d", size, read)
+	}
+	return data, nil
+}
+
+func (lz4Codec) Close() error {
+	return nil
+}
+
+// s2Codec uses S2 block format. Level 1 (default) is the fastest mode,
+// level 2 enables better compression and level 3 the best compression.
+type s2Codec struct {
+	level int
+}
+
+func newS2Codec(level int, _ bool) (Codec, error) {
+	if level < 0 || level > 3 {
+		return nil, fmt.Errorf("invalid s2 compression level %d, expected value in [0:3]", level)
+	}
+	return s2Codec{level: level}, nil
+}
+
+func (c s2Codec) Compress(dst, src []byte) []byte {
+	offset := len(dst)
+	buf := make([]byte, offset+s2.MaxEncodedLen(len(src)))
+	copy(buf, dst)
+
+	var res []byte
+	switch c.level {
+	case 2:
+		res = s2.EncodeBetter(buf[offset:], src)
+	case 3:
+		res = s2.EncodeBest(buf[offset:], src)
+	default:
+		res = s2.Encode(buf[offset:], src)
+	}
+	return buf[:offset+len(res)]
+}
+
+// s2MaxRatio is the maximum ratio of the uncompressed size to the size of
+// the S2 block: the longest 5-byte repeat tag expands to (1<<24)-1+(1<<16)+4
+// bytes.
+const s2MaxRatio = ((1<<24)-1+(1<<16)+4)/5 + 1
+
+func (s2Codec) Decompress(src []byte) ([]byte, error) {
+	size, err := s2.DecodedLen(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s2 data: can't read uncompressed size: %w", err)
+	}
+
+	_, n := binary.Uvarint(src)
+	if uint64(size) > uint64(len(src)-n)*s2MaxRatio {
+		return nil, fmt.Errorf("invalid s2 data: uncompressed size %d is too big for %d bytes block", size, len(src)-n)
+	}
+
+	data, err := s2.Decode(make([]byte, size), src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s2 data: %w", err)
+	}
+	return data, nil
+}
+
+func (s2Codec) Close() error {
+	return nil
+}
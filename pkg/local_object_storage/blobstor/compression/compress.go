@@ -2,9 +2,9 @@ package compression
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 
-	"github.com/klauspost/compress/zstd"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 )
 
@@ -13,28 +13,96 @@ type Config struct {
 	Enabled                    bool
 	UncompressableContentTypes []string
 
-	encoder *zstd.Encoder
-	decoder *zstd.Decoder
+	// Codec is the name of the codec used for compression,
+	// CodecZstd is used if empty. Data compressed with any
+	// supported codec can be decompressed regardless of this value.
+	Codec string
+	// Level is a codec-specific compression level,
+	// the codec default is used if zero.
+	Level int
+
+	// EstimateCompressibility enables trial compression of the data
+	// sample before compressing the whole data. Data is stored
+	// uncompressed if the sample (or the whole data) can't be compressed
+	// better than EstimateCompressibilityThreshold.
+	EstimateCompressibility bool
+	// EstimateCompressibilityThreshold is the minimum fraction of the
+	// space that must be saved by compression for the data to be stored
+	// compressed. Must be in [0:1) range.
+	EstimateCompressibilityThreshold float64
+
+	// Metrics is used to report compression statistics, can be nil.
+	Metrics MetricsWriter
+
+	encoder   Codec
+	encoderID byte
+	header    []byte
+	decoders  map[byte]Codec
+}
+
+// MetricsWriter is an interface that must store compression metrics.
+type MetricsWriter interface {
+	// AddCompressedSize must account data of originalSize bytes
+	// which was stored compressed to compressedSize bytes.
+	AddCompressedSize(originalSize, compressedSize int)
+	// IncCompressionSkipped must increment the number of objects
+	// stored uncompressed because they were found to be incompressible.
+	IncCompressionSkipped()
 }
 
+// EstimationSampleSize is the size of the data sample which is compressed
+// to estimate compressibility of the data. Smaller data is compressed fully.
+const EstimationSampleSize = 64 * 1024
+
 // zstdFrameMagic contains first 4 bytes of any compressed object
 // https://github.com/klauspost/compress/blob/master/zstd/framedec.go#L58 .
 var zstdFrameMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
 
 // Init initializes compression routines.
 func (c *Config) Init() error {
-	var err error
+	if c.EstimateCompressibilityThreshold < 0 || c.EstimateCompressibilityThreshold >= 1 {
+		return fmt.Errorf("invalid compressibility threshold %f, expected value in [0:1) range",
+			c.EstimateCompressibilityThreshold)
+	}
 
-	if c.Enabled {
-		c.encoder, err = zstd.NewWriter(nil)
-		if err != nil {
-			return err
+	name := c.Codec
+	if name == "" {
+		name = CodecZstd
+	}
+
+	if name != CodecNone {
+		d, ok := registry[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownCodec, name)
+		}
+
+		if c.Enabled {
+			encoder, err := d.newCodec(c.Level, true)
+			if err != nil {
+				return fmt.Errorf("could not initialize %s codec: %w", name, err)
+			}
+
+			c.encoder = encoder
+			c.encoderID = d.id
+			if d.id != codecIDZstd {
+				c.header = append(append([]byte{}, codecMagic...), d.id)
+			}
 		}
 	}
 
-	c.decoder, err = zstd.NewReader(nil)
-	if err != nil {
-		return err
+	c.decoders = make(map[byte]Codec, len(registry))
+	for name, d := range registry {
+		if c.encoder != nil && d.id == c.encoderID {
+			c.decoders[d.id] = c.encoder
+			continue
+		}
+
+		decoder, err := d.newCodec(0, false)
+		if err != nil {
+			_ = c.Close()
+			return fmt.Errorf("could not initialize %s codec: %w", name, err)
+		}
+		c.decoders[d.id] = decoder
 	}
 
 	return nil
@@ -45,6 +113,9 @@ func (c *Config) Init() error {
 // 1. Compression is enabled in settings.
 // 2. Object MIME Content-Type is allowed for compression.
 func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
+	if c.Codec == CodecNone {
+		return false
+	}
 	if !c.Enabled || len(c.UncompressableContentTypes) == 0 {
 		return c.Enabled
 	}
@@ -71,33 +142,77 @@ func (c *Config) NeedsCompression(obj *objectSDK.Object) bool {
 	return c.Enabled
 }
 
-// Decompress decompresses data if it starts with the magic
-// and returns data untouched otherwise.
+// Decompress decompresses data if it starts with the zstd frame magic
+// or the codec header and returns data untouched otherwise.
 func (c *Config) Decompress(data []byte) ([]byte, error) {
-	if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
+	switch {
+	case len(data) >= 4 && bytes.Equal(data[:4], zstdFrameMagic):
+		return c.decoders[codecIDZstd].Decompress(data)
+	case len(data) >= headerSize && bytes.Equal(data[:len(codecMagic)], codecMagic):
+		decoder, ok := c.decoders[data[len(codecMagic)]]
+		if !ok {
+			return nil, fmt.Errorf("%w: codec ID %d", ErrUnknownCodec, data[len(codecMagic)])
+		}
+		return decoder.Decompress(data[headerSize:])
+	default:
 		return data, nil
 	}
-	return c.decoder.DecodeAll(data, nil)
 }
 
 // Compress compresses data if compression is enabled
 // and returns data untouched otherwise.
 func (c *Config) Compress(data []byte) []byte {
-	if c == nil || !c.Enabled {
+	if c == nil || !c.Enabled || c.encoder == nil {
+		return data
+	}
+
+	if c.EstimateCompressibility && len(data) > EstimationSampleSize {
+		// Payload is the last field of the marshaled object,
+		// so the tail of the data is sampled to skip the header.
+		sample := data[len(data)-EstimationSampleSize:]
+		if !c.compressible(len(sample), len(c.encoder.Compress(nil, sample))) {
+			c.skipped()
+			return data
+		}
+	}
+
+	res := c.encoder.Compress(c.header, data)
+	if c.EstimateCompressibility && !c.compressible(len(data), len(res)) {
+		c.skipped()
 		return data
 	}
-	maxSize := c.encoder.MaxEncodedSize(len(data))
-	return c.encoder.EncodeAll(data, make([]byte, 0, maxSize))
+
+	if c.Metrics != nil {
+		c.Metrics.AddCompressedSize(len(data), len(res))
+	}
+	return res
 }
 
-// Close closes encoder and decoder, returns any error occurred.
+// compressible checks whether the ratio of compression from originalSize
+// to compressedSize is enough to store the data compressed.
+func (c *Config) compressible(originalSize, compressedSize int) bool {
+	return float64(compressedSize) <= float64(originalSize)*(1-c.EstimateCompressibilityThreshold)
+}
+
+func (c *Config) skipped() {
+	if c.Metrics != nil {
+		c.Metrics.IncCompressionSkipped()
+	}
+}
+
+// Close closes encoder and decoders, returns any error occurred.
 func (c *Config) Close() error {
 	var err error
 	if c.encoder != nil {
 		err = c.encoder.Close()
 	}
-	if c.decoder != nil {
-		c.decoder.Close()
+	for _, d := range c.decoders {
+		if d == c.encoder {
+			continue
+		}
+		if cErr := d.Close(); err == nil {
+			err = cErr
+		}
 	}
 	return err
 }
@@ -0,0 +1,190 @@
+package compression
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	data := notSoRandomSlice(64*1024, 123)
+
+	for _, tc := range []struct {
+		codec string
+		level int
+	}{
+		{codec: ""},
+		{codec: CodecZstd, level: 19},
+		{codec: CodecLZ4},
+		{codec: CodecLZ4, level: 9},
+		{codec: CodecS2},
+		{codec: CodecS2, level: 3},
+	} {
+		t.Run(tc.codec, func(t *testing.T) {
+			c := Config{Enabled: true, Codec: tc.codec, Level: tc.level}
+			require.NoError(t, c.Init())
+			t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+			compressed := c.Compress(data)
+			require.Less(t, len(compressed), len(data))
+
+			actual, err := c.Decompress(compressed)
+			require.NoError(t, err)
+			require.Equal(t, data, actual)
+
+			t.Run("random data", func(t *testing.T) {
+				random := make([]byte, 1024)
+				_, _ = rand.Read(random)
+
+				actual, err := c.Decompress(c.Compress(random))
+				require.NoError(t, err)
+				require.Equal(t, random, actual)
+			})
+			t.Run("empty data", func(t *testing.T) {
+				actual, err := c.Decompress(c.Compress([]byte{}))
+				require.NoError(t, err)
+				require.Empty(t, actual)
+			})
+		})
+	}
+}
+
+func TestDecompressAnyCodec(t *testing.T) {
+	data := notSoRandomSlice(4096, 17)
+
+	var compressed [][]byte
+	for _, codec := range []string{CodecZstd, CodecLZ4, CodecS2} {
+		c := Config{Enabled: true, Codec: codec}
+		require.NoError(t, c.Init())
+		compressed = append(compressed, c.Compress(data))
+		require.NoError(t, c.Close())
+	}
+
+	for _, codec := range []string{CodecZstd, CodecLZ4, CodecS2, CodecNone} {
+		for _, enabled := range []bool{true, false} {
+			c := Config{Enabled: enabled, Codec: codec}
+			require.NoError(t, c.Init())
+
+			for i := range compressed {
+				actual, err := c.Decompress(compressed[i])
+				require.NoError(t, err)
+				require.Equal(t, data, actual)
+			}
+
+			actual, err := c.Decompress(data)
+			require.NoError(t, err)
+			require.Equal(t, data, actual, "uncompressed data must be returned untouched")
+
+			require.NoError(t, c.Close())
+		}
+	}
+}
+
+func TestConfig_Init(t *testing.T) {
+	c := Config{Enabled: true, Codec: "unknown"}
+	require.ErrorIs(t, c.Init(), ErrUnknownCodec)
+
+	c = Config{Enabled: true, Codec: CodecLZ4, Level: 10}
+	require.Error(t, c.Init())
+
+	c = Config{Enabled: true, Codec: CodecNone}
+	require.NoError(t, c.Init())
+
+	data := []byte("some data")
+	require.Equal(t, data, c.Compress(data))
+	require.False(t, c.NeedsCompression(nil))
+	require.NoError(t, c.Close())
+}
+
+func TestDecompressUnknownCodec(t *testing.T) {
+	c := Config{}
+	require.NoError(t, c.Init())
+
+	data := append(append([]byte{}, codecMagic...), 0xFF, 1, 2, 3)
+	_, err := c.Decompress(data)
+	require.ErrorIs(t, err, ErrUnknownCodec)
+}
+
+func TestLZ4DecompressInvalidSize(t *testing.T) {
+	c, err := newLZ4Codec(0, false)
+	require.NoError(t, err)
+
+	data := make([]byte, binary.MaxVarintLen64)
+	data = append(data[:binary.PutUvarint(data, 1<<40)], 0x10, 'a')
+
+	_, err = c.Decompress(data)
+	require.Error(t, err)
+}
+
+func TestS2DecompressInvalidSize(t *testing.T) {
+	c, err := newS2Codec(0, false)
+	require.NoError(t, err)
+
+	data := make([]byte, binary.MaxVarintLen64)
+	data = append(data[:binary.PutUvarint(data, 1<<31)], 0x10, 'a')
+
+	_, err = c.Decompress(data)
+	require.Error(t, err)
+
+	// highly compressible data is not rejected
+	for _, level := range []int{1, 2, 3} {
+		c, err := newS2Codec(level, true)
+		require.NoError(t, err)
+
+		zeros := make([]byte, 64<<20)
+		res, err := c.Decompress(c.Compress(nil, zeros))
+		require.NoError(t, err)
+		require.Equal(t, zeros, res)
+	}
+}
+
+type testMetrics struct {
+	original, compressed int
+	skipped              int
+}
+
+func (m *testMetrics) AddCompressedSize(originalSize, compressedSize int) {
+	m.original += originalSize
+	m.compressed += compressedSize
+}
+
+func (m *testMetrics) IncCompressionSkipped() {
+	m.skipped++
+}
+
+func TestEstimateCompressibility(t *testing.T) {
+	m := new(testMetrics)
+	c := Config{
+		Enabled:                          true,
+		EstimateCompressibility:          true,
+		EstimateCompressibilityThreshold: 0.1,
+		Metrics:                          m,
+	}
+	require.NoError(t, c.Init())
+	t.Cleanup(func() { require.NoError(t, c.Close()) })
+
+	for _, size := range []int{1024, 2 * EstimationSampleSize} {
+		random := make([]byte, size)
+		_, _ = rand.Read(random)
+		require.Equal(t, random, c.Compress(random))
+
+		compressible := notSoRandomSlice(size, 123)
+		compressed := c.Compress(compressible)
+		require.Less(t, len(compressed), len(compressible))
+
+		actual, err := c.Decompress(compressed)
+		require.NoError(t, err)
+		require.Equal(t, compressible, actual)
+	}
+
+	require.Equal(t, 2, m.skipped)
+	require.Equal(t, 1024+2*EstimationSampleSize, m.original)
+	require.Less(t, m.compressed, m.original)
+
+	t.Run("invalid threshold", func(t *testing.T) {
+		c := Config{EstimateCompressibilityThreshold: 1}
+		require.Error(t, c.Init())
+	})
+}
@@ -0,0 +1,233 @@
+package blobstor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// DedupIndex tracks the references of the objects to the deduplicated
+// payloads. The index must survive the loss of the stored objects
+// metadata since it can't be restored from the BlobStor.
+type DedupIndex interface {
+	// AddPayloadRef adds the reference of the object to the payload and
+	// returns true if the payload has already been stored.
+	AddPayloadRef(hash []byte, addr oid.Address) (stored bool, err error)
+	// SetPayloadStorageID marks the payload as stored under the storage ID.
+	SetPayloadStorageID(hash []byte, storageID []byte) error
+	// PayloadHash returns the hash of the payload referenced by the object
+	// or nil if the object payload is not deduplicated.
+	PayloadHash(addr oid.Address) ([]byte, error)
+	// RemovePayloadRef removes the reference of the object to its payload.
+	// If the reference was the last one, the storage ID of the payload is
+	// returned with true.
+	RemovePayloadRef(addr oid.Address) (storageID []byte, last bool, err error)
+	// PayloadStorageID returns the storage ID of the stored payload.
+	PayloadStorageID(hash []byte) (storageID []byte, found bool, err error)
+}
+
+// dedupLockNum is the number of mutexes synchronizing the operations with
+// the deduplicated payloads.
+const dedupLockNum = 256
+
+// WithPayloadDeduplication returns option to store identical payloads once.
+//
+// Payloads of at least minSize bytes are stored separately under their
+// SHA256 hash, while the objects are stored without payload. The payload
+// is deleted with the last object referencing it. Zero minSize disables
+// deduplication of the new objects, already deduplicated ones remain
+// readable.
+func WithPayloadDeduplication(minSize uint64, idx DedupIndex) Option {
+	return func(c *cfg) {
+		c.dedupMinSize = minSize
+		c.dedupIndex = idx
+	}
+}
+
+// payloadContainer is a container of the objects holding deduplicated
+// payloads. Such objects are not returned from Iterate.
+var payloadContainer cid.ID
+
+// deduplicable returns SHA256 hash of the object payload if the payload
+// must be deduplicated. The hash is taken from the header and is checked
+// to match the payload since the payloads are addressed by it.
+func (b *BlobStor) deduplicable(obj *objectSDK.Object) ([sha256.Size]byte, bool) {
+	var hash [sha256.Size]byte
+
+	if b.dedupIndex == nil || b.dedupMinSize == 0 || obj == nil {
+		return hash, false
+	}
+
+	payload := obj.Payload()
+	if uint64(len(payload)) < b.dedupMinSize || uint64(len(payload)) != obj.PayloadSize() {
+		return hash, false
+	}
+
+	cs, ok := obj.PayloadChecksum()
+	if !ok || cs.Type() != checksum.SHA256 {
+		return hash, false
+	}
+
+	hash = sha256.Sum256(payload)
+
+	return hash, bytes.Equal(hash[:], cs.Value())
+}
+
+// putDeduplicated saves the object payload once and the object without
+// the payload.
+func (b *BlobStor) putDeduplicated(prm common.PutPrm, hash [sha256.Size]byte) (common.PutRes, error) {
+	mtx := &b.dedupLocks[hash[0]]
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	stored, err := b.dedupIndex.AddPayloadRef(hash[:], prm.Address)
+	if err != nil {
+		return common.PutRes{}, fmt.Errorf("could not add payload reference: %w", err)
+	}
+
+	if !stored {
+		blob := objectSDK.New()
+		blob.SetContainerID(payloadContainer)
+		blob.SetID(oid.ID(hash))
+		blob.SetPayload(prm.Object.Payload())
+
+		res, err := b.put(common.PutPrm{Object: blob, DontCompress: prm.DontCompress})
+		if err == nil {
+			err = b.dedupIndex.SetPayloadStorageID(hash[:], res.StorageID)
+		}
+
+		if err != nil {
+			b.releasePayload(prm.Address, hash[:])
+			return common.PutRes{}, fmt.Errorf("could not save payload: %w", err)
+		}
+	}
+
+	res, err := b.put(common.PutPrm{Object: prm.Object.CutPayload(), DontCompress: prm.DontCompress})
+	if err != nil {
+		b.releasePayload(prm.Address, hash[:])
+	}
+
+	return res, err
+}
+
+// releasePayload removes the reference of the object to the payload with
+// the hash and deletes the payload if it is not referenced anymore. Caller
+// must hold the payload lock.
+func (b *BlobStor) releasePayload(addr oid.Address, hash []byte) {
+	storageID, last, err := b.dedupIndex.RemovePayloadRef(addr)
+	if err != nil {
+		b.log.Warn("could not remove payload reference",
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()))
+
+		return
+	}
+
+	if !last {
+		return
+	}
+
+	payloadAddr, err := payloadAddress(hash)
+	if err != nil {
+		return
+	}
+
+	_, err = b.delete(common.DeletePrm{Address: payloadAddr, StorageID: storageID})
+	if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
+		b.log.Warn("could not delete deduplicated payload",
+			zap.Stringer("address", addr),
+			zap.Stringer("payload", payloadAddr),
+			zap.String("error", err.Error()))
+	}
+}
+
+// deletePayloadRef releases the deduplicated payload of the deleted object.
+func (b *BlobStor) deletePayloadRef(addr oid.Address) {
+	hash, err := b.dedupIndex.PayloadHash(addr)
+	if err != nil {
+		b.log.Warn("could not get payload reference",
+			zap.Stringer("address", addr),
+			zap.String("error", err.Error()))
+
+		return
+	} else if len(hash) == 0 {
+		return
+	}
+
+	mtx := &b.dedupLocks[hash[0]]
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	b.releasePayload(addr, hash)
+}
+
+// isDeduplicated checks if the payload of the stored object is
+// stored separately.
+func isDeduplicated(obj *objectSDK.Object) bool {
+	return obj.PayloadSize() > 0 && len(obj.Payload()) == 0
+}
+
+// payloadAddress returns the address of the object holding the payload
+// with the hash.
+func payloadAddress(hash []byte) (oid.Address, error) {
+	var id oid.ID
+	if err := id.Decode(hash); err != nil {
+		return oid.Address{}, err
+	}
+
+	var addr oid.Address
+	addr.SetContainer(payloadContainer)
+	addr.SetObject(id)
+
+	return addr, nil
+}
+
+// resolvePayload sets the deduplicated payload to the stored object.
+func (b *BlobStor) resolvePayload(obj *objectSDK.Object) error {
+	cs, ok := obj.PayloadChecksum()
+	if !ok || cs.Type() != checksum.SHA256 {
+		return errors.New("missing SHA256 checksum of the deduplicated payload")
+	}
+
+	addr, err := payloadAddress(cs.Value())
+	if err != nil {
+		return fmt.Errorf("invalid payload checksum: %w", err)
+	}
+
+	var storageID []byte
+
+	if b.dedupIndex != nil {
+		id, found, err := b.dedupIndex.PayloadStorageID(cs.Value())
+		if err == nil && found {
+			storageID = id
+		}
+	}
+
+	res, err := b.get(common.GetPrm{Address: addr, StorageID: storageID})
+	if err != nil && storageID != nil && errors.As(err, new(apistatus.ObjectNotFound)) {
+		res, err = b.get(common.GetPrm{Address: addr})
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not get deduplicated payload: %w", err)
+	}
+
+	payload := res.Object.Payload()
+	if uint64(len(payload)) != obj.PayloadSize() {
+		return logicerr.New("deduplicated payload size mismatch")
+	}
+
+	obj.SetPayload(payload)
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package blobstor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testDedupIndex struct {
+	mtx        sync.Mutex
+	storageIDs map[string][]byte
+	objects    map[oid.Address]string
+}
+
+func newTestDedupIndex() *testDedupIndex {
+	return &testDedupIndex{
+		storageIDs: make(map[string][]byte),
+		objects:    make(map[oid.Address]string),
+	}
+}
+
+func (x *testDedupIndex) AddPayloadRef(hash []byte, addr oid.Address) (bool, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.objects[addr] = string(hash)
+	_, ok := x.storageIDs[string(hash)]
+
+	return ok, nil
+}
+
+func (x *testDedupIndex) SetPayloadStorageID(hash []byte, storageID []byte) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.storageIDs[string(hash)] = storageID
+
+	return nil
+}
+
+func (x *testDedupIndex) PayloadHash(addr oid.Address) ([]byte, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	hash, ok := x.objects[addr]
+	if !ok {
+		return nil, nil
+	}
+
+	return []byte(hash), nil
+}
+
+func (x *testDedupIndex) RemovePayloadRef(addr oid.Address) ([]byte, bool, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	hash, ok := x.objects[addr]
+	if !ok {
+		return nil, false, nil
+	}
+
+	delete(x.objects, addr)
+
+	for _, h := range x.objects {
+		if h == hash {
+			return nil, false, nil
+		}
+	}
+
+	id := x.storageIDs[hash]
+	delete(x.storageIDs, hash)
+
+	return id, true, nil
+}
+
+func (x *testDedupIndex) PayloadStorageID(hash []byte) ([]byte, bool, error) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	id, ok := x.storageIDs[string(hash)]
+
+	return id, ok, nil
+}
+
+func testDedupObject(payload []byte) *objectSDK.Object {
+	obj := objectSDK.New()
+	obj.SetID(oidtest.ID())
+	obj.SetContainerID(cidtest.ID())
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	var cs checksum.Checksum
+	cs.SetSHA256(sha256.Sum256(payload))
+	obj.SetPayloadChecksum(cs)
+
+	return obj
+}
+
+func TestPayloadDeduplication(t *testing.T) {
+	const (
+		smallSizeLimit = 512
+		minSize        = 128
+	)
+
+	for _, size := range []int{smallSizeLimit / 2, smallSizeLimit * 2} {
+		idx := newTestDedupIndex()
+
+		bs := New(
+			WithStorages(defaultStorages(t.TempDir(), smallSizeLimit)),
+			WithPayloadDeduplication(minSize, idx))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+
+		payload := bytes.Repeat([]byte{1}, size)
+		objs := []*objectSDK.Object{
+			testDedupObject(payload),
+			testDedupObject(payload),
+			testDedupObject(payload[:minSize-1]),
+		}
+
+		for i := range objs {
+			_, err := bs.Put(common.PutPrm{Object: objs[i]})
+			require.NoError(t, err)
+		}
+
+		// 2 headers, the payload and the small object
+		require.Equal(t, 4, storedObjects(t, bs))
+
+		checkObjects := func(t *testing.T, objs ...*objectSDK.Object) {
+			for _, obj := range objs {
+				addr := object.AddressOf(obj)
+
+				res, err := bs.Get(common.GetPrm{Address: addr})
+				require.NoError(t, err)
+				require.Equal(t, obj, res.Object)
+
+				var rng objectSDK.Range
+				rng.SetOffset(1)
+				rng.SetLength(obj.PayloadSize() - 2)
+
+				rngRes, err := bs.GetRange(common.GetRangePrm{Address: addr, Range: rng})
+				require.NoError(t, err)
+				require.Equal(t, obj.Payload()[1:obj.PayloadSize()-1], rngRes.Data)
+			}
+
+			var iterated []oid.Address
+
+			_, err := bs.Iterate(common.IteratePrm{
+				Handler: func(elem common.IterationElement) error {
+					obj := objectSDK.New()
+					require.NoError(t, obj.Unmarshal(elem.ObjectData))
+					require.Equal(t, uint64(len(obj.Payload())), obj.PayloadSize())
+
+					iterated = append(iterated, elem.Address)
+
+					return nil
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, iterated, len(objs))
+		}
+
+		checkObjects(t, objs...)
+
+		_, err := bs.Delete(common.DeletePrm{Address: object.AddressOf(objs[0])})
+		require.NoError(t, err)
+		require.Equal(t, 3, storedObjects(t, bs))
+
+		checkObjects(t, objs[1:]...)
+
+		_, err = bs.Delete(common.DeletePrm{Address: object.AddressOf(objs[1])})
+		require.NoError(t, err)
+		require.Equal(t, 1, storedObjects(t, bs))
+		require.Empty(t, idx.storageIDs)
+
+		require.NoError(t, bs.Close())
+	}
+}
+
+// storedObjects returns the number of objects in the sub-storages of b.
+func storedObjects(t *testing.T, b *BlobStor) int {
+	var n int
+
+	for i := range b.storage {
+		_, err := b.storage[i].Storage.Iterate(common.IteratePrm{
+			Handler: func(common.IterationElement) error {
+				n++
+				return nil
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	return n
+}
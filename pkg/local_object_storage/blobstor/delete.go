@@ -7,10 +7,21 @@ import (
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 )
 
+// Delete removes the object from b. Deduplicated payload of the object
+// is removed with the last object referencing it.
 func (b *BlobStor) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
+	res, err := b.delete(prm)
+	if b.dedupIndex != nil && (err == nil || errors.As(err, new(apistatus.ObjectNotFound))) {
+		b.deletePayloadRef(prm.Address)
+	}
+
+	return res, err
+}
+
+func (b *BlobStor) delete(prm common.DeletePrm) (common.DeleteRes, error) {
 	if prm.StorageID == nil {
 		for i := range b.storage {
 			res, err := b.storage[i].Storage.Delete(prm)
@@ -32,6 +43,11 @@ func (b *BlobStor) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
 	}
 
 	res, err := st.Delete(prm)
+	if prm.StorageID != nil && len(prm.StorageID) == 0 && err != nil && errors.As(err, new(apistatus.ObjectNotFound)) && len(b.storage) > 1 {
+		// object may be moved to another tier, see Migrate
+		prm.StorageID = nil
+		return b.delete(prm)
+	}
 	if err == nil {
 		logOp(b.log, deleteOp, prm.Address, st.Type(), prm.StorageID)
 	}
@@ -14,10 +14,17 @@ func (b *BlobStor) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
 	defer b.modeMtx.RUnlock()
 
 	if prm.StorageID != nil {
-		if len(prm.StorageID) == 0 {
-			return b.storage[len(b.storage)-1].Storage.Exists(prm)
+		if len(prm.StorageID) != 0 {
+			return b.storage[0].Storage.Exists(prm)
 		}
-		return b.storage[0].Storage.Exists(prm)
+
+		res, err := b.storage[len(b.storage)-1].Storage.Exists(prm)
+		if err != nil || res.Exists || len(b.storage) == 1 {
+			return res, err
+		}
+
+		// object may be moved to another tier, see Migrate
+		prm.StorageID = nil
 	}
 
 	// If there was an error during existence check below,
@@ -353,12 +353,15 @@ func (t *FSTree) Get(prm common.GetPrm) (common.GetRes, error) {
 
 	data, err = t.Decompress(data)
 	if err != nil {
-		return common.GetRes{}, err
+		if errors.Is(err, compression.ErrUnknownCodec) {
+			return common.GetRes{}, err
+		}
+		return common.GetRes{}, fmt.Errorf("%w: %v", common.ErrInvalidData, err)
 	}
 
 	obj := objectSDK.New()
 	if err := obj.Unmarshal(data); err != nil {
-		return common.GetRes{}, err
+		return common.GetRes{}, fmt.Errorf("%w: %v", common.ErrInvalidData, err)
 	}
 
 	return common.GetRes{Object: obj, RawData: data}, err
@@ -2,6 +2,7 @@ package blobstor
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
@@ -15,6 +16,23 @@ func (b *BlobStor) Get(prm common.GetPrm) (common.GetRes, error) {
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
+	res, err := b.get(prm)
+	if err != nil || !isDeduplicated(res.Object) {
+		return res, err
+	}
+
+	if err := b.resolvePayload(res.Object); err != nil {
+		return common.GetRes{}, err
+	}
+
+	if res.RawData, err = res.Object.Marshal(); err != nil {
+		return common.GetRes{}, fmt.Errorf("could not marshal the object: %w", err)
+	}
+
+	return res, nil
+}
+
+func (b *BlobStor) get(prm common.GetPrm) (common.GetRes, error) {
 	if prm.StorageID == nil {
 		for i := range b.storage {
 			res, err := b.storage[i].Storage.Get(prm)
@@ -26,7 +44,13 @@ func (b *BlobStor) Get(prm common.GetPrm) (common.GetRes, error) {
 		return common.GetRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.Get(prm)
+		res, err := b.storage[len(b.storage)-1].Storage.Get(prm)
+		if err != nil && errors.As(err, new(apistatus.ObjectNotFound)) && len(b.storage) > 1 {
+			// object may be moved to another tier, see Migrate
+			prm.StorageID = nil
+			return b.get(prm)
+		}
+		return res, err
 	}
 	return b.storage[0].Storage.Get(prm)
 }
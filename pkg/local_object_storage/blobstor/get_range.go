@@ -15,6 +15,37 @@ func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error)
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
+	res, err := b.getRange(prm)
+	if err == nil || !errors.As(err, new(apistatus.ObjectOutOfRange)) {
+		return res, err
+	}
+
+	// payload of the deduplicated object is stored separately
+	getRes, err := b.get(common.GetPrm{Address: prm.Address, StorageID: prm.StorageID})
+	if err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	if !isDeduplicated(getRes.Object) {
+		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectOutOfRange{})
+	}
+
+	if err := b.resolvePayload(getRes.Object); err != nil {
+		return common.GetRangeRes{}, err
+	}
+
+	payload := getRes.Object.Payload()
+	from := prm.Range.GetOffset()
+	to := from + prm.Range.GetLength()
+
+	if pLen := uint64(len(payload)); to < from || pLen < from || pLen < to {
+		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectOutOfRange{})
+	}
+
+	return common.GetRangeRes{Data: payload[from:to]}, nil
+}
+
+func (b *BlobStor) getRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
 	if prm.StorageID == nil {
 		for i := range b.storage {
 			res, err := b.storage[i].Storage.GetRange(prm)
@@ -26,7 +57,13 @@ func (b *BlobStor) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error)
 		return common.GetRangeRes{}, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
 	if len(prm.StorageID) == 0 {
-		return b.storage[len(b.storage)-1].Storage.GetRange(prm)
+		res, err := b.storage[len(b.storage)-1].Storage.GetRange(prm)
+		if err != nil && errors.As(err, new(apistatus.ObjectNotFound)) && len(b.storage) > 1 {
+			// object may be moved to another tier, see Migrate
+			prm.StorageID = nil
+			return b.getRange(prm)
+		}
+		return res, err
 	}
 	return b.storage[0].Storage.GetRange(prm)
 }
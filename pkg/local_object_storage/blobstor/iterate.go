@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
@@ -15,10 +16,17 @@ import (
 // did not allow to completely iterate over the storage.
 //
 // If handler returns an error, method wraps and returns it immediately.
+//
+// Deduplicated payloads are not iterated separately, objects are passed
+// to the handler with their payloads.
 func (b *BlobStor) Iterate(prm common.IteratePrm) (common.IterateRes, error) {
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
+	if b.dedupIndex != nil {
+		prm = b.dedupIteratePrm(prm)
+	}
+
 	for i := range b.storage {
 		_, err := b.storage[i].Storage.Iterate(prm)
 		if err != nil && !prm.IgnoreErrors {
@@ -48,3 +56,68 @@ func IterateBinaryObjects(blz *BlobStor, f func(addr oid.Address, data []byte, d
 
 	return err
 }
+
+// dedupIteratePrm wraps the handlers of prm to skip the deduplicated payloads
+// and to resolve the payloads of the deduplicated objects.
+func (b *BlobStor) dedupIteratePrm(prm common.IteratePrm) common.IteratePrm {
+	resolve := func(data []byte) ([]byte, error) {
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+		}
+
+		if !isDeduplicated(obj) {
+			return data, nil
+		}
+
+		if err := b.resolvePayload(obj); err != nil {
+			return nil, err
+		}
+
+		return obj.Marshal()
+	}
+
+	if h := prm.Handler; h != nil {
+		prm.Handler = func(elem common.IterationElement) error {
+			if elem.Address.Container() == payloadContainer {
+				return nil
+			}
+
+			data, err := resolve(elem.ObjectData)
+			if err != nil {
+				if prm.IgnoreErrors {
+					if prm.ErrorHandler != nil {
+						return prm.ErrorHandler(elem.Address, err)
+					}
+
+					return nil
+				}
+
+				return err
+			}
+
+			elem.ObjectData = data
+
+			return h(elem)
+		}
+	}
+
+	if h := prm.LazyHandler; h != nil {
+		prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
+			if addr.Container() == payloadContainer {
+				return nil
+			}
+
+			return h(addr, func() ([]byte, error) {
+				data, err := f()
+				if err != nil {
+					return nil, err
+				}
+
+				return resolve(data)
+			})
+		}
+	}
+
+	return prm
+}
@@ -0,0 +1,83 @@
+package blobstor
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+)
+
+// MetricsWriter is an interface that must store sub-storage metrics.
+type MetricsWriter interface {
+	// AddStorageOperationDuration must account the duration of the op
+	// operation performed by the sub-storage of the storageType type.
+	AddStorageOperationDuration(storageType, op string, d time.Duration)
+}
+
+// Sub-storage operations accounted by MetricsWriter.
+const (
+	metricGet      = "get"
+	metricGetRange = "range"
+	metricExists   = "exists"
+	metricPut      = "put"
+	metricDelete   = "delete"
+)
+
+// WithMetrics returns option to specify the writer
+// of sub-storage operation metrics.
+func WithMetrics(m MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metrics = m
+	}
+}
+
+// meteredStorage measures the duration of the object
+// operations of the wrapped sub-storage.
+type meteredStorage struct {
+	common.Storage
+
+	metrics MetricsWriter
+}
+
+func (s meteredStorage) elapsed(op string) func() {
+	t := time.Now()
+
+	return func() {
+		s.metrics.AddStorageOperationDuration(s.Type(), op, time.Since(t))
+	}
+}
+
+func (s meteredStorage) Get(prm common.GetPrm) (common.GetRes, error) {
+	defer s.elapsed(metricGet)()
+	return s.Storage.Get(prm)
+}
+
+func (s meteredStorage) GetRange(prm common.GetRangePrm) (common.GetRangeRes, error) {
+	defer s.elapsed(metricGetRange)()
+	return s.Storage.GetRange(prm)
+}
+
+func (s meteredStorage) Exists(prm common.ExistsPrm) (common.ExistsRes, error) {
+	defer s.elapsed(metricExists)()
+	return s.Storage.Exists(prm)
+}
+
+func (s meteredStorage) Put(prm common.PutPrm) (common.PutRes, error) {
+	defer s.elapsed(metricPut)()
+	return s.Storage.Put(prm)
+}
+
+func (s meteredStorage) Delete(prm common.DeletePrm) (common.DeleteRes, error) {
+	defer s.elapsed(metricDelete)()
+	return s.Storage.Delete(prm)
+}
+
+// Compact compacts the wrapped sub-storage if it implements common.Compactor.
+// Otherwise, nothing is compacted.
+func (s meteredStorage) Compact(prm common.CompactPrm) (common.CompactRes, error) {
+	c, ok := s.Storage.(common.Compactor)
+	if !ok {
+		return common.CompactRes{}, nil
+	}
+
+	return c.Compact(prm)
+}
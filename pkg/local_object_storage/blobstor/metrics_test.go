@@ -0,0 +1,67 @@
+package blobstor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+type testMetrics struct {
+	mtx sync.Mutex
+	ops map[string]int
+}
+
+func (m *testMetrics) AddStorageOperationDuration(storageType, op string, _ time.Duration) {
+	m.mtx.Lock()
+	m.ops[storageType+"/"+op]++
+	m.mtx.Unlock()
+}
+
+func TestStorageMetrics(t *testing.T) {
+	const smallSizeLimit = 512
+
+	m := &testMetrics{ops: make(map[string]int)}
+
+	b := New(
+		WithStorages(defaultStorages(t.TempDir(), smallSizeLimit)),
+		WithMetrics(m))
+	require.NoError(t, b.Open(false))
+	require.NoError(t, b.Init())
+	t.Cleanup(func() { _ = b.Close() })
+
+	small, big := testObject(smallSizeLimit/2), testObject(smallSizeLimit+1)
+
+	for _, obj := range []*objectSDK.Object{small, big} {
+		var prm common.PutPrm
+		prm.Object = obj
+
+		res, err := b.Put(prm)
+		require.NoError(t, err)
+
+		var getPrm common.GetPrm
+		getPrm.Address = objectCore.AddressOf(obj)
+		getPrm.StorageID = res.StorageID
+
+		_, err = b.Get(getPrm)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, map[string]int{
+		blobovniczatree.Type + "/" + metricPut: 1,
+		blobovniczatree.Type + "/" + metricGet: 1,
+		fstree.Type + "/" + metricPut:          1,
+		fstree.Type + "/" + metricGet:          1,
+	}, m.ops)
+
+	t.Run("compaction", func(t *testing.T) {
+		_, err := b.Compact(common.CompactPrm{})
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,157 @@
+package blobstor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ErrTierMismatch is returned when object can't be moved to the
+// sub-storage because of its policy.
+var ErrTierMismatch = logicerr.New("object does not match the sub-storage policy")
+
+// MigratePrm groups the parameters of Migrate operation.
+type MigratePrm struct {
+	Address oid.Address
+	// StorageID is the current storage ID of the object, must not be nil.
+	StorageID []byte
+	// Tier is the index of the sub-storage to move the object to.
+	Tier int
+	// Commit is called after the object is saved in the new sub-storage
+	// and before it is removed from the old one. Object is not moved if
+	// Commit returns an error.
+	Commit func(storageID []byte) error
+}
+
+// MigrateRes groups the resulting values of Migrate operation.
+type MigrateRes struct {
+	// StorageID is the storage ID of the object in the new sub-storage.
+	StorageID []byte
+	// Moved is true if the object has been moved.
+	Moved bool
+}
+
+// Migrate moves the object to the sub-storage with the index prm.Tier.
+// Sub-storages are ordered from the hottest one to the coldest one. The
+// sub-storage the object is moved from is found by the storage ID, the
+// object is searched in all sub-storages if the ID doesn't point to it.
+//
+// Object is not moved if it is already stored in the requested
+// sub-storage. Returns ErrTierMismatch if the policy of the
+// requested sub-storage doesn't allow storing the object.
+func (b *BlobStor) Migrate(prm MigratePrm) (MigrateRes, error) {
+	b.modeMtx.RLock()
+	defer b.modeMtx.RUnlock()
+
+	if b.mode.ReadOnly() {
+		return MigrateRes{}, common.ErrReadOnly
+	}
+
+	if prm.StorageID == nil {
+		return MigrateRes{}, errors.New("missing storage ID")
+	}
+
+	if prm.Tier < 0 || prm.Tier >= len(b.storage) {
+		return MigrateRes{}, fmt.Errorf("invalid tier %d", prm.Tier)
+	}
+
+	from, fromID, err := b.storageOf(prm.Address, prm.StorageID)
+	if err != nil {
+		return MigrateRes{}, err
+	}
+
+	if from == prm.Tier {
+		return MigrateRes{StorageID: prm.StorageID}, nil
+	}
+
+	getRes, err := b.storage[from].Storage.Get(common.GetPrm{Address: prm.Address, StorageID: fromID})
+	if err != nil {
+		return MigrateRes{}, err
+	}
+
+	to := b.storage[prm.Tier]
+	if to.Policy != nil && !to.Policy(getRes.Object, getRes.RawData) {
+		return MigrateRes{}, ErrTierMismatch
+	}
+
+	putRes, err := to.Storage.Put(common.PutPrm{
+		Address:      prm.Address,
+		Object:       getRes.Object,
+		RawData:      getRes.RawData,
+		DontCompress: !b.NeedsCompression(getRes.Object),
+	})
+	if err != nil {
+		return MigrateRes{}, fmt.Errorf("could not put object to %s: %w", to.Storage.Type(), err)
+	}
+
+	if prm.Commit != nil {
+		if err := prm.Commit(putRes.StorageID); err != nil {
+			_, dErr := to.Storage.Delete(common.DeletePrm{Address: prm.Address, StorageID: putRes.StorageID})
+			if dErr != nil {
+				b.log.Warn("could not remove object copy after failed migration",
+					zap.Stringer("address", prm.Address),
+					zap.String("storage", to.Storage.Type()),
+					zap.String("error", dErr.Error()))
+			}
+
+			return MigrateRes{}, err
+		}
+	}
+
+	logOp(b.log, putOp, prm.Address, to.Storage.Type(), putRes.StorageID)
+
+	_, err = b.storage[from].Storage.Delete(common.DeletePrm{Address: prm.Address, StorageID: fromID})
+	if err != nil {
+		b.log.Warn("could not remove migrated object from the old sub-storage",
+			zap.Stringer("address", prm.Address),
+			zap.String("storage", b.storage[from].Storage.Type()),
+			zap.String("error", err.Error()))
+	} else {
+		logOp(b.log, deleteOp, prm.Address, b.storage[from].Storage.Type(), fromID)
+	}
+
+	return MigrateRes{StorageID: putRes.StorageID, Moved: true}, nil
+}
+
+// storageOf returns the index of the sub-storage the object is stored in
+// and the storage ID to access the object in it. The sub-storage is chosen
+// by the storage ID the same way Get does it, if the object is not there,
+// each sub-storage is searched for the object.
+func (b *BlobStor) storageOf(addr oid.Address, storageID []byte) (int, []byte, error) {
+	guess := 0
+	if len(storageID) == 0 {
+		guess = len(b.storage) - 1
+	}
+
+	res, err := b.storage[guess].Storage.Exists(common.ExistsPrm{Address: addr, StorageID: storageID})
+	if err == nil && res.Exists {
+		return guess, storageID, nil
+	}
+
+	for i := range b.storage {
+		if i == guess {
+			continue
+		}
+
+		// storage ID is specific to the sub-storage, so it is not passed
+		res, err = b.storage[i].Storage.Exists(common.ExistsPrm{Address: addr})
+		if err != nil {
+			b.log.Debug("could not check object existence in the sub-storage",
+				zap.Stringer("address", addr),
+				zap.String("storage", b.storage[i].Storage.Type()),
+				zap.String("error", err.Error()))
+			continue
+		}
+
+		if res.Exists {
+			return i, nil, nil
+		}
+	}
+
+	return 0, nil, logicerr.Wrap(apistatus.ObjectNotFound{})
+}
@@ -0,0 +1,149 @@
+package blobstor
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	const smallSizeLimit = 512
+
+	bs := New(WithStorages(defaultStorages(t.TempDir(), smallSizeLimit)))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+	t.Cleanup(func() { _ = bs.Close() })
+
+	obj := testObject(smallSizeLimit / 2)
+	addr := object.AddressOf(obj)
+
+	putRes, err := bs.Put(common.PutPrm{Object: obj})
+	require.NoError(t, err)
+	require.NotEmpty(t, putRes.StorageID)
+
+	checkGet := func(t *testing.T, id []byte) {
+		res, err := bs.Get(common.GetPrm{Address: addr, StorageID: id})
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object)
+	}
+
+	t.Run("failed commit", func(t *testing.T) {
+		errCommit := errors.New("commit")
+
+		_, err := bs.Migrate(MigratePrm{
+			Address:   addr,
+			StorageID: putRes.StorageID,
+			Tier:      1,
+			Commit:    func([]byte) error { return errCommit },
+		})
+		require.ErrorIs(t, err, errCommit)
+
+		checkGet(t, putRes.StorageID)
+
+		_, err = bs.storage[1].Storage.Get(common.GetPrm{Address: addr, StorageID: []byte{}})
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	var committed []byte
+
+	res, err := bs.Migrate(MigratePrm{
+		Address:   addr,
+		StorageID: putRes.StorageID,
+		Tier:      1,
+		Commit: func(id []byte) error {
+			committed = id
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, res.Moved)
+	require.NotNil(t, res.StorageID)
+	require.Empty(t, res.StorageID)
+	require.Equal(t, res.StorageID, committed)
+
+	checkGet(t, res.StorageID)
+
+	_, err = bs.Get(common.GetPrm{Address: addr, StorageID: putRes.StorageID})
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	// already stored in the requested tier
+	again, err := bs.Migrate(MigratePrm{Address: addr, StorageID: res.StorageID, Tier: 1})
+	require.NoError(t, err)
+	require.False(t, again.Moved)
+
+	res, err = bs.Migrate(MigratePrm{Address: addr, StorageID: res.StorageID, Tier: 0})
+	require.NoError(t, err)
+	require.True(t, res.Moved)
+	require.NotEmpty(t, res.StorageID)
+
+	checkGet(t, res.StorageID)
+
+	t.Run("stale storage ID", func(t *testing.T) {
+		// object is in the first sub-storage, but the ID points to the last one
+		res, err := bs.Migrate(MigratePrm{Address: addr, StorageID: []byte{}, Tier: 0})
+		require.NoError(t, err)
+		require.False(t, res.Moved)
+
+		res, err = bs.Migrate(MigratePrm{Address: addr, StorageID: []byte{}, Tier: 1})
+		require.NoError(t, err)
+		require.True(t, res.Moved)
+
+		checkGet(t, res.StorageID)
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		big := testObject(smallSizeLimit * 2)
+
+		putRes, err := bs.Put(common.PutPrm{Object: big})
+		require.NoError(t, err)
+
+		_, err = bs.Migrate(MigratePrm{Address: object.AddressOf(big), StorageID: putRes.StorageID, Tier: 0})
+		require.ErrorIs(t, err, ErrTierMismatch)
+	})
+}
+
+func TestMigrateNotLastTier(t *testing.T) {
+	const smallSizeLimit = 512
+
+	dir := t.TempDir()
+	storages := append(defaultStorages(dir, smallSizeLimit),
+		SubStorage{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "last")))})
+
+	bs := New(WithStorages(storages))
+	require.NoError(t, bs.Open(false))
+	require.NoError(t, bs.Init())
+	t.Cleanup(func() { _ = bs.Close() })
+
+	obj := testObject(smallSizeLimit / 2)
+	addr := object.AddressOf(obj)
+
+	putRes, err := bs.Put(common.PutPrm{Object: obj})
+	require.NoError(t, err)
+
+	res, err := bs.Migrate(MigratePrm{Address: addr, StorageID: putRes.StorageID, Tier: 1})
+	require.NoError(t, err)
+	require.True(t, res.Moved)
+
+	// empty storage ID points to the last sub-storage, but the object
+	// is found in the other one
+	getRes, err := bs.Get(common.GetPrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+	require.Equal(t, obj, getRes.Object)
+
+	exRes, err := bs.Exists(common.ExistsPrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+	require.True(t, exRes.Exists)
+
+	_, err = bs.Delete(common.DeletePrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+
+	exRes, err = bs.Exists(common.ExistsPrm{Address: addr, StorageID: res.StorageID})
+	require.NoError(t, err)
+	require.False(t, exRes.Exists)
+}
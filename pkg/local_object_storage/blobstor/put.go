@@ -7,6 +7,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
 )
 
 // ErrNoPlaceFound is returned when object can't be saved to any sub-storage component
@@ -25,6 +26,25 @@ func (b *BlobStor) Put(prm common.PutPrm) (common.PutRes, error) {
 	b.modeMtx.RLock()
 	defer b.modeMtx.RUnlock()
 
+	if prm.Object != nil {
+		prm.Address = object.AddressOf(prm.Object)
+	}
+
+	if hash, ok := b.deduplicable(prm.Object); ok {
+		res, err := b.putDeduplicated(prm, hash)
+		if err == nil {
+			return res, nil
+		}
+
+		b.log.Debug("could not deduplicate object payload, saving the object entirely",
+			zap.Stringer("address", prm.Address),
+			zap.String("error", err.Error()))
+	}
+
+	return b.put(prm)
+}
+
+func (b *BlobStor) put(prm common.PutPrm) (common.PutRes, error) {
 	if prm.Object != nil {
 		prm.Address = object.AddressOf(prm.Object)
 	}
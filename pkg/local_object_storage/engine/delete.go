@@ -1,12 +1,16 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +19,8 @@ type DeletePrm struct {
 	addr oid.Address
 
 	forceRemoval bool
+
+	ctx context.Context
 }
 
 // DeleteRes groups the resulting values of Delete operation.
@@ -34,6 +40,12 @@ func (p *DeletePrm) WithForceRemoval() {
 	p.forceRemoval = true
 }
 
+// WithContext is a Delete option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *DeletePrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Delete marks the objects to be removed.
 //
 // Returns an error if executions are blocked (see BlockExecution).
@@ -45,6 +57,10 @@ func (p *DeletePrm) WithForceRemoval() {
 // on operations with that object) if WithForceRemoval option has
 // been provided.
 func (e *StorageEngine) Delete(prm DeletePrm) (res DeleteRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Delete", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.delete(prm)
 		return err
@@ -91,6 +107,7 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 
 		var shPrm shard.InhumePrm
 		shPrm.MarkAsGarbage(prm.addr)
+		shPrm.SetContext(prm.ctx)
 		if prm.forceRemoval {
 			shPrm.ForceRemoval()
 		}
@@ -113,21 +130,23 @@ func (e *StorageEngine) delete(prm DeletePrm) (DeleteRes, error) {
 	}
 
 	if splitInfo != nil {
-		e.deleteChildren(prm.addr, prm.forceRemoval, splitInfo.SplitID())
+		e.deleteChildren(prm.ctx, prm.addr, prm.forceRemoval, splitInfo.SplitID())
 	}
 
 	return DeleteRes{}, nil
 }
 
-func (e *StorageEngine) deleteChildren(addr oid.Address, force bool, splitID *objectSDK.SplitID) {
+func (e *StorageEngine) deleteChildren(ctx context.Context, addr oid.Address, force bool, splitID *objectSDK.SplitID) {
 	var fs objectSDK.SearchFilters
 	fs.AddSplitIDFilter(objectSDK.MatchStringEqual, splitID)
 
 	var selectPrm shard.SelectPrm
 	selectPrm.SetFilters(fs)
 	selectPrm.SetContainerID(addr.Container())
+	selectPrm.SetContext(ctx)
 
 	var inhumePrm shard.InhumePrm
+	inhumePrm.SetContext(ctx)
 	if force {
 		inhumePrm.ForceRemoval()
 	}
@@ -32,6 +32,8 @@ type StorageEngine struct {
 
 		err error
 	}
+
+	evacuation evacuationJobs
 }
 
 type shardWrapper struct {
@@ -202,6 +204,10 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	highWatermark float64
+
+	evacuationStatePath string
 }
 
 func defaultCfg() *cfg {
@@ -257,3 +263,21 @@ func WithErrorThreshold(sz uint32) Option {
 		c.errorsThreshold = sz
 	}
 }
+
+// WithEvacuationStatePath returns an option to specify the file the state of
+// the background evacuation job is persisted to, so that the job can be
+// resumed after the restart. Empty path means the state is kept in memory only.
+func WithEvacuationStatePath(p string) Option {
+	return func(c *cfg) {
+		c.evacuationStatePath = p
+	}
+}
+
+// WithShardHighWatermark returns an option to specify the fill ratio of the
+// shard's disk space in the (0, 1] range above which new objects are not
+// written to the shard. Zero value disables the limit.
+func WithShardHighWatermark(v float64) Option {
+	return func(c *cfg) {
+		c.highWatermark = v
+	}
+}
@@ -1,28 +1,35 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/hrw"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 )
 
 // EvacuateShardPrm represents parameters for the EvacuateShard operation.
 type EvacuateShardPrm struct {
 	shardID      []*shard.ID
-	handler      func(oid.Address, *objectSDK.Object) error
+	handler      func(context.Context, oid.Address, *objectSDK.Object) error
+	treeHandler  func(context.Context, cid.ID, string, *pilorama.Move) error
 	ignoreErrors bool
 }
 
 // EvacuateShardRes represents result of the EvacuateShard operation.
 type EvacuateShardRes struct {
-	count int
+	count      int
+	treesCount int
 }
 
 // WithShardIDList sets shard ID.
@@ -36,16 +43,28 @@ func (p *EvacuateShardPrm) WithIgnoreErrors(ignore bool) {
 }
 
 // WithFaultHandler sets handler to call for objects which cannot be saved on other shards.
-func (p *EvacuateShardPrm) WithFaultHandler(f func(oid.Address, *objectSDK.Object) error) {
+func (p *EvacuateShardPrm) WithFaultHandler(f func(context.Context, oid.Address, *objectSDK.Object) error) {
 	p.handler = f
 }
 
+// WithTreeHandler sets handler to call for every operation of the trees
+// which cannot be saved on other shards.
+func (p *EvacuateShardPrm) WithTreeHandler(f func(context.Context, cid.ID, string, *pilorama.Move) error) {
+	p.treeHandler = f
+}
+
 // Count returns amount of evacuated objects.
 // Objects for which handler returned no error are also assumed evacuated.
 func (p EvacuateShardRes) Count() int {
 	return p.count
 }
 
+// TreesCount returns amount of evacuated trees.
+// Trees for which handler returned no error are also assumed evacuated.
+func (p EvacuateShardRes) TreesCount() int {
+	return p.treesCount
+}
+
 const defaultEvacuateBatchSize = 100
 
 type pooledShard struct {
@@ -53,33 +72,76 @@ type pooledShard struct {
 	pool util.WorkerPool
 }
 
-var errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
+var (
+	errMustHaveTwoShards = errors.New("must have at least 1 spare shard")
+	errPutTree           = errors.New("could not put tree to any shard")
+)
+
+// evacuationProgress describes the position of the evacuation process,
+// so that it can be continued after interruption.
+type evacuationProgress struct {
+	// shardIndex is an index of the shard being evacuated in the shard ID list.
+	shardIndex int
+	// cursor is a position of the current batch in the shard being evacuated.
+	cursor *meta.Cursor
+	// offset is a number of processed objects in the current batch.
+	offset int
+	// objectsDone is true if all objects of the current shard have been processed.
+	objectsDone bool
+	// treeIndex is an index of the tree being evacuated in the shard's tree list.
+	treeIndex int
+	// height is a height of the next operation of the tree being evacuated.
+	height uint64
+
+	evacuated atomic.Uint64
+	failed    atomic.Uint64
+
+	treesEvacuated atomic.Uint64
+	treesFailed    atomic.Uint64
+
+	// onCheckpoint is called when the position can be persisted.
+	onCheckpoint func()
+}
 
 // Evacuate moves data from one shard to the others.
 // The shard being moved must be in read-only mode.
 func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error) {
-	sidList := make([]string, len(prm.shardID))
-	for i := range prm.shardID {
-		sidList[i] = prm.shardID[i].String()
-	}
+	return e.evacuate(context.Background(), prm, new(evacuationProgress))
+}
 
+// checkEvacuation checks that the shards from the list can be evacuated.
+func (e *StorageEngine) checkEvacuation(prm EvacuateShardPrm) error {
 	e.mtx.RLock()
-	for i := range sidList {
-		sh, ok := e.shards[sidList[i]]
+	defer e.mtx.RUnlock()
+
+	for i := range prm.shardID {
+		sh, ok := e.shards[prm.shardID[i].String()]
 		if !ok {
-			e.mtx.RUnlock()
-			return EvacuateShardRes{}, errShardNotFound
+			return errShardNotFound
 		}
 
 		if !sh.GetMode().ReadOnly() {
-			e.mtx.RUnlock()
-			return EvacuateShardRes{}, shard.ErrMustBeReadOnly
+			return shard.ErrMustBeReadOnly
 		}
 	}
 
-	if len(e.shards)-len(sidList) < 1 && prm.handler == nil {
-		e.mtx.RUnlock()
-		return EvacuateShardRes{}, errMustHaveTwoShards
+	if len(e.shards)-len(prm.shardID) < 1 && prm.handler == nil {
+		return errMustHaveTwoShards
+	}
+
+	return nil
+}
+
+// evacuate moves data from the shards starting from the position described
+// by the progress and updates it. Stops when ctx is done.
+func (e *StorageEngine) evacuate(ctx context.Context, prm EvacuateShardPrm, p *evacuationProgress) (EvacuateShardRes, error) {
+	if err := e.checkEvacuation(prm); err != nil {
+		return EvacuateShardRes{}, err
+	}
+
+	sidList := make([]string, len(prm.shardID))
+	for i := range prm.shardID {
+		sidList[i] = prm.shardID[i].String()
 	}
 
 	e.log.Info("started shards evacuation", zap.Strings("shard_ids", sidList))
@@ -87,6 +149,7 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 	// We must have all shards, to have correct information about their
 	// indexes in a sorted slice and set appropriate marks in the metabase.
 	// Evacuated shard is skipped during put.
+	e.mtx.RLock()
 	shards := make([]pooledShard, 0, len(e.shards))
 	for id := range e.shards {
 		shards = append(shards, pooledShard{
@@ -98,7 +161,7 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 
 	weights := make([]float64, 0, len(shards))
 	for i := range shards {
-		weights = append(weights, e.shardWeight(shards[i].Shard))
+		weights = append(weights, e.placementWeight(shards[i].Shard))
 	}
 
 	shardMap := make(map[string]*shard.Shard)
@@ -115,20 +178,21 @@ func (e *StorageEngine) Evacuate(prm EvacuateShardPrm) (EvacuateShardRes, error)
 
 	var res EvacuateShardRes
 
-mainLoop:
-	for n := range sidList {
+	for ; p.shardIndex < len(sidList); p.nextShard() {
+		n := p.shardIndex
 		sh := shardMap[sidList[n]]
 
-		var c *meta.Cursor
-		for {
-			listPrm.WithCursor(c)
+		for !p.objectsDone {
+			p.checkpoint()
+			listPrm.WithCursor(p.cursor)
 
 			// TODO (@fyrchik): #1731 this approach doesn't work in degraded modes
 			//  because ListWithCursor works only with the metabase.
 			listRes, err := sh.ListWithCursor(listPrm)
 			if err != nil {
 				if errors.Is(err, meta.ErrEndOfListing) || errors.Is(err, shard.ErrDegradedMode) {
-					continue mainLoop
+					p.objectsDone = true
+					break
 				}
 				return res, err
 			}
@@ -138,14 +202,26 @@ mainLoop:
 
 		loop:
 			for i := range lst {
+				if i < p.offset {
+					// already processed before the interruption
+					continue
+				}
+
+				p.offset = i
+				if err := ctx.Err(); err != nil {
+					return res, err
+				}
+
 				addr := lst[i].Address
 
 				var getPrm shard.GetPrm
 				getPrm.SetAddress(addr)
+				getPrm.SetIOClass(limiter.Background)
 
 				getRes, err := sh.Get(getPrm)
 				if err != nil {
 					if prm.ignoreErrors {
+						p.failed.Inc()
 						continue
 					}
 					return res, err
@@ -156,7 +232,7 @@ mainLoop:
 					if _, ok := shardMap[shards[j].ID().String()]; ok {
 						continue
 					}
-					putDone, exists := e.putToShard(shards[j].hashedShard, j, shards[j].pool, addr, getRes.Object())
+					putDone, exists := e.putToShard(ctx, shards[j].hashedShard, nil, shards[j].pool, addr, getRes.Object(), limiter.Background)
 					if putDone || exists {
 						if putDone {
 							e.log.Debug("object is moved to another shard",
@@ -165,6 +241,7 @@ mainLoop:
 								zap.Stringer("addr", addr))
 
 							res.count++
+							p.evacuated.Inc()
 						}
 						continue loop
 					}
@@ -176,14 +253,22 @@ mainLoop:
 					return res, fmt.Errorf("%w: %s", errPutShard, lst[i])
 				}
 
-				err = prm.handler(addr, getRes.Object())
+				err = prm.handler(ctx, addr, getRes.Object())
 				if err != nil {
+					if ctxErr := ctx.Err(); ctxErr != nil {
+						return res, ctxErr
+					}
 					return res, err
 				}
 				res.count++
+				p.evacuated.Inc()
 			}
 
-			c = listRes.Cursor()
+			p.cursor, p.offset = listRes.Cursor(), 0
+		}
+
+		if err := e.evacuateTrees(ctx, prm, sh, shardMap, &res, p); err != nil {
+			return res, err
 		}
 	}
 
@@ -191,3 +276,139 @@ mainLoop:
 		zap.Strings("shard_ids", sidList))
 	return res, nil
 }
+
+// evacuateTrees moves the trees stored in the shard to the other shards
+// starting from the position described by the progress. Trees which cannot
+// be saved on other shards are passed to the tree handler.
+func (e *StorageEngine) evacuateTrees(ctx context.Context, prm EvacuateShardPrm, sh *shard.Shard,
+	shardMap map[string]*shard.Shard, res *EvacuateShardRes, p *evacuationProgress) error {
+	trees, err := sh.TreeListAll()
+	if err != nil {
+		if errors.Is(err, shard.ErrPiloramaDisabled) || errors.Is(err, pilorama.ErrDegradedMode) {
+			return nil
+		}
+		return fmt.Errorf("could not list trees: %w", err)
+	}
+
+	for ; p.treeIndex < len(trees); p.treeIndex, p.height = p.treeIndex+1, 0 {
+		tr := trees[p.treeIndex]
+
+		p.checkpoint()
+		err := e.evacuateTree(ctx, prm, sh, tr, shardMap, p)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if !prm.ignoreErrors {
+				return fmt.Errorf("could not evacuate tree %s of the container %s: %w", tr.TreeID, tr.CID, err)
+			}
+
+			e.log.Warn("could not evacuate tree",
+				zap.Stringer("shard_id", sh.ID()),
+				zap.Stringer("cid", tr.CID),
+				zap.String("tree_id", tr.TreeID),
+				zap.Error(err))
+
+			p.treesFailed.Inc()
+			continue
+		}
+
+		res.treesCount++
+		p.treesEvacuated.Inc()
+	}
+
+	return nil
+}
+
+// evacuateTree copies the op log of the tree to another shard or passes
+// it to the tree handler if there is no shard to store the tree on.
+func (e *StorageEngine) evacuateTree(ctx context.Context, prm EvacuateShardPrm, sh *shard.Shard,
+	tr pilorama.ContainerIDTreeID, shardMap map[string]*shard.Shard, p *evacuationProgress) error {
+	var apply func(*pilorama.Move) error
+
+	target := e.treeEvacuationTarget(tr, shardMap)
+	if target != nil {
+		d := pilorama.CIDDescriptor{CID: tr.CID, Position: 0, Size: 1}
+		apply = func(op *pilorama.Move) error {
+			return target.TreeApply(d, tr.TreeID, op, true)
+		}
+	} else if prm.treeHandler != nil {
+		apply = func(op *pilorama.Move) error {
+			return prm.treeHandler(ctx, tr.CID, tr.TreeID, op)
+		}
+	} else {
+		return errPutTree
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		op, err := sh.TreeGetOpLog(tr.CID, tr.TreeID, p.height)
+		if err != nil {
+			return err
+		}
+		if op.Time == 0 {
+			break
+		}
+
+		if err := apply(&op); err != nil {
+			return err
+		}
+
+		p.height = op.Time + 1
+	}
+
+	if target != nil {
+		e.log.Debug("tree is moved to another shard",
+			zap.Stringer("from", sh.ID()),
+			zap.Stringer("to", target.ID()),
+			zap.Stringer("cid", tr.CID),
+			zap.String("tree_id", tr.TreeID))
+	}
+	return nil
+}
+
+// treeEvacuationTarget returns the shard to move the tree to. The shard
+// which already stores the tree is preferred, so that the interrupted
+// evacuation continues on the same shard. Returns nil if there is no
+// writable shard with the pilorama enabled.
+func (e *StorageEngine) treeEvacuationTarget(tr pilorama.ContainerIDTreeID, shardMap map[string]*shard.Shard) *shard.Shard {
+	var target *shard.Shard
+
+	for _, sh := range e.sortShards(tr.CID) {
+		if _, ok := shardMap[sh.ID().String()]; ok || sh.GetMode().ReadOnly() {
+			continue
+		}
+
+		exists, err := sh.TreeExists(tr.CID, tr.TreeID)
+		if err != nil {
+			// pilorama is disabled or unavailable
+			continue
+		}
+		if exists {
+			return sh.Shard
+		}
+		if target == nil {
+			target = sh.Shard
+		}
+	}
+
+	return target
+}
+
+// checkpoint reports the progress to be persisted. It is called
+// at the batch and tree boundaries.
+func (p *evacuationProgress) checkpoint() {
+	if p.onCheckpoint != nil {
+		p.onCheckpoint()
+	}
+}
+
+// nextShard moves the progress to the beginning of the next shard.
+func (p *evacuationProgress) nextShard() {
+	p.shardIndex++
+	p.cursor, p.offset, p.objectsDone = nil, 0, false
+	p.treeIndex, p.height = 0, 0
+}
@@ -0,0 +1,469 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// EvacuationStatus is a status of the background evacuation job.
+type EvacuationStatus uint8
+
+const (
+	// EvacuationUndefined is an undefined status of the evacuation job.
+	EvacuationUndefined EvacuationStatus = iota
+	// EvacuationRunning means that the evacuation job is in progress.
+	EvacuationRunning
+	// EvacuationStopped means that the evacuation job has been stopped
+	// by the user and can be resumed.
+	EvacuationStopped
+	// EvacuationCompleted means that all objects have been evacuated.
+	EvacuationCompleted
+	// EvacuationFailed means that the evacuation job has been interrupted
+	// by an error and can be resumed.
+	EvacuationFailed
+)
+
+// String implements fmt.Stringer.
+func (s EvacuationStatus) String() string {
+	switch s {
+	case EvacuationRunning:
+		return "running"
+	case EvacuationStopped:
+		return "stopped"
+	case EvacuationCompleted:
+		return "completed"
+	case EvacuationFailed:
+		return "failed"
+	default:
+		return "undefined"
+	}
+}
+
+var (
+	// ErrEvacuationInProgress is returned when the evacuation job is started
+	// or resumed while another one is running.
+	ErrEvacuationInProgress = logicerr.New("evacuation is already in progress")
+	// ErrEvacuationNotRunning is returned when there is no running evacuation job to stop.
+	ErrEvacuationNotRunning = logicerr.New("evacuation is not running")
+	// ErrEvacuationNotFound is returned when there is no evacuation job.
+	ErrEvacuationNotFound = logicerr.New("evacuation has not been started")
+	// ErrEvacuationCompleted is returned when the completed evacuation job is resumed.
+	ErrEvacuationCompleted = logicerr.New("evacuation is already completed")
+)
+
+// EvacuationState describes the state of the background evacuation job.
+type EvacuationState struct {
+	id         string
+	shardIDs   []*shard.ID
+	status     EvacuationStatus
+	total      uint64
+	evacuated  uint64
+	failed     uint64
+	startedAt  time.Time
+	finishedAt time.Time
+	err        string
+
+	interrupted bool
+
+	treesTotal     uint64
+	treesEvacuated uint64
+	treesFailed    uint64
+}
+
+// ID returns the identifier of the evacuation job.
+func (s EvacuationState) ID() string {
+	return s.id
+}
+
+// ShardIDs returns the list of evacuated shards.
+func (s EvacuationState) ShardIDs() []*shard.ID {
+	return s.shardIDs
+}
+
+// Status returns the status of the evacuation job.
+func (s EvacuationState) Status() EvacuationStatus {
+	return s.status
+}
+
+// Total returns the number of objects in the evacuated shards
+// at the moment the job has been started.
+func (s EvacuationState) Total() uint64 {
+	return s.total
+}
+
+// Evacuated returns the number of evacuated objects.
+func (s EvacuationState) Evacuated() uint64 {
+	return s.evacuated
+}
+
+// Failed returns the number of objects that have been skipped because of errors.
+func (s EvacuationState) Failed() uint64 {
+	return s.failed
+}
+
+// Remaining returns the estimated number of objects left to evacuate.
+func (s EvacuationState) Remaining() uint64 {
+	if done := s.evacuated + s.failed; done < s.total {
+		return s.total - done
+	}
+	return 0
+}
+
+// TreesTotal returns the number of trees in the evacuated shards
+// at the moment the job has been started.
+func (s EvacuationState) TreesTotal() uint64 {
+	return s.treesTotal
+}
+
+// TreesEvacuated returns the number of evacuated trees.
+func (s EvacuationState) TreesEvacuated() uint64 {
+	return s.treesEvacuated
+}
+
+// TreesFailed returns the number of trees that have been skipped because of errors.
+func (s EvacuationState) TreesFailed() uint64 {
+	return s.treesFailed
+}
+
+// TreesRemaining returns the estimated number of trees left to evacuate.
+func (s EvacuationState) TreesRemaining() uint64 {
+	if done := s.treesEvacuated + s.treesFailed; done < s.treesTotal {
+		return s.treesTotal - done
+	}
+	return 0
+}
+
+// StartedAt returns the time the evacuation job has been started at.
+func (s EvacuationState) StartedAt() time.Time {
+	return s.startedAt
+}
+
+// FinishedAt returns the time the evacuation job has been finished at.
+// Zero time is returned for the running job.
+func (s EvacuationState) FinishedAt() time.Time {
+	return s.finishedAt
+}
+
+// Error returns the error message of the failed evacuation job.
+func (s EvacuationState) Error() string {
+	return s.err
+}
+
+// Interrupted checks whether the job has been interrupted by the
+// node shutdown and has not been resumed yet.
+func (s EvacuationState) Interrupted() bool {
+	return s.interrupted
+}
+
+// evacuationJob is a background evacuation process.
+type evacuationJob struct {
+	id         string
+	prm        EvacuateShardPrm
+	progress   evacuationProgress
+	total      uint64
+	treesTotal uint64
+	status     EvacuationStatus
+	startedAt  time.Time
+	finishedAt time.Time
+	err        error
+
+	// interrupted is set if the job has been stopped by the engine shutdown.
+	interrupted bool
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// ResumeEvacuationPrm groups the parameters of ResumeEvacuation operation.
+type ResumeEvacuationPrm struct {
+	handler     func(context.Context, oid.Address, *objectSDK.Object) error
+	treeHandler func(context.Context, cid.ID, string, *pilorama.Move) error
+}
+
+// WithFaultHandler sets handler to call for objects which cannot be saved on other shards.
+// If not set, the handler the job has been started with is used.
+func (p *ResumeEvacuationPrm) WithFaultHandler(f func(context.Context, oid.Address, *objectSDK.Object) error) {
+	p.handler = f
+}
+
+// WithTreeHandler sets handler to call for every operation of the trees
+// which cannot be saved on other shards. If not set, the handler the
+// job has been started with is used.
+func (p *ResumeEvacuationPrm) WithTreeHandler(f func(context.Context, cid.ID, string, *pilorama.Move) error) {
+	p.treeHandler = f
+}
+
+// evacuationJobs holds the last evacuation job of the engine.
+type evacuationJobs struct {
+	mtx sync.Mutex
+	job *evacuationJob
+}
+
+// StartEvacuation starts the evacuation of the shards in background.
+// The shards being moved must be in read-only mode. Only one evacuation job
+// can be run at a time, the previous job's state is discarded.
+//
+// If the evacuation state path is configured, the state of the job is
+// persisted and restored after the restart, see ResumeEvacuation.
+//
+// Returns ErrEvacuationInProgress if another job is running.
+func (e *StorageEngine) StartEvacuation(prm EvacuateShardPrm) (EvacuationState, error) {
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	if j := e.evacuation.job; j != nil && j.status == EvacuationRunning {
+		return EvacuationState{}, ErrEvacuationInProgress
+	}
+
+	if err := e.checkEvacuation(prm); err != nil {
+		return EvacuationState{}, err
+	}
+
+	j := &evacuationJob{
+		id:        uuid.New().String(),
+		prm:       prm,
+		startedAt: time.Now(),
+	}
+
+	e.mtx.RLock()
+	for i := range prm.shardID {
+		sh := e.shards[prm.shardID[i].String()]
+
+		cc, err := sh.ObjectCounters()
+		if err != nil {
+			e.log.Warn("could not read object counters of the evacuated shard",
+				zap.Stringer("shard_id", prm.shardID[i]),
+				zap.Error(err))
+		} else {
+			j.total += cc.Logic()
+		}
+
+		// errors are ignored, the shard may have no pilorama
+		if trees, err := sh.TreeListAll(); err == nil {
+			j.treesTotal += uint64(len(trees))
+		}
+	}
+	e.mtx.RUnlock()
+
+	e.evacuation.job = j
+	e.runEvacuation(j)
+
+	return j.state(), nil
+}
+
+// ResumeEvacuation continues the stopped or failed evacuation job
+// from the position it has been interrupted at. The job restored after
+// the restart is continued from the last persisted position, so some
+// objects may be evacuated again.
+func (e *StorageEngine) ResumeEvacuation(prm ResumeEvacuationPrm) (EvacuationState, error) {
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	j := e.evacuation.job
+	switch {
+	case j == nil:
+		return EvacuationState{}, ErrEvacuationNotFound
+	case j.status == EvacuationRunning:
+		return EvacuationState{}, ErrEvacuationInProgress
+	case j.status == EvacuationCompleted:
+		return EvacuationState{}, ErrEvacuationCompleted
+	}
+
+	if prm.handler != nil {
+		j.prm.handler = prm.handler
+	}
+	if prm.treeHandler != nil {
+		j.prm.treeHandler = prm.treeHandler
+	}
+
+	if err := e.checkEvacuation(j.prm); err != nil {
+		return EvacuationState{}, err
+	}
+
+	e.runEvacuation(j)
+
+	return j.state(), nil
+}
+
+// StopEvacuation stops the running evacuation job and waits for it
+// to finish. Stopped job can be resumed with ResumeEvacuation.
+//
+// Returns ErrEvacuationNotRunning if there is no running job.
+func (e *StorageEngine) StopEvacuation() error {
+	e.evacuation.mtx.Lock()
+
+	j := e.evacuation.job
+	if j == nil || j.status != EvacuationRunning {
+		e.evacuation.mtx.Unlock()
+		return ErrEvacuationNotRunning
+	}
+
+	j.cancel()
+	done := j.done
+	e.evacuation.mtx.Unlock()
+
+	<-done
+	return nil
+}
+
+// EvacuationStatus returns the state of the last evacuation job.
+//
+// Returns ErrEvacuationNotFound if no job has been started.
+func (e *StorageEngine) EvacuationStatus() (EvacuationState, error) {
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	if e.evacuation.job == nil {
+		return EvacuationState{}, ErrEvacuationNotFound
+	}
+
+	return e.evacuation.job.state(), nil
+}
+
+// WaitEvacuation waits for the evacuation job with the given identifier to
+// finish and returns its final state. ErrEvacuationNotFound is returned if
+// the job has been replaced by another one.
+func (e *StorageEngine) WaitEvacuation(ctx context.Context, id string) (EvacuationState, error) {
+	for {
+		e.evacuation.mtx.Lock()
+		j := e.evacuation.job
+		if j == nil || j.id != id {
+			e.evacuation.mtx.Unlock()
+			return EvacuationState{}, ErrEvacuationNotFound
+		}
+
+		if j.status != EvacuationRunning {
+			st := j.state()
+			e.evacuation.mtx.Unlock()
+			return st, nil
+		}
+
+		done := j.done
+		e.evacuation.mtx.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return EvacuationState{}, ctx.Err()
+		}
+	}
+}
+
+// isEvacuating checks whether any of the shards is being evacuated.
+// Must be called with the evacuation mutex held.
+func (e *StorageEngine) isEvacuating(ids []*shard.ID) bool {
+	j := e.evacuation.job
+	if j == nil || j.status != EvacuationRunning {
+		return false
+	}
+
+	for i := range ids {
+		for k := range j.prm.shardID {
+			if ids[i].String() == j.prm.shardID[k].String() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// runEvacuation starts the job in a separate goroutine.
+// Must be called with the evacuation mutex held.
+func (e *StorageEngine) runEvacuation(j *evacuationJob) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	j.status = EvacuationRunning
+	j.finishedAt = time.Time{}
+	j.err = nil
+	j.interrupted = false
+	j.ctx = ctx
+	j.cancel = cancel
+	j.done = make(chan struct{})
+	j.progress.onCheckpoint = func() {
+		e.evacuation.mtx.Lock()
+		e.saveEvacuation(j)
+		e.evacuation.mtx.Unlock()
+	}
+
+	e.saveEvacuation(j)
+
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		defer close(j.done)
+		defer cancel()
+
+		go func() {
+			select {
+			case <-e.closeCh:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		_, err := e.evacuate(ctx, j.prm, &j.progress)
+
+		e.evacuation.mtx.Lock()
+		defer e.evacuation.mtx.Unlock()
+
+		j.finishedAt = time.Now()
+		switch {
+		case err == nil:
+			j.status = EvacuationCompleted
+		case errors.Is(err, context.Canceled):
+			j.status = EvacuationStopped
+
+			select {
+			case <-e.closeCh:
+				j.interrupted = true
+			default:
+			}
+		default:
+			j.status = EvacuationFailed
+			j.err = err
+
+			e.log.Error("shards evacuation failed",
+				zap.String("id", j.id),
+				zap.Error(err))
+		}
+
+		e.saveEvacuation(j)
+	}()
+}
+
+func (j *evacuationJob) state() EvacuationState {
+	s := EvacuationState{
+		id:         j.id,
+		shardIDs:   j.prm.shardID,
+		status:     j.status,
+		total:      j.total,
+		evacuated:  j.progress.evacuated.Load(),
+		failed:     j.progress.failed.Load(),
+		startedAt:  j.startedAt,
+		finishedAt: j.finishedAt,
+
+		interrupted: j.interrupted,
+
+		treesTotal:     j.treesTotal,
+		treesEvacuated: j.progress.treesEvacuated.Load(),
+		treesFailed:    j.progress.treesFailed.Load(),
+	}
+
+	if j.err != nil {
+		s.err = j.err.Error()
+	}
+
+	return s
+}
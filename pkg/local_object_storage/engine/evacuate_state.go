@@ -0,0 +1,175 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"go.uber.org/zap"
+)
+
+// evacuationJobState is a persistent state of the evacuation job.
+type evacuationJobState struct {
+	ID           string           `json:"id"`
+	ShardIDs     [][]byte         `json:"shard_ids"`
+	IgnoreErrors bool             `json:"ignore_errors"`
+	Status       EvacuationStatus `json:"status"`
+	StartedAt    time.Time        `json:"started_at"`
+	FinishedAt   time.Time        `json:"finished_at"`
+	Error        string           `json:"error,omitempty"`
+	Interrupted  bool             `json:"interrupted"`
+
+	Total          uint64 `json:"total"`
+	Evacuated      uint64 `json:"evacuated"`
+	Failed         uint64 `json:"failed"`
+	TreesTotal     uint64 `json:"trees_total"`
+	TreesEvacuated uint64 `json:"trees_evacuated"`
+	TreesFailed    uint64 `json:"trees_failed"`
+
+	ShardIndex  int    `json:"shard_index"`
+	Cursor      []byte `json:"cursor,omitempty"`
+	Offset      int    `json:"offset"`
+	ObjectsDone bool   `json:"objects_done"`
+	TreeIndex   int    `json:"tree_index"`
+	Height      uint64 `json:"height"`
+}
+
+// saveEvacuation writes the state of the job to the evacuation state file.
+// Errors are logged, the job is continued with the in-memory state.
+// Must be called with the evacuation mutex held.
+func (e *StorageEngine) saveEvacuation(j *evacuationJob) {
+	if e.evacuationStatePath == "" {
+		return
+	}
+
+	err := writeEvacuationState(e.evacuationStatePath, j.persistentState())
+	if err != nil {
+		e.log.Warn("could not save evacuation state",
+			zap.String("id", j.id),
+			zap.Error(err))
+	}
+}
+
+// loadEvacuation restores the last evacuation job from the evacuation
+// state file. The job which has been running at the shutdown is restored
+// as the stopped one and is marked as interrupted, see ResumeEvacuation.
+func (e *StorageEngine) loadEvacuation() error {
+	if e.evacuationStatePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(e.evacuationStatePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("could not read evacuation state: %w", err)
+	}
+
+	var st evacuationJobState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return fmt.Errorf("could not decode evacuation state: %w", err)
+	}
+
+	j := &evacuationJob{
+		id:         st.ID,
+		total:      st.Total,
+		treesTotal: st.TreesTotal,
+		status:     st.Status,
+		startedAt:  st.StartedAt,
+		finishedAt: st.FinishedAt,
+	}
+
+	j.prm.shardID = make([]*shard.ID, len(st.ShardIDs))
+	for i := range st.ShardIDs {
+		j.prm.shardID[i] = shard.NewIDFromBytes(st.ShardIDs[i])
+	}
+	j.prm.ignoreErrors = st.IgnoreErrors
+
+	if st.Error != "" {
+		j.err = errors.New(st.Error)
+	}
+	if j.status == EvacuationRunning || st.Interrupted {
+		j.status = EvacuationStopped
+		j.interrupted = true
+	}
+
+	p := &j.progress
+	p.shardIndex = st.ShardIndex
+	p.offset = st.Offset
+	p.objectsDone = st.ObjectsDone
+	p.treeIndex = st.TreeIndex
+	p.height = st.Height
+	p.evacuated.Store(st.Evacuated)
+	p.failed.Store(st.Failed)
+	p.treesEvacuated.Store(st.TreesEvacuated)
+	p.treesFailed.Store(st.TreesFailed)
+
+	if st.Cursor != nil {
+		p.cursor = new(meta.Cursor)
+		if err := p.cursor.Unmarshal(st.Cursor); err != nil {
+			return fmt.Errorf("could not decode evacuation cursor: %w", err)
+		}
+	}
+
+	e.evacuation.mtx.Lock()
+	e.evacuation.job = j
+	e.evacuation.mtx.Unlock()
+
+	return nil
+}
+
+func (j *evacuationJob) persistentState() evacuationJobState {
+	st := evacuationJobState{
+		ID:           j.id,
+		ShardIDs:     make([][]byte, len(j.prm.shardID)),
+		IgnoreErrors: j.prm.ignoreErrors,
+		Status:       j.status,
+		StartedAt:    j.startedAt,
+		FinishedAt:   j.finishedAt,
+		Interrupted:  j.interrupted,
+
+		Total:          j.total,
+		Evacuated:      j.progress.evacuated.Load(),
+		Failed:         j.progress.failed.Load(),
+		TreesTotal:     j.treesTotal,
+		TreesEvacuated: j.progress.treesEvacuated.Load(),
+		TreesFailed:    j.progress.treesFailed.Load(),
+
+		ShardIndex:  j.progress.shardIndex,
+		Offset:      j.progress.offset,
+		ObjectsDone: j.progress.objectsDone,
+		TreeIndex:   j.progress.treeIndex,
+		Height:      j.progress.height,
+	}
+
+	for i := range j.prm.shardID {
+		st.ShardIDs[i] = *j.prm.shardID[i]
+	}
+	if j.err != nil {
+		st.Error = j.err.Error()
+	}
+	if j.progress.cursor != nil {
+		st.Cursor = j.progress.cursor.Marshal()
+	}
+
+	return st
+}
+
+// writeEvacuationState atomically replaces the evacuation state file.
+func writeEvacuationState(path string, st evacuationJobState) error {
+	data, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
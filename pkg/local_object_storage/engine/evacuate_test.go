@@ -1,20 +1,24 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -46,6 +50,10 @@ func newEngineEvacuate(t *testing.T, shardNum int, objPerShard int) (*StorageEng
 				meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
 				meta.WithPermissions(0700),
 				meta.WithEpochState(epochState{}),
+			),
+			shard.WithPiloramaOptions(
+				pilorama.WithPath(filepath.Join(dir, fmt.Sprintf("%d.pilorama", i))),
+				pilorama.WithPerm(0700),
 			))
 		require.NoError(t, err)
 	}
@@ -129,9 +137,9 @@ func TestEvacuateShard(t *testing.T) {
 func TestEvacuateNetwork(t *testing.T) {
 	var errReplication = errors.New("handler error")
 
-	acceptOneOf := func(objects []*objectSDK.Object, max int) func(oid.Address, *objectSDK.Object) error {
+	acceptOneOf := func(objects []*objectSDK.Object, max int) func(context.Context, oid.Address, *objectSDK.Object) error {
 		var n int
-		return func(addr oid.Address, obj *objectSDK.Object) error {
+		return func(_ context.Context, addr oid.Address, obj *objectSDK.Object) error {
 			if n == max {
 				return errReplication
 			}
@@ -221,4 +229,277 @@ func TestEvacuateNetwork(t *testing.T) {
 			require.Equal(t, totalCount, res.Count())
 		})
 	})
+	t.Run("trees", func(t *testing.T) {
+		e, ids, objects := newEngineEvacuate(t, 1, 1)
+		sh := e.shards[ids[0].String()]
+
+		cnr := cidtest.ID()
+		expected := populateTrees(t, sh, cnr, "tree1", "tree2")
+
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+		actual := make(map[string][]pilorama.Move)
+
+		var prm EvacuateShardPrm
+		prm.shardID = ids
+		prm.handler = acceptOneOf(objects, 1)
+		prm.treeHandler = func(_ context.Context, id cid.ID, treeID string, op *pilorama.Move) error {
+			require.Equal(t, cnr, id)
+			actual[treeID] = append(actual[treeID], *op)
+			return nil
+		}
+
+		res, err := e.Evacuate(prm)
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Count())
+		require.Equal(t, 2, res.TreesCount())
+		require.Equal(t, expected, actual)
+
+		t.Run("handler error", func(t *testing.T) {
+			prm.handler = acceptOneOf(objects, 1)
+			prm.treeHandler = func(context.Context, cid.ID, string, *pilorama.Move) error {
+				return errReplication
+			}
+
+			_, err := e.Evacuate(prm)
+			require.ErrorIs(t, err, errReplication)
+
+			prm.handler = acceptOneOf(objects, 1)
+			prm.ignoreErrors = true
+
+			_, err = e.Evacuate(prm)
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestEvacuateTrees(t *testing.T) {
+	e, ids, _ := newEngineEvacuate(t, 2, 3)
+	sh := e.shards[ids[0].String()]
+
+	cnr := cidtest.ID()
+	expected := populateTrees(t, sh, cnr, "tree1", "tree2")
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	var prm EvacuateShardPrm
+	prm.shardID = ids[0:1]
+	prm.treeHandler = func(context.Context, cid.ID, string, *pilorama.Move) error {
+		require.FailNow(t, "tree handler must not be called when there is a spare shard")
+		return nil
+	}
+
+	res, err := e.Evacuate(prm)
+	require.NoError(t, err)
+	require.Equal(t, 2, res.TreesCount())
+
+	target := e.shards[ids[1].String()]
+	for treeID, ops := range expected {
+		require.Equal(t, ops, treeOpLog(t, target, cnr, treeID))
+	}
+
+	t.Run("no spare shard", func(t *testing.T) {
+		require.NoError(t, target.SetMode(mode.ReadOnly))
+		prm.treeHandler = nil
+
+		_, err := e.Evacuate(prm)
+		require.ErrorIs(t, err, errPutTree)
+
+		prm.ignoreErrors = true
+
+		res, err := e.Evacuate(prm)
+		require.NoError(t, err)
+		require.Equal(t, 0, res.TreesCount())
+	})
+}
+
+// populateTrees creates the trees in the forest and returns their op logs.
+func populateTrees(t *testing.T, f pilorama.Forest, cnr cid.ID, treeIDs ...string) map[string][]pilorama.Move {
+	res := make(map[string][]pilorama.Move, len(treeIDs))
+	for _, treeID := range treeIDs {
+		for _, path := range []string{"a", "b"} {
+			_, err := f.TreeAddByPath(pilorama.CIDDescriptor{CID: cnr, Position: 0, Size: 1}, treeID,
+				pilorama.AttributeFilename, []string{path}, nil)
+			require.NoError(t, err)
+		}
+		res[treeID] = treeOpLog(t, f, cnr, treeID)
+	}
+	return res
+}
+
+func treeOpLog(t *testing.T, f pilorama.Forest, cnr cid.ID, treeID string) []pilorama.Move {
+	var ops []pilorama.Move
+	for h := uint64(0); ; {
+		op, err := f.TreeGetOpLog(cnr, treeID, h)
+		require.NoError(t, err)
+		if op.Time == 0 {
+			return ops
+		}
+		ops = append(ops, op)
+		h = op.Time + 1
+	}
+}
+
+func TestEvacuationJob(t *testing.T) {
+	const objCount = 3
+
+	e, ids, _ := newEngineEvacuate(t, 1, objCount)
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	_, err := e.EvacuationStatus()
+	require.ErrorIs(t, err, ErrEvacuationNotFound)
+	require.ErrorIs(t, e.StopEvacuation(), ErrEvacuationNotRunning)
+
+	_, err = e.ResumeEvacuation(ResumeEvacuationPrm{})
+	require.ErrorIs(t, err, ErrEvacuationNotFound)
+
+	var (
+		called  = make(chan struct{})
+		unblock = make(chan struct{})
+		blocked = true
+	)
+
+	var prm EvacuateShardPrm
+	prm.shardID = ids
+	prm.handler = func(context.Context, oid.Address, *objectSDK.Object) error {
+		if blocked {
+			blocked = false
+			called <- struct{}{}
+			<-unblock
+		}
+		return nil
+	}
+
+	st, err := e.StartEvacuation(prm)
+	require.NoError(t, err)
+	require.NotEmpty(t, st.ID())
+	require.Equal(t, ids, st.ShardIDs())
+	require.Equal(t, uint64(objCount), st.Total())
+
+	<-called
+
+	_, err = e.StartEvacuation(prm)
+	require.ErrorIs(t, err, ErrEvacuationInProgress)
+	require.ErrorIs(t, e.DetachShards(ids), ErrEvacuationInProgress)
+
+	st, err = e.EvacuationStatus()
+	require.NoError(t, err)
+	require.Equal(t, EvacuationRunning, st.Status())
+
+	stopped := make(chan error)
+	go func() { stopped <- e.StopEvacuation() }()
+
+	// Wait until the job is canceled before unblocking the handler,
+	// so that exactly one object is evacuated.
+	e.evacuation.mtx.Lock()
+	j := e.evacuation.job
+	e.evacuation.mtx.Unlock()
+	require.Eventually(t, func() bool {
+		return j.ctx.Err() != nil
+	}, 3*time.Second, 10*time.Millisecond)
+
+	close(unblock)
+	require.NoError(t, <-stopped)
+
+	st, err = e.EvacuationStatus()
+	require.NoError(t, err)
+	require.Equal(t, EvacuationStopped, st.Status())
+	require.Equal(t, uint64(1), st.Evacuated())
+	require.Equal(t, uint64(objCount-1), st.Remaining())
+	require.False(t, st.FinishedAt().IsZero())
+
+	resumed, err := e.ResumeEvacuation(ResumeEvacuationPrm{})
+	require.NoError(t, err)
+	require.Equal(t, st.ID(), resumed.ID())
+
+	_, err = e.WaitEvacuation(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrEvacuationNotFound)
+
+	st, err = e.WaitEvacuation(context.Background(), resumed.ID())
+	require.NoError(t, err)
+	require.Equal(t, EvacuationCompleted, st.Status())
+
+	require.Equal(t, uint64(objCount), st.Evacuated())
+	require.Equal(t, uint64(0), st.Failed())
+	require.Equal(t, uint64(0), st.Remaining())
+
+	_, err = e.ResumeEvacuation(ResumeEvacuationPrm{})
+	require.ErrorIs(t, err, ErrEvacuationCompleted)
+
+	t.Run("failed", func(t *testing.T) {
+		errHandler := errors.New("handler error")
+		prm.handler = func(context.Context, oid.Address, *objectSDK.Object) error {
+			return errHandler
+		}
+
+		_, err := e.StartEvacuation(prm)
+		require.NoError(t, err)
+
+		var st EvacuationState
+		require.Eventually(t, func() bool {
+			st, err = e.EvacuationStatus()
+			return err == nil && st.Status() == EvacuationFailed
+		}, 3*time.Second, 10*time.Millisecond)
+
+		require.Contains(t, st.Error(), errHandler.Error())
+	})
+}
+
+func TestEvacuationJobRestore(t *testing.T) {
+	const objCount = 3
+
+	e, ids, _ := newEngineEvacuate(t, 1, objCount)
+	require.NoError(t, e.shards[ids[0].String()].SetMode(mode.ReadOnly))
+
+	statePath := filepath.Join(t.TempDir(), "evacuation.state")
+	e.evacuationStatePath = statePath
+
+	var (
+		called  = make(chan struct{})
+		unblock = make(chan struct{})
+		blocked = true
+	)
+
+	var prm EvacuateShardPrm
+	prm.shardID = ids
+	prm.handler = func(context.Context, oid.Address, *objectSDK.Object) error {
+		if blocked {
+			blocked = false
+			called <- struct{}{}
+			<-unblock
+		}
+		return nil
+	}
+
+	st, err := e.StartEvacuation(prm)
+	require.NoError(t, err)
+
+	<-called
+
+	e.evacuation.mtx.Lock()
+	j := e.evacuation.job
+	e.evacuation.mtx.Unlock()
+
+	go func() {
+		<-j.ctx.Done()
+		close(unblock)
+	}()
+	require.NoError(t, e.Close())
+
+	restored := New()
+	restored.evacuationStatePath = statePath
+	require.NoError(t, restored.loadEvacuation())
+
+	rst, err := restored.EvacuationStatus()
+	require.NoError(t, err)
+	require.Equal(t, st.ID(), rst.ID())
+	require.Equal(t, ids, rst.ShardIDs())
+	require.Equal(t, EvacuationStopped, rst.Status())
+	require.True(t, rst.Interrupted())
+	require.Equal(t, uint64(objCount), rst.Total())
+	require.Equal(t, uint64(1), rst.Evacuated())
+
+	rj := restored.evacuation.job
+	require.Equal(t, j.progress.offset, rj.progress.offset)
+	require.Equal(t, j.progress.shardIndex, rj.progress.shardIndex)
 }
@@ -1,20 +1,27 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
-	addr oid.Address
+	addr    oid.Address
+	ioClass limiter.Class
+	ctx     context.Context
 }
 
 // GetRes groups the resulting values of Get operation.
@@ -29,6 +36,17 @@ func (p *GetPrm) WithAddress(addr oid.Address) {
 	p.addr = addr
 }
 
+// WithIOClass is a Get option to set the I/O class of the operation.
+func (p *GetPrm) WithIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// WithContext is a Get option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *GetPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object.
 func (r GetRes) Object() *objectSDK.Object {
 	return r.obj
@@ -44,6 +62,10 @@ func (r GetRes) Object() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Get(prm GetPrm) (res GetRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Get", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.get(prm)
 		return err
@@ -72,6 +94,8 @@ func (e *StorageEngine) get(prm GetPrm) (GetRes, error) {
 
 	var shPrm shard.GetPrm
 	shPrm.SetAddress(prm.addr)
+	shPrm.SetIOClass(prm.ioClass)
+	shPrm.SetContext(prm.ctx)
 
 	var hasDegraded bool
 	var objectExpired bool
@@ -1,20 +1,27 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
-	addr oid.Address
-	raw  bool
+	addr    oid.Address
+	raw     bool
+	ioClass limiter.Class
+	ctx     context.Context
 }
 
 // HeadRes groups the resulting values of Head operation.
@@ -36,6 +43,17 @@ func (p *HeadPrm) WithRaw(raw bool) {
 	p.raw = raw
 }
 
+// WithIOClass is a Head option to set the I/O class of the operation.
+func (p *HeadPrm) WithIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// WithContext is a Head option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *HeadPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Header returns the requested object header.
 //
 // Instance has empty payload.
@@ -53,6 +71,10 @@ func (r HeadRes) Header() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Head(prm HeadPrm) (res HeadRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Head", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.head(prm)
 		return err
@@ -79,6 +101,8 @@ func (e *StorageEngine) head(prm HeadPrm) (HeadRes, error) {
 	var shPrm shard.HeadPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRaw(prm.raw)
+	shPrm.SetIOClass(prm.ioClass)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
 		res, err := sh.Head(shPrm)
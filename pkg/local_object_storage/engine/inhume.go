@@ -6,9 +6,12 @@ import (
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -18,6 +21,8 @@ type InhumePrm struct {
 	addrs     []oid.Address
 
 	forceRemoval bool
+
+	ctx context.Context
 }
 
 // InhumeRes encapsulates results of inhume operation.
@@ -48,6 +53,12 @@ func (p *InhumePrm) WithForceRemoval() {
 	p.tombstone = nil
 }
 
+// WithContext is an Inhume option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *InhumePrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 var errInhumeFailure = errors.New("inhume operation failed")
 
 // Inhume calls metabase. Inhume method to mark an object as removed. It won't be
@@ -61,6 +72,10 @@ var errInhumeFailure = errors.New("inhume operation failed")
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Inhume(prm InhumePrm) (res InhumeRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Inhume", attribute.Int("objects", len(prm.addrs)))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.inhume(prm)
 		return err
@@ -75,6 +90,7 @@ func (e *StorageEngine) inhume(prm InhumePrm) (InhumeRes, error) {
 	}
 
 	var shPrm shard.InhumePrm
+	shPrm.SetContext(prm.ctx)
 	if prm.forceRemoval {
 		shPrm.ForceRemoval()
 	}
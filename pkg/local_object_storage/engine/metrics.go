@@ -21,6 +21,21 @@ type MetricRegister interface {
 	AddToObjectCounter(shardID, objectType string, delta int)
 
 	SetReadonly(shardID string, readonly bool)
+
+	AddCompressedSize(shardID string, originalSize, compressedSize int)
+	IncCompressionSkipped(shardID string)
+
+	AddCompaction(shardID string, compacted int, reclaimed uint64)
+
+	AddScrubbedObjects(shardID string, checked, corrupted int)
+
+	AddTierMigrations(shardID string, promoted, demoted int)
+
+	AddWriteCacheBatch(shardID string, d time.Duration)
+	SetFlushBacklog(shardID string, count uint64)
+
+	AddShardOperationDuration(shardID, op string, d time.Duration)
+	AddStorageOperationDuration(shardID, storageType, op string, d time.Duration)
 }
 
 func elapsed(addFunc func(d time.Duration)) func() {
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+)
+
+// OwnerUsagePrm groups the parameters of OwnerUsage operation.
+type OwnerUsagePrm struct {
+	limit int
+}
+
+// OwnerUsageRes groups the resulting values of OwnerUsage operation.
+type OwnerUsageRes struct {
+	usage []meta.OwnerUsage
+}
+
+// SetLimit sets the maximum number of the owners to return.
+// Zero means no limit.
+func (p *OwnerUsagePrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// Usage returns the object counters of the owners sorted by the
+// physical size in descending order.
+func (r OwnerUsageRes) Usage() []meta.OwnerUsage {
+	return r.usage
+}
+
+// OwnerUsage returns the object counters of the owners with the largest
+// physical size of the objects stored in all shards.
+//
+// Returns an error if executions are blocked (see BlockExecution).
+func (e *StorageEngine) OwnerUsage(prm OwnerUsagePrm) (res OwnerUsageRes, err error) {
+	err = e.execIfNotBlocked(func() error {
+		res = e.ownerUsage(prm)
+		return nil
+	})
+
+	return
+}
+
+func (e *StorageEngine) ownerUsage(prm OwnerUsagePrm) OwnerUsageRes {
+	var (
+		order []string
+		total = make(map[string]meta.OwnerUsage)
+	)
+
+	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
+		// shard tops can't be merged, so all owners are requested
+		res, err := sh.Shard.OwnerUsage(shard.OwnerUsagePrm{})
+		if err != nil {
+			e.reportShardError(sh, "can't get owner usage", err)
+			return false
+		}
+
+		for _, u := range res.Usage() {
+			key := u.Owner().EncodeToString()
+
+			cur, ok := total[key]
+			if !ok {
+				order = append(order, key)
+				total[key] = u
+				continue
+			}
+
+			total[key] = cur.Add(u)
+		}
+
+		return false
+	})
+
+	usage := make([]meta.OwnerUsage, 0, len(order))
+	for _, key := range order {
+		usage = append(usage, total[key])
+	}
+
+	return OwnerUsageRes{
+		usage: meta.SortOwnerUsage(usage, prm.limit),
+	}
+}
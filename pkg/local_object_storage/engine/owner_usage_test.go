@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"os"
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageEngine_OwnerUsage(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 3)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	owners := []user.ID{*usertest.ID(), *usertest.ID(), *usertest.ID()}
+
+	for i := range owners {
+		for j := 0; j < 10; j++ {
+			obj := generateObjectWithCID(t, cidtest.ID())
+			obj.SetOwnerID(&owners[i])
+			obj.SetPayloadSize(uint64(10 * (i + 1)))
+
+			var prm PutPrm
+			prm.WithObject(obj)
+
+			_, err := e.Put(prm)
+			require.NoError(t, err)
+		}
+	}
+
+	var prm OwnerUsagePrm
+	prm.SetLimit(2)
+
+	res, err := e.OwnerUsage(prm)
+	require.NoError(t, err)
+	require.Equal(t, []meta.OwnerUsage{
+		meta.NewOwnerUsage(owners[2], 10, 300, 10, 300),
+		meta.NewOwnerUsage(owners[1], 10, 200, 10, 200),
+	}, res.Usage())
+}
@@ -1,21 +1,29 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
-	obj *objectSDK.Object
+	obj     *objectSDK.Object
+	ioClass limiter.Class
+	ctx     context.Context
 }
 
 // PutRes groups the resulting values of Put operation.
@@ -30,6 +38,17 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 	p.obj = obj
 }
 
+// WithIOClass is a Put option to set the I/O class of the operation.
+func (p *PutPrm) WithIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// WithContext is a Put option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *PutPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Put saves the object to local storage.
 //
 // Returns any error encountered that
@@ -39,6 +58,10 @@ func (p *PutPrm) WithObject(obj *objectSDK.Object) {
 //
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the object has been marked as removed.
 func (e *StorageEngine) Put(prm PutPrm) (res PutRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Put", attribute.Stringer("address", object.AddressOf(prm.obj)))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.put(prm)
 		return err
@@ -61,21 +84,29 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 		return PutRes{}, err
 	}
 
+	// objects are looked up in the plain HRW order of the shards, the first
+	// shard of this order is the object's best place
+	var best *hashedShard
+	if sorted := e.sortShards(addr); len(sorted) > 0 {
+		best = &sorted[0]
+	}
+
 	finished := false
 
-	e.iterateOverSortedShards(addr, func(ind int, sh hashedShard) (stop bool) {
+	for _, sh := range e.sortShardsForPlacement(addr) {
 		e.mtx.RLock()
 		pool, ok := e.shardPools[sh.ID().String()]
 		e.mtx.RUnlock()
 		if !ok {
 			// Shard was concurrently removed, skip.
-			return false
+			continue
 		}
 
-		putDone, exists := e.putToShard(sh, ind, pool, addr, prm.obj)
-		finished = putDone || exists
-		return finished
-	})
+		putDone, exists := e.putToShard(prm.ctx, sh, best, pool, addr, prm.obj, prm.ioClass)
+		if finished = putDone || exists; finished {
+			break
+		}
+	}
 
 	if !finished {
 		err = errPutShard
@@ -84,10 +115,11 @@ func (e *StorageEngine) put(prm PutPrm) (PutRes, error) {
 	return PutRes{}, err
 }
 
-// putToShard puts object to sh.
+// putToShard puts object to sh. If the object already exists in sh and the
+// best shard is set, the object is marked for relocation to it when needed.
 // First return value is true iff put has been successfully done.
 // Second return value is true iff object already exists.
-func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object) (bool, bool) {
+func (e *StorageEngine) putToShard(ctx context.Context, sh hashedShard, best *hashedShard, pool util.WorkerPool, addr oid.Address, obj *objectSDK.Object, ioClass limiter.Class) (bool, bool) {
 	var putSuccess, alreadyExists bool
 
 	exitCh := make(chan struct{})
@@ -111,7 +143,7 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 
 		alreadyExists = exists.Exists()
 		if alreadyExists {
-			if ind != 0 {
+			if best != nil && e.needsRelocation(sh, *best, addr) {
 				var toMoveItPrm shard.ToMoveItPrm
 				toMoveItPrm.SetAddress(addr)
 
@@ -127,8 +159,14 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 			return
 		}
 
+		if e.isAboveHighWatermark(sh) {
+			return
+		}
+
 		var putPrm shard.PutPrm
 		putPrm.SetObject(obj)
+		putPrm.SetIOClass(ioClass)
+		putPrm.SetContext(ctx)
 
 		_, err = sh.Put(putPrm)
 		if err != nil {
@@ -154,6 +192,49 @@ func (e *StorageEngine) putToShard(sh hashedShard, ind int, pool util.WorkerPool
 	return putSuccess, alreadyExists
 }
 
+// needsRelocation checks whether the object stored in sh should be moved to
+// the best shard. It is so only if the best shard is another one, it is
+// healthy enough to accept the object and doesn't store the object.
+func (e *StorageEngine) needsRelocation(sh, best hashedShard, addr oid.Address) bool {
+	if sh.ID().String() == best.ID().String() {
+		return false
+	}
+
+	if best.GetMode() != mode.ReadWrite || best.errorCount.Load() != 0 {
+		return false
+	}
+
+	if e.highWatermark > 0 && best.WeightValues().FillRatio() >= e.highWatermark {
+		return false
+	}
+
+	var existPrm shard.ExistsPrm
+	existPrm.SetAddress(addr)
+
+	res, err := best.Exists(existPrm)
+
+	return err == nil && !res.Exists()
+}
+
+// isAboveHighWatermark checks whether the shard's disk space is filled
+// above the configured limit.
+func (e *StorageEngine) isAboveHighWatermark(sh hashedShard) bool {
+	if e.highWatermark <= 0 {
+		return false
+	}
+
+	ratio := sh.WeightValues().FillRatio()
+	if ratio < e.highWatermark {
+		return false
+	}
+
+	e.log.Debug("shard is filled above high watermark, skip put",
+		zap.Stringer("shard_id", sh.ID()),
+		zap.Float64("fill_ratio", ratio))
+
+	return true
+}
+
 // Put writes provided object to local storage.
 func Put(storage *StorageEngine, obj *objectSDK.Object) error {
 	var putPrm PutPrm
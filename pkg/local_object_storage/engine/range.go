@@ -1,14 +1,19 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -17,6 +22,10 @@ type RngPrm struct {
 	off, ln uint64
 
 	addr oid.Address
+
+	ioClass limiter.Class
+
+	ctx context.Context
 }
 
 // RngRes groups the resulting values of GetRange operation.
@@ -39,6 +48,17 @@ func (p *RngPrm) WithPayloadRange(rng *objectSDK.Range) {
 	p.off, p.ln = rng.GetOffset(), rng.GetLength()
 }
 
+// WithIOClass is a GetRange option to set the I/O class of the operation.
+func (p *RngPrm) WithIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// WithContext is a GetRange option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *RngPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object part.
 //
 // Instance payload contains the requested range of the original object.
@@ -57,6 +77,10 @@ func (r RngRes) Object() *objectSDK.Object {
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) GetRange(prm RngPrm) (res RngRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.GetRange", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e.getRange(prm)
 		return err
@@ -88,6 +112,8 @@ func (e *StorageEngine) getRange(prm RngPrm) (RngRes, error) {
 	var shPrm shard.RngPrm
 	shPrm.SetAddress(prm.addr)
 	shPrm.SetRange(prm.off, prm.ln)
+	shPrm.SetIOClass(prm.ioClass)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
 		noMeta := sh.GetMode().NoMetabase()
@@ -1,21 +1,33 @@
 package engine
 
 import (
+	"bytes"
+	"context"
+	"sort"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	ctx     context.Context
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *oid.ID
 }
 
 // WithContainerID is a Select option to set the container id to search in.
@@ -28,17 +40,46 @@ func (p *SelectPrm) WithFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// WithCursor is a Select option to resume the selection after the object
+// with the specified ID. If the cursor or the limit is set, objects are
+// selected in ascending order of their IDs.
+func (p *SelectPrm) WithCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// WithLimit is a Select option to set the maximum number of the selected
+// objects. Zero limit means no limit.
+func (p *SelectPrm) WithLimit(limit uint32) {
+	p.limit = limit
+}
+
+// WithContext is a Select option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *SelectPrm) WithContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns the cursor to continue the selection with. Returns nil
+// if the limit was not reached, so there are no more objects to select.
+func (r SelectRes) Cursor() *oid.ID {
+	return r.cursor
+}
+
 // Select selects the objects from local storage that match select parameters.
 //
 // Returns any error encountered that did not allow to completely select the objects.
 //
 // Returns an error if executions are blocked (see BlockExecution).
 func (e *StorageEngine) Select(prm SelectPrm) (res SelectRes, err error) {
+	var span trace.Span
+	prm.ctx, span = tracing.StartSpan(prm.ctx, "engine.Select", attribute.Stringer("container", prm.cnr))
+	defer func() { tracing.Finish(span, err) }()
+
 	err = e.execIfNotBlocked(func() error {
 		res, err = e._select(prm)
 		return err
@@ -60,6 +101,9 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 	var shPrm shard.SelectPrm
 	shPrm.SetContainerID(prm.cnr)
 	shPrm.SetFilters(prm.filters)
+	shPrm.SetCursor(prm.cursor)
+	shPrm.SetLimit(prm.limit)
+	shPrm.SetContext(prm.ctx)
 
 	e.iterateOverUnsortedShards(func(sh hashedShard) (stop bool) {
 		res, err := sh.Select(shPrm)
@@ -78,9 +122,34 @@ func (e *StorageEngine) _select(prm SelectPrm) (SelectRes, error) {
 		return false
 	})
 
-	return SelectRes{
-		addrList: addrList,
-	}, outError
+	if prm.cursor == nil && prm.limit == 0 {
+		return SelectRes{
+			addrList: addrList,
+		}, outError
+	}
+
+	// each shard returns its own page, so the pages
+	// are merged and cut to the limit
+	sort.Slice(addrList, func(i, j int) bool {
+		return lessObjectID(addrList[i].Object(), addrList[j].Object())
+	})
+
+	var res SelectRes
+
+	if prm.limit > 0 && len(addrList) >= int(prm.limit) {
+		addrList = addrList[:prm.limit]
+
+		id := addrList[len(addrList)-1].Object()
+		res.cursor = &id
+	}
+
+	res.addrList = addrList
+
+	return res, outError
+}
+
+func lessObjectID(a, b oid.ID) bool {
+	return bytes.Compare(a[:], b[:]) < 0
 }
 
 // List returns `limit` available physically storage object addresses in engine.
@@ -2,10 +2,12 @@ package engine
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/nspcc-dev/hrw"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -16,6 +18,14 @@ import (
 
 var errShardNotFound = logicerr.New("shard not found")
 
+var (
+	// ErrShardPathsInUse is returned when the shard being attached uses
+	// the paths of the already attached shard.
+	ErrShardPathsInUse = logicerr.New("shard paths are already in use")
+	// ErrDetachAllShards is returned when all the shards of the engine are being detached.
+	ErrDetachAllShards = logicerr.New("could not detach all shards")
+)
+
 type hashedShard shardWrapper
 
 type metricsWithID struct {
@@ -49,6 +59,42 @@ func (m *metricsWithID) SetReadonly(readonly bool) {
 	m.mw.SetReadonly(m.id, readonly)
 }
 
+func (m *metricsWithID) AddCompressedSize(originalSize, compressedSize int) {
+	m.mw.AddCompressedSize(m.id, originalSize, compressedSize)
+}
+
+func (m *metricsWithID) IncCompressionSkipped() {
+	m.mw.IncCompressionSkipped(m.id)
+}
+
+func (m *metricsWithID) AddCompaction(compacted int, reclaimed uint64) {
+	m.mw.AddCompaction(m.id, compacted, reclaimed)
+}
+
+func (m *metricsWithID) AddScrubbedObjects(checked, corrupted int) {
+	m.mw.AddScrubbedObjects(m.id, checked, corrupted)
+}
+
+func (m *metricsWithID) AddTierMigrations(promoted, demoted int) {
+	m.mw.AddTierMigrations(m.id, promoted, demoted)
+}
+
+func (m *metricsWithID) AddWriteCacheBatch(d time.Duration) {
+	m.mw.AddWriteCacheBatch(m.id, d)
+}
+
+func (m *metricsWithID) SetFlushBacklog(count uint64) {
+	m.mw.SetFlushBacklog(m.id, count)
+}
+
+func (m *metricsWithID) AddOperationDuration(op string, d time.Duration) {
+	m.mw.AddShardOperationDuration(m.id, op, d)
+}
+
+func (m *metricsWithID) AddStorageOperationDuration(storageType, op string, d time.Duration) {
+	m.mw.AddStorageOperationDuration(m.id, storageType, op, d)
+}
+
 // AddShard adds a new shard to the storage engine.
 //
 // Returns any error encountered that did not allow adding a shard.
@@ -98,6 +144,12 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 		shard.WithReportErrorFunc(e.reportShardErrorBackground),
 	)...)
 
+	// Check before the metabase is opened, because
+	// it cannot be opened twice.
+	if err := e.checkShardPaths(sh.DumpInfo()); err != nil {
+		return nil, err
+	}
+
 	if err := sh.UpdateID(); err != nil {
 		return nil, fmt.Errorf("could not update shard ID: %w", err)
 	}
@@ -105,6 +157,115 @@ func (e *StorageEngine) createShard(opts []shard.Option) (*shard.Shard, error) {
 	return sh, err
 }
 
+// checkShardPaths checks that the new shard does not use the metabase
+// or blobstor of the attached shards.
+func (e *StorageEngine) checkShardPaths(info shard.Info) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	configID := calculateShardID(info)
+	for _, sh := range e.shards {
+		shInfo := sh.DumpInfo()
+		if calculateShardID(shInfo) == configID || shInfo.MetaBaseInfo.Path == info.MetaBaseInfo.Path {
+			return fmt.Errorf("%w by %s shard", ErrShardPathsInUse, sh.ID())
+		}
+	}
+	return nil
+}
+
+// AttachShard creates a new shard, opens and initializes it and adds it
+// to the running storage engine. Unlike AddShard, it must be called only
+// after the engine has been initialized.
+//
+// Returns ErrShardPathsInUse if the shard uses the paths of another shard.
+func (e *StorageEngine) AttachShard(opts ...shard.Option) (*shard.ID, error) {
+	sh, err := e.attachShard(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.cfg.metrics != nil {
+		e.cfg.metrics.SetReadonly(sh.ID().String(), sh.GetMode() != mode.ReadWrite)
+	}
+
+	e.log.Info("shard has been attached", zap.Stringer("id", sh.ID()))
+
+	return sh.ID(), nil
+}
+
+// attachShard creates, opens and initializes a new shard and adds it to the engine.
+func (e *StorageEngine) attachShard(opts []shard.Option) (*shard.Shard, error) {
+	sh, err := e.createShard(opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not create a shard: %w", err)
+	}
+
+	idStr := sh.ID().String()
+
+	err = sh.Open()
+	if err == nil {
+		err = sh.Init()
+	}
+	if err != nil {
+		_ = sh.Close()
+		return nil, fmt.Errorf("could not init %s shard: %w", idStr, err)
+	}
+
+	err = e.addShard(sh)
+	if err != nil {
+		_ = sh.Close()
+		return nil, fmt.Errorf("could not add %s shard: %w", idStr, err)
+	}
+
+	return sh, nil
+}
+
+// DetachShards closes the shards and removes them from the running
+// storage engine. Data of the shards is left untouched, so it should be
+// evacuated in advance if needed.
+//
+// Returns ErrDetachAllShards if no shards would be left and
+// ErrEvacuationInProgress if any of the shards is being evacuated.
+func (e *StorageEngine) DetachShards(ids []*shard.ID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// evacuation of the detached shards must not be started concurrently
+	e.evacuation.mtx.Lock()
+	defer e.evacuation.mtx.Unlock()
+
+	if e.isEvacuating(ids) {
+		return ErrEvacuationInProgress
+	}
+
+	strIDs := make([]string, 0, len(ids))
+	uniq := make(map[string]struct{}, len(ids))
+
+	e.mtx.RLock()
+	for i := range ids {
+		id := ids[i].String()
+		if _, ok := e.shards[id]; !ok {
+			e.mtx.RUnlock()
+			return fmt.Errorf("%w: %s", errShardNotFound, id)
+		}
+
+		if _, ok := uniq[id]; !ok {
+			uniq[id] = struct{}{}
+			strIDs = append(strIDs, id)
+		}
+	}
+	all := len(strIDs) == len(e.shards)
+	e.mtx.RUnlock()
+
+	if all {
+		return ErrDetachAllShards
+	}
+
+	e.removeShards(strIDs...)
+	return nil
+}
+
 func (e *StorageEngine) addShard(sh *shard.Shard) error {
 	e.mtx.Lock()
 	defer e.mtx.Unlock()
@@ -184,22 +345,35 @@ func generateShardID() (*shard.ID, error) {
 	return shard.NewIDFromBytes(bin), nil
 }
 
-func (e *StorageEngine) shardWeight(sh *shard.Shard) float64 {
+// placementWeight returns the weight of the shard used for HRW sorting on
+// the placement of new objects. The free disk space is scaled by the unused
+// part of the disk, so the objects are distributed proportionally to the
+// available capacity and the shards approaching the high watermark get less
+// objects than the emptier ones of the same free space.
+func (e *StorageEngine) placementWeight(sh *shard.Shard) float64 {
 	weightValues := sh.WeightValues()
 
-	return float64(weightValues.FreeSpace)
+	return float64(weightValues.FreeSpace) * (1 - weightValues.FillRatio())
 }
 
-func (e *StorageEngine) sortShardsByWeight(objAddr interface{ EncodeToString() string }) []hashedShard {
-	e.mtx.RLock()
-	defer e.mtx.RUnlock()
+// sortShards returns the shards sorted by HRW of the address. The order
+// doesn't depend on the shard weights, so it is the same for all lookups.
+func (e *StorageEngine) sortShards(objAddr interface{ EncodeToString() string }) []hashedShard {
+	shards := e.unsortedShards()
 
-	shards := make([]hashedShard, 0, len(e.shards))
-	weights := make([]float64, 0, len(e.shards))
+	hrw.SortSliceByValue(shards, hrw.Hash([]byte(objAddr.EncodeToString())))
 
-	for _, sh := range e.shards {
-		shards = append(shards, hashedShard(sh))
-		weights = append(weights, e.shardWeight(sh.Shard))
+	return shards
+}
+
+// sortShardsForPlacement returns the shards sorted by HRW of the address
+// weighted by the available capacity of the shards, see placementWeight.
+func (e *StorageEngine) sortShardsForPlacement(objAddr interface{ EncodeToString() string }) []hashedShard {
+	shards := e.unsortedShards()
+
+	weights := make([]float64, 0, len(shards))
+	for i := range shards {
+		weights = append(weights, e.placementWeight(shards[i].Shard))
 	}
 
 	hrw.SortSliceByWeightValue(shards, weights, hrw.Hash([]byte(objAddr.EncodeToString())))
@@ -221,7 +395,7 @@ func (e *StorageEngine) unsortedShards() []hashedShard {
 }
 
 func (e *StorageEngine) iterateOverSortedShards(addr oid.Address, handler func(int, hashedShard) (stop bool)) {
-	for i, sh := range e.sortShardsByWeight(addr) {
+	for i, sh := range e.sortShards(addr) {
 		if handler(i, sh) {
 			break
 		}
@@ -255,6 +429,20 @@ func (e *StorageEngine) SetShardMode(id *shard.ID, m mode.Mode, resetErrorCounte
 	return errShardNotFound
 }
 
+// SetShardIOLimits sets I/O rate limits of the shard.
+func (e *StorageEngine) SetShardIOLimits(id *shard.ID, l limiter.Limits) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return errShardNotFound
+	}
+
+	sh.SetIOLimits(l)
+	return nil
+}
+
 // HandleNewEpoch notifies every shard about NewEpoch event.
 func (e *StorageEngine) HandleNewEpoch(epoch uint64) {
 	ev := shard.EventNewEpoch(epoch)
@@ -1,9 +1,18 @@
 package engine
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +53,122 @@ func TestRemoveShard(t *testing.T) {
 		require.True(t, ok != removed)
 	}
 }
+
+func TestAttachDetachShard(t *testing.T) {
+	e := testEngineFromShardOpts(t, 1, nil)
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	shardOpts := func(i int) []shard.Option {
+		return []shard.Option{
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages(
+					newStorages(filepath.Join(t.Name(), fmt.Sprintf("blobstor%d", i)),
+						1<<20)),
+			),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(t.Name(), fmt.Sprintf("metabase%d", i))),
+				meta.WithPermissions(0700),
+				meta.WithEpochState(epochState{}),
+			),
+		}
+	}
+
+	oldID := e.DumpInfo().Shards[0].ID
+
+	_, err := e.AttachShard(shardOpts(0)...)
+	require.ErrorIs(t, err, ErrShardPathsInUse)
+
+	id, err := e.AttachShard(shardOpts(1)...)
+	require.NoError(t, err)
+	require.Len(t, e.shards, 2)
+	require.Len(t, e.shardPools, 2)
+
+	sh := e.shards[id.String()]
+	require.Equal(t, mode.ReadWrite, sh.GetMode())
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	_, err = Get(e, object.AddressOf(obj))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, e.DetachShards([]*shard.ID{id, oldID}), ErrDetachAllShards)
+	require.ErrorIs(t, e.DetachShards([]*shard.ID{shard.NewIDFromBytes([]byte{1, 2, 3})}), errShardNotFound)
+
+	require.NoError(t, e.DetachShards([]*shard.ID{id}))
+	require.Len(t, e.shards, 1)
+	require.Len(t, e.shardPools, 1)
+
+	_, err = Get(e, object.AddressOf(obj))
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	// data is kept after the detachment
+	id2, err := e.AttachShard(shardOpts(1)...)
+	require.NoError(t, err)
+	require.Equal(t, id, id2)
+
+	_, err = Get(e, object.AddressOf(obj))
+	require.NoError(t, err)
+}
+
+func TestShardHighWatermark(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 2)
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	for _, info := range e.DumpInfo().Shards {
+		require.NotZero(t, info.WeightValues.Capacity)
+	}
+
+	// any used disk space is above the watermark
+	e.highWatermark = 1e-9
+	require.ErrorIs(t, Put(e, generateObjectWithCID(t, cidtest.ID())), errPutShard)
+
+	e.highWatermark = 0
+	require.NoError(t, Put(e, generateObjectWithCID(t, cidtest.ID())))
+}
+
+func TestNeedsRelocation(t *testing.T) {
+	e := testNewEngineWithShardNum(t, 2)
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	addr := object.AddressOf(obj)
+
+	shards := e.sortShards(addr)
+	best, other := shards[0], shards[1]
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+	_, err := other.Put(putPrm)
+	require.NoError(t, err)
+
+	require.True(t, e.needsRelocation(other, best, addr))
+	require.False(t, e.needsRelocation(best, best, addr))
+
+	// the best shard can't accept the object
+	require.NoError(t, best.SetMode(mode.ReadOnly))
+	require.False(t, e.needsRelocation(other, best, addr))
+	require.NoError(t, best.SetMode(mode.ReadWrite))
+
+	e.highWatermark = 1e-9
+	require.False(t, e.needsRelocation(other, best, addr))
+	e.highWatermark = 0
+
+	// the object is already in the best shard
+	_, err = best.Put(putPrm)
+	require.NoError(t, err)
+	require.False(t, e.needsRelocation(other, best, addr))
+}
@@ -0,0 +1,226 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// accessBucketName stores the last epochs the objects stored in
+// blobovniczas have been read at.
+var accessBucketName = []byte{accessPrefix}
+
+// MarkAccessedPrm groups the parameters of MarkAccessed operation.
+type MarkAccessedPrm struct {
+	addrs []oid.Address
+	epoch uint64
+}
+
+// MarkAccessedRes groups the resulting values of MarkAccessed operation.
+type MarkAccessedRes struct{}
+
+// SetAddresses sets the addresses of the accessed objects.
+func (p *MarkAccessedPrm) SetAddresses(addrs ...oid.Address) {
+	p.addrs = addrs
+}
+
+// SetEpoch sets the epoch the objects have been accessed at.
+func (p *MarkAccessedPrm) SetEpoch(epoch uint64) {
+	p.epoch = epoch
+}
+
+// MarkAccessed saves the last access epoch of the objects.
+//
+// The epoch is saved only for the objects stored in blobovniczas,
+// other objects are ignored.
+func (db *DB) MarkAccessed(prm MarkAccessedPrm) (res MarkAccessedRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	val := make([]byte, 8)
+	binary.LittleEndian.PutUint64(val, prm.epoch)
+
+	err = db.boltDB.Batch(func(tx *bbolt.Tx) error {
+		access := tx.Bucket(accessBucketName)
+		key := make([]byte, addressKeySize)
+
+		for i := range prm.addrs {
+			small := tx.Bucket(smallBucketName(prm.addrs[i].Container(), key))
+			if small == nil || len(small.Get(objectKey(prm.addrs[i].Object(), key))) == 0 {
+				continue
+			}
+
+			err := access.Put(addressKey(prm.addrs[i], key), val)
+			if err != nil {
+				return fmt.Errorf("could not put access epoch: %w", err)
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// ListColdPrm groups the parameters of ListColdObjects operation.
+type ListColdPrm struct {
+	epoch  uint64
+	count  int
+	cursor *Cursor
+}
+
+// ListColdRes groups the resulting values of ListColdObjects operation.
+type ListColdRes struct {
+	cold      []oid.Address
+	untracked []oid.Address
+	cursor    *Cursor
+}
+
+// SetAccessedBefore sets the epoch the objects must not have been accessed
+// since to be listed.
+func (p *ListColdPrm) SetAccessedBefore(epoch uint64) {
+	p.epoch = epoch
+}
+
+// SetCount sets the maximum number of the listed objects of each kind.
+func (p *ListColdPrm) SetCount(count int) {
+	p.count = count
+}
+
+// SetCursor sets the position to continue the listing from. Nil cursor
+// starts the listing from the beginning.
+func (p *ListColdPrm) SetCursor(cursor *Cursor) {
+	p.cursor = cursor
+}
+
+// AddressList returns the addresses of the objects which have not been
+// accessed since the requested epoch.
+func (r ListColdRes) AddressList() []oid.Address {
+	return r.cold
+}
+
+// Untracked returns the addresses of the objects without the last
+// access epoch.
+func (r ListColdRes) Untracked() []oid.Address {
+	return r.untracked
+}
+
+// Cursor returns the position to continue the listing from. Nil cursor is
+// returned if all the objects have been listed.
+func (r ListColdRes) Cursor() *Cursor {
+	return r.cursor
+}
+
+// ListColdObjects lists the objects stored in blobovniczas which have not
+// been accessed since the requested epoch. Objects without the saved last
+// access epoch are listed separately, MarkAccessed should be called for
+// them to start tracking.
+//
+// The listing stops when any of the lists is full, it can be continued
+// with the returned cursor.
+func (db *DB) ListColdObjects(prm ListColdPrm) (res ListColdRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	if prm.count <= 0 {
+		return res, nil
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		var (
+			access = tx.Bucket(accessBucketName)
+			key    = make([]byte, addressKeySize)
+			offset []byte
+			name   []byte
+			c      = tx.Cursor()
+		)
+
+		if prm.cursor != nil {
+			name, _ = c.Seek(prm.cursor.bucketName)
+			if bytes.Equal(name, prm.cursor.bucketName) {
+				offset = prm.cursor.inBucketOffset
+			}
+		} else {
+			name, _ = c.First()
+		}
+
+		for ; name != nil; name, _ = c.Next() {
+			if len(name) != bucketKeySize || name[0] != smallPrefix {
+				continue
+			}
+
+			b := tx.Bucket(name)
+			if b == nil {
+				continue
+			}
+
+			bc := b.Cursor()
+
+			var k, v []byte
+			if offset != nil {
+				k, v = bc.Seek(offset)
+				if bytes.Equal(k, offset) {
+					k, v = bc.Next()
+				}
+				offset = nil
+			} else {
+				k, v = bc.First()
+			}
+
+			for ; k != nil; k, v = bc.Next() {
+				if len(v) == 0 {
+					// stored in FSTree
+					continue
+				}
+
+				var addr oid.Address
+				if err := decodeAddressFromKey(&addr, append(append(key[:0], name[1:]...), k...)); err != nil {
+					continue
+				}
+
+				var val []byte
+				if access != nil {
+					val = access.Get(addressKey(addr, key))
+				}
+
+				switch {
+				case len(val) != 8:
+					res.untracked = append(res.untracked, addr)
+				case binary.LittleEndian.Uint64(val) < prm.epoch:
+					res.cold = append(res.cold, addr)
+				default:
+					continue
+				}
+
+				if len(res.cold) >= prm.count || len(res.untracked) >= prm.count {
+					res.cursor = &Cursor{
+						bucketName:     slice.Copy(name),
+						inBucketOffset: slice.Copy(k),
+					}
+					return errBreakBucketForEach
+				}
+			}
+		}
+
+		return nil
+	})
+	if err == errBreakBucketForEach {
+		err = nil
+	}
+
+	return
+}
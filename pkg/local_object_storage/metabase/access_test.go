@@ -0,0 +1,106 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ListColdObjects(t *testing.T) {
+	db := newDB(t)
+
+	objs := make([]*objectSDK.Object, 3)
+	small := make([]oid.Address, len(objs))
+	for i := range small {
+		objs[i] = generateObject(t)
+		small[i] = object.AddressOf(objs[i])
+
+		require.NoError(t, metaPut(db, objs[i], []byte("blz")))
+	}
+
+	big := generateObject(t)
+	require.NoError(t, metaPut(db, big, nil))
+
+	listCold := func(t *testing.T, epoch uint64) ([]oid.Address, []oid.Address) {
+		var prm meta.ListColdPrm
+		prm.SetAccessedBefore(epoch)
+		prm.SetCount(10)
+
+		res, err := db.ListColdObjects(prm)
+		require.NoError(t, err)
+
+		return res.AddressList(), res.Untracked()
+	}
+
+	cold, untracked := listCold(t, 10)
+	require.Empty(t, cold)
+	require.ElementsMatch(t, small, untracked)
+
+	require.NoError(t, metaMarkAccessed(db, 5, small[0], small[1], object.AddressOf(big)))
+	require.NoError(t, metaMarkAccessed(db, 10, small[2]))
+
+	cold, untracked = listCold(t, 10)
+	require.ElementsMatch(t, small[:2], cold)
+	require.Empty(t, untracked)
+
+	cold, _ = listCold(t, 5)
+	require.Empty(t, cold)
+
+	t.Run("count", func(t *testing.T) {
+		var prm meta.ListColdPrm
+		prm.SetAccessedBefore(10)
+		prm.SetCount(1)
+
+		res, err := db.ListColdObjects(prm)
+		require.NoError(t, err)
+		require.Len(t, res.AddressList(), 1)
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		var (
+			prm  meta.ListColdPrm
+			cold []oid.Address
+		)
+		prm.SetAccessedBefore(10)
+		prm.SetCount(1)
+
+		for {
+			res, err := db.ListColdObjects(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(res.AddressList()), 1)
+
+			cold = append(cold, res.AddressList()...)
+			if res.Cursor() == nil {
+				break
+			}
+
+			prm.SetCursor(res.Cursor())
+		}
+
+		require.ElementsMatch(t, small[:2], cold)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, metaDelete(db, small[0]))
+
+		// access epoch is removed with the object
+		require.NoError(t, metaPut(db, objs[0], []byte("blz")))
+
+		_, untracked := listCold(t, 10)
+		require.Equal(t, []oid.Address{small[0]}, untracked)
+	})
+}
+
+func metaMarkAccessed(db *meta.DB, epoch uint64, addrs ...oid.Address) error {
+	var prm meta.MarkAccessedPrm
+	prm.SetAddresses(addrs...)
+	prm.SetEpoch(epoch)
+
+	_, err := db.MarkAccessed(prm)
+
+	return err
+}
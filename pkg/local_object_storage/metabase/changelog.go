@@ -0,0 +1,364 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// changeLogBucketName stores rows with the metabase modifications
+// in the order they were made.
+var changeLogBucketName = []byte{changeLogPrefix}
+
+// changeLogTruncatedKey is the key in the shard info bucket storing the
+// cursor the change log has been truncated up to.
+var changeLogTruncatedKey = []byte("changelog_truncated")
+
+// defaultChangeLogLimit is the default number of the latest changes
+// kept in the change log.
+const defaultChangeLogLimit = 1 << 20
+
+// changeLogTruncateBatchSize is the number of the change log entries
+// removed in a single transaction.
+const changeLogTruncateBatchSize = 10000
+
+// ErrChangesTruncated is returned when the changes made after the cursor
+// have been partially removed from the change log.
+var ErrChangesTruncated = logicerr.New("change log has been truncated after the cursor")
+
+// ChangeType is a type of the metabase modification.
+type ChangeType byte
+
+const (
+	// ChangePut means that the object has been put to the metabase.
+	ChangePut ChangeType = iota
+	// ChangeInhume means that the object has been covered with a tombstone
+	// or marked with GC.
+	ChangeInhume
+)
+
+// Change represents descriptor of the single metabase modification.
+type Change struct {
+	cursor uint64
+	typ    ChangeType
+	addr   oid.Address
+	tomb   *oid.Address
+}
+
+// Cursor returns the identifier of the metabase state the change
+// has been made at. It corresponds to the cursor returned by Snapshot.
+func (c Change) Cursor() uint64 {
+	return c.cursor
+}
+
+// Type returns type of the change.
+func (c Change) Type() ChangeType {
+	return c.typ
+}
+
+// Address returns address of the changed object.
+func (c Change) Address() oid.Address {
+	return c.addr
+}
+
+// Tombstone returns address of the tombstone that covers
+// the object. Returns false if the object has been inhumed
+// with a GC mark or the change is not ChangeInhume.
+func (c Change) Tombstone() (oid.Address, bool) {
+	if c.tomb == nil {
+		return oid.Address{}, false
+	}
+	return *c.tomb, true
+}
+
+// ChangeHandler is a Change handling function.
+type ChangeHandler func(Change) error
+
+// ChangesIterationPrm groups parameters of the change log
+// iteration process.
+type ChangesIterationPrm struct {
+	h     ChangeHandler
+	since uint64
+}
+
+// SetHandler sets a handler that will be called on every Change.
+func (p *ChangesIterationPrm) SetHandler(h ChangeHandler) {
+	p.h = h
+}
+
+// SetSince sets the cursor to start an iteration after. Only the
+// changes made after the metabase state with the provided cursor
+// are handled.
+//
+// Zero cursor means start an iteration from the beginning.
+func (p *ChangesIterationPrm) SetSince(cursor uint64) {
+	p.since = cursor
+}
+
+// IterateChanges iterates over metabase modifications in the order
+// they were made.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+// Returns ErrChangesTruncated if the change log has been truncated
+// after the start cursor.
+func (db *DB) IterateChanges(p ChangesIterationPrm) error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	}
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		return iterateChanges(tx, p.since, p.h)
+	})
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+	return err
+}
+
+func iterateChanges(tx *bbolt.Tx, since uint64, h ChangeHandler) error {
+	b := tx.Bucket(changeLogBucketName)
+	if b == nil {
+		// read-only metabase which has not been initialized yet
+		return nil
+	}
+
+	if truncated := changeLogTruncated(tx); since < truncated {
+		return fmt.Errorf("%w: cursor=%d, truncated=%d", ErrChangesTruncated, since, truncated)
+	}
+
+	var start [8]byte
+	binary.BigEndian.PutUint64(start[:], since+1)
+
+	c := b.Cursor()
+	for k, v := c.Seek(start[:]); k != nil; k, v = c.Next() {
+		ch, err := decodeChange(k, v)
+		if err != nil {
+			return fmt.Errorf("could not parse change log entry: %w", err)
+		}
+
+		if err := h(ch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TruncateChangesPrm groups parameters of the TruncateChanges operation.
+type TruncateChangesPrm struct {
+	until uint64
+}
+
+// TruncateChangesRes groups the resulting values of the TruncateChanges operation.
+type TruncateChangesRes struct {
+	count int
+}
+
+// SetUntil sets the cursor the change log is truncated up to. Changes made
+// at the metabase states up to the cursor (inclusive) are removed.
+func (p *TruncateChangesPrm) SetUntil(cursor uint64) {
+	p.until = cursor
+}
+
+// Count returns the number of the removed changes.
+func (r TruncateChangesRes) Count() int {
+	return r.count
+}
+
+// TruncateChanges removes the changes made up to the cursor from the change
+// log. The changes are removed in batches, so the concurrent operations are
+// not blocked for the whole truncation.
+//
+// After the truncation the change log can't be iterated from the cursors
+// preceding the truncated one.
+func (db *DB) TruncateChanges(prm TruncateChangesPrm) (res TruncateChangesRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	res.count, err = db.truncateChanges(prm.until)
+
+	return res, err
+}
+
+// TrimChangesRes groups the resulting values of the TrimChanges operation.
+type TrimChangesRes struct {
+	count int
+}
+
+// Count returns the number of the removed changes.
+func (r TrimChangesRes) Count() int {
+	return r.count
+}
+
+// TrimChanges removes the oldest changes from the change log, so that
+// it keeps at most the configured number of the latest changes (see
+// WithChangeLogLimit). Changes made in the same transaction are removed
+// together, so the change log may keep a bit fewer changes.
+func (db *DB) TrimChanges() (res TrimChangesRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	var until uint64
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(changeLogBucketName)
+		if b == nil {
+			return nil
+		}
+
+		c := b.Cursor()
+		k, _ := c.Last()
+		for i := 0; k != nil && i < db.changeLogLimit; i++ {
+			k, _ = c.Prev()
+		}
+
+		if k != nil {
+			until = binary.BigEndian.Uint64(k)
+		}
+
+		return nil
+	})
+	if err != nil || until == 0 {
+		return res, err
+	}
+
+	res.count, err = db.truncateChanges(until)
+
+	return res, err
+}
+
+// truncateChanges removes the changes made up to the cursor from the change
+// log in batches and returns the number of the removed changes.
+func (db *DB) truncateChanges(until uint64) (int, error) {
+	var (
+		end   [8]byte
+		done  bool
+		count int
+	)
+	binary.BigEndian.PutUint64(end[:], until+1)
+
+	for !done {
+		err := db.boltDB.Update(func(tx *bbolt.Tx) error {
+			info, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+			if err != nil {
+				return err
+			}
+
+			// mark the log truncated before the removal, so the
+			// partially truncated log is never iterated over
+			if changeLogTruncated(tx) < until {
+				var v [8]byte
+				binary.LittleEndian.PutUint64(v[:], until)
+
+				if err := info.Put(changeLogTruncatedKey, v[:]); err != nil {
+					return err
+				}
+			}
+
+			b := tx.Bucket(changeLogBucketName)
+			if b == nil {
+				done = true
+				return nil
+			}
+
+			keys := make([][]byte, 0, changeLogTruncateBatchSize)
+
+			c := b.Cursor()
+			for k, _ := c.First(); k != nil && bytes.Compare(k, end[:]) < 0; k, _ = c.Next() {
+				if len(keys) == changeLogTruncateBatchSize {
+					break
+				}
+				keys = append(keys, k)
+			}
+
+			done = len(keys) < changeLogTruncateBatchSize
+
+			for i := range keys {
+				if err := b.Delete(keys[i]); err != nil {
+					return err
+				}
+			}
+
+			count += len(keys)
+			return nil
+		})
+		if err != nil {
+			return count, fmt.Errorf("could not truncate change log: %w", err)
+		}
+	}
+
+	return count, nil
+}
+
+// changeLogTruncated returns the cursor the change log has been truncated up to.
+func changeLogTruncated(tx *bbolt.Tx) uint64 {
+	b := tx.Bucket(shardInfoBucket)
+	if b == nil {
+		return 0
+	}
+
+	data := b.Get(changeLogTruncatedKey)
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(data)
+}
+
+// logChange records the modification of the object in the change log.
+// Changes are ordered by the ID of the transaction they were made in.
+func logChange(tx *bbolt.Tx, addr oid.Address, typ ChangeType, tomb []byte) error {
+	key := make([]byte, 8+addressKeySize)
+	binary.BigEndian.PutUint64(key, uint64(tx.ID()))
+	addressKey(addr, key[8:])
+
+	value := make([]byte, 1+len(tomb))
+	value[0] = byte(typ)
+	copy(value[1:], tomb)
+
+	return tx.Bucket(changeLogBucketName).Put(key, value)
+}
+
+func decodeChange(k, v []byte) (Change, error) {
+	var ch Change
+
+	if len(k) != 8+addressKeySize || len(v) == 0 {
+		return ch, errors.New("invalid entry length")
+	}
+
+	ch.cursor = binary.BigEndian.Uint64(k)
+	ch.typ = ChangeType(v[0])
+
+	if err := decodeAddressFromKey(&ch.addr, k[8:]); err != nil {
+		return ch, err
+	}
+
+	if len(v) > 1 {
+		var tomb oid.Address
+		if err := decodeAddressFromKey(&tomb, v[1:]); err != nil {
+			return ch, fmt.Errorf("invalid tombstone address: %w", err)
+		}
+		ch.tomb = &tomb
+	}
+
+	return ch, nil
+}
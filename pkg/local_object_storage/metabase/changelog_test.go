@@ -0,0 +1,162 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_IterateChanges(t *testing.T) {
+	db := newDB(t)
+
+	obj1 := generateObject(t)
+	obj2 := generateObject(t)
+	tomb := oidtest.Address()
+
+	require.NoError(t, putBig(db, obj1))
+
+	snRes, err := db.Snapshot(meta.SnapshotPrm{})
+	require.NoError(t, err)
+	cursor := snRes.Cursor()
+
+	require.NoError(t, putBig(db, obj2))
+
+	// storage ID update is not a change
+	var putPrm meta.PutPrm
+	putPrm.SetObject(obj2)
+	putPrm.SetStorageID([]byte{1, 2, 3})
+	_, err = db.Put(putPrm)
+	require.NoError(t, err)
+
+	require.NoError(t, metaInhume(db, object.AddressOf(obj1), tomb))
+
+	var gcPrm meta.InhumePrm
+	gcPrm.SetAddresses(object.AddressOf(obj2))
+	gcPrm.SetGCMark()
+	_, err = db.Inhume(gcPrm)
+	require.NoError(t, err)
+
+	all := iterateChanges(t, db, 0)
+	require.Len(t, all, 4)
+	require.Equal(t, meta.ChangePut, all[0].Type())
+	require.Equal(t, object.AddressOf(obj1), all[0].Address())
+	require.Equal(t, cursor, all[0].Cursor())
+
+	changes := iterateChanges(t, db, cursor)
+	require.Equal(t, all[1:], changes)
+
+	require.Equal(t, meta.ChangePut, changes[0].Type())
+	require.Equal(t, object.AddressOf(obj2), changes[0].Address())
+	require.Greater(t, changes[0].Cursor(), cursor)
+
+	require.Equal(t, meta.ChangeInhume, changes[1].Type())
+	require.Equal(t, object.AddressOf(obj1), changes[1].Address())
+	actualTomb, ok := changes[1].Tombstone()
+	require.True(t, ok)
+	require.Equal(t, tomb, actualTomb)
+
+	require.Equal(t, meta.ChangeInhume, changes[2].Type())
+	require.Equal(t, object.AddressOf(obj2), changes[2].Address())
+	_, ok = changes[2].Tombstone()
+	require.False(t, ok)
+
+	snRes, err = db.Snapshot(meta.SnapshotPrm{})
+	require.NoError(t, err)
+	require.Empty(t, iterateChanges(t, db, snRes.Cursor()))
+
+	t.Run("interrupt", func(t *testing.T) {
+		var n int
+		var prm meta.ChangesIterationPrm
+		prm.SetHandler(func(meta.Change) error {
+			n++
+			return meta.ErrInterruptIterator
+		})
+		require.NoError(t, db.IterateChanges(prm))
+		require.Equal(t, 1, n)
+	})
+}
+
+func iterateChanges(t *testing.T, db *meta.DB, since uint64) []meta.Change {
+	var changes []meta.Change
+
+	var prm meta.ChangesIterationPrm
+	prm.SetSince(since)
+	prm.SetHandler(func(ch meta.Change) error {
+		changes = append(changes, ch)
+		return nil
+	})
+	require.NoError(t, db.IterateChanges(prm))
+
+	return changes
+}
+
+func TestDB_TruncateChanges(t *testing.T) {
+	db := newDB(t)
+
+	obj1 := generateObject(t)
+	obj2 := generateObject(t)
+
+	require.NoError(t, putBig(db, obj1))
+
+	snRes, err := db.Snapshot(meta.SnapshotPrm{})
+	require.NoError(t, err)
+	cursor := snRes.Cursor()
+
+	require.NoError(t, putBig(db, obj2))
+	all := iterateChanges(t, db, 0)
+
+	var prm meta.TruncateChangesPrm
+	prm.SetUntil(cursor)
+
+	res, err := db.TruncateChanges(prm)
+	require.NoError(t, err)
+	require.Equal(t, 1, res.Count())
+
+	require.Equal(t, all[1:], iterateChanges(t, db, cursor))
+
+	var iterPrm meta.ChangesIterationPrm
+	iterPrm.SetSince(cursor - 1)
+	iterPrm.SetHandler(func(meta.Change) error { return nil })
+	require.ErrorIs(t, db.IterateChanges(iterPrm), meta.ErrChangesTruncated)
+
+	// truncation to the older cursor doesn't restore the log
+	prm.SetUntil(cursor - 1)
+	res, err = db.TruncateChanges(prm)
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Count())
+	require.ErrorIs(t, db.IterateChanges(iterPrm), meta.ErrChangesTruncated)
+}
+
+func TestDB_TrimChanges(t *testing.T) {
+	const limit = 2
+
+	db := newDB(t, meta.WithChangeLogLimit(limit))
+
+	res, err := db.TrimChanges()
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Count())
+
+	for i := 0; i < limit+3; i++ {
+		require.NoError(t, putBig(db, generateObject(t)))
+	}
+
+	all := iterateChanges(t, db, 0)
+	require.Len(t, all, limit+3)
+
+	res, err = db.TrimChanges()
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Count())
+	require.Equal(t, all[3:], iterateChanges(t, db, all[2].Cursor()))
+
+	var iterPrm meta.ChangesIterationPrm
+	iterPrm.SetHandler(func(meta.Change) error { return nil })
+	require.ErrorIs(t, db.IterateChanges(iterPrm), meta.ErrChangesTruncated)
+
+	// the log within the limit is kept
+	res, err = db.TrimChanges()
+	require.NoError(t, err)
+	require.Equal(t, 0, res.Count())
+}
@@ -73,7 +73,9 @@ func (db *DB) openBolt() error {
 
 // Init initializes metabase. It creates static (CID-independent) buckets in underlying BoltDB instance.
 //
-// Returns ErrOutdatedVersion if a database at the provided path is outdated.
+// Metabase of the previous versions is upgraded in place, see Migrate.
+// Returns ErrOutdatedVersion if a database at the provided path is outdated
+// and can't be migrated.
 //
 // Does nothing if metabase has already been initialized and filled. To roll back the database to its initial state,
 // use Reset.
@@ -83,6 +85,7 @@ func (db *DB) Init() error {
 
 // Reset resets metabase. Works similar to Init but cleans up all static buckets and
 // removes all dynamic (CID-dependent) ones in non-blank BoltDB instances.
+// References to the deduplicated payloads are kept.
 func (db *DB) Reset() error {
 	db.modeMtx.RLock()
 	defer db.modeMtx.RUnlock()
@@ -106,6 +109,28 @@ func (db *DB) init(reset bool) error {
 		string(garbageBucketName):         {},
 		string(shardInfoBucket):           {},
 		string(bucketNameLocked):          {},
+		string(changeLogBucketName):       {},
+		string(corruptedBucketName):       {},
+		string(payloadBucketName):         {},
+		string(payloadRefBucketName):      {},
+		string(objectPayloadBucketName):   {},
+		string(accessBucketName):          {},
+		string(ownerUsageBucketName):      {},
+		string(indexSettingsBucketName):   {},
+	}
+
+	// payload references can't be restored from the blobstor,
+	// so they are kept on reset
+	mPersistentBuckets := map[string]struct{}{
+		string(payloadBucketName):       {},
+		string(payloadRefBucketName):    {},
+		string(objectPayloadBucketName): {},
+	}
+
+	if !reset {
+		if err := db.migrate(); err != nil {
+			return err
+		}
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
@@ -119,7 +144,7 @@ func (db *DB) init(reset bool) error {
 		}
 		for k := range mStaticBuckets {
 			name := []byte(k)
-			if reset {
+			if _, ok := mPersistentBuckets[k]; reset && !ok {
 				err := tx.DeleteBucket(name)
 				if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
 					return fmt.Errorf("could not delete static bucket %s: %w", k, err)
@@ -167,7 +192,12 @@ func (db *DB) SyncCounters() error {
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
-		return syncCounter(tx, true)
+		err := syncCounter(tx, true)
+		if err != nil {
+			return err
+		}
+
+		return syncOwnerUsage(tx)
 	})
 }
 
@@ -0,0 +1,106 @@
+package meta
+
+import (
+	"fmt"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// corruptedBucketName stores rows with the objects which physical copies
+// have been found corrupted. Such objects are considered missing until
+// a healthy copy is put again.
+var corruptedBucketName = []byte{corruptedPrefix}
+
+// MarkCorruptedPrm groups the parameters of MarkCorrupted operation.
+type MarkCorruptedPrm struct {
+	addr   oid.Address
+	reason string
+}
+
+// MarkCorruptedRes groups the resulting values of MarkCorrupted operation.
+type MarkCorruptedRes struct{}
+
+// SetAddress sets address of the corrupted object.
+func (p *MarkCorruptedPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// SetReason sets human-readable description of the detected corruption.
+func (p *MarkCorruptedPrm) SetReason(reason string) {
+	p.reason = reason
+}
+
+// MarkCorrupted marks the object as having a corrupted physical copy.
+//
+// Marked object is reported as missing (i.e. ObjectNotFound is returned
+// from Exists and Get), it is not returned from Select and ListWithCursor,
+// so the replication of the object to this node is triggered.
+// The mark is removed once the object is put again.
+//
+// Returns an error of type apistatus.ObjectNotFound if the object
+// is not stored in the metabase.
+func (db *DB) MarkCorrupted(prm MarkCorruptedPrm) (res MarkCorruptedRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		key := make([]byte, addressKeySize)
+		if _, err := db.get(tx, prm.addr, key, false, true, 0); err != nil {
+			return err
+		}
+
+		corrupted, err := tx.CreateBucketIfNotExists(corruptedBucketName)
+		if err != nil {
+			return fmt.Errorf("could not create corrupted bucket: %w", err)
+		}
+
+		return corrupted.Put(addressKey(prm.addr, key), []byte(prm.reason))
+	})
+
+	return
+}
+
+// isCorrupted checks whether the object with the address key
+// is marked as corrupted.
+func isCorrupted(tx *bbolt.Tx, addrKey []byte) bool {
+	corrupted := tx.Bucket(corruptedBucketName)
+	return corrupted != nil && corrupted.Get(addrKey) != nil
+}
+
+// healCorrupted removes the corruption mark of the object and saves the
+// storage ID of its new physical copy. Returns false if the object is
+// not marked.
+func healCorrupted(tx *bbolt.Tx, addr oid.Address, id []byte) (bool, error) {
+	corrupted := tx.Bucket(corruptedBucketName)
+	if corrupted == nil {
+		return false, nil
+	}
+
+	addrKey := addressKey(addr, make([]byte, addressKeySize))
+	if corrupted.Get(addrKey) == nil {
+		return false, nil
+	}
+
+	if err := corrupted.Delete(addrKey); err != nil {
+		return false, fmt.Errorf("could not remove corruption mark: %w", err)
+	}
+
+	if id != nil {
+		return true, updateStorageID(tx, addr, id)
+	}
+
+	key := make([]byte, bucketKeySize)
+	small := tx.Bucket(smallBucketName(addr.Container(), key))
+	if small == nil {
+		return true, nil
+	}
+
+	return true, small.Delete(objectKey(addr.Object(), key))
+}
@@ -0,0 +1,85 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_MarkCorrupted(t *testing.T) {
+	db := newDB(t)
+
+	obj := generateObject(t)
+	addr := object.AddressOf(obj)
+	cnr, _ := obj.ContainerID()
+
+	require.NoError(t, metaPut(db, obj, []byte("blz")))
+
+	t.Run("missing object", func(t *testing.T) {
+		err := metaMarkCorrupted(db, oidtest.Address())
+		require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	})
+
+	require.NoError(t, metaMarkCorrupted(db, addr))
+
+	exists, err := metaExists(db, addr)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+	require.False(t, exists)
+
+	_, err = metaGet(db, addr, false)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	testSelect(t, db, cnr, objectSDK.SearchFilters{})
+
+	_, _, err = metaListWithCursor(db, 10, nil)
+	require.ErrorIs(t, err, meta.ErrEndOfListing)
+
+	t.Run("heal", func(t *testing.T) {
+		require.NoError(t, metaPut(db, obj, nil))
+
+		exists, err := metaExists(db, addr)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		// new copy is stored in the other sub-storage
+		id, err := metaStorageID(db, addr)
+		require.NoError(t, err)
+		require.Nil(t, id)
+
+		testSelect(t, db, cnr, objectSDK.SearchFilters{}, addr)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		obj := generateObject(t)
+		addr := object.AddressOf(obj)
+
+		require.NoError(t, putBig(db, obj))
+		require.NoError(t, metaMarkCorrupted(db, addr))
+		require.NoError(t, metaDelete(db, addr))
+
+		// stale mark must not prevent the object from being put again
+		require.NoError(t, putBig(db, obj))
+
+		exists, err := metaExists(db, addr)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		_, err = metaGet(db, addr, false)
+		require.NoError(t, err)
+	})
+}
+
+func metaMarkCorrupted(db *meta.DB, addr oid.Address) error {
+	var prm meta.MarkCorruptedPrm
+	prm.SetAddress(addr)
+	prm.SetReason("checksum mismatch")
+
+	_, err := db.MarkCorrupted(prm)
+	return err
+}
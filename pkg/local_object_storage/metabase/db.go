@@ -13,6 +13,7 @@ import (
 
 	"github.com/mr-tron/base58"
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -60,6 +61,8 @@ type cfg struct {
 	log *logger.Logger
 
 	epochState EpochState
+
+	changeLogLimit int
 }
 
 func defaultCfg() *cfg {
@@ -69,6 +72,7 @@ func defaultCfg() *cfg {
 		},
 		boltBatchDelay: bbolt.DefaultMaxBatchDelay,
 		boltBatchSize:  bbolt.DefaultMaxBatchSize,
+		changeLogLimit: defaultChangeLogLimit,
 		log:            &logger.Logger{Logger: zap.L()},
 	}
 }
@@ -104,6 +108,10 @@ func New(opts ...Option) *DB {
 				matchSlow:   stringCommonPrefixMatcher,
 				matchBucket: stringCommonPrefixMatcherBucket,
 			},
+			objectcore.MatchNumGT: numericMatcher(func(c int) bool { return c > 0 }),
+			objectcore.MatchNumGE: numericMatcher(func(c int) bool { return c >= 0 }),
+			objectcore.MatchNumLT: numericMatcher(func(c int) bool { return c < 0 }),
+			objectcore.MatchNumLE: numericMatcher(func(c int) bool { return c <= 0 }),
 		},
 	}
 }
@@ -343,6 +351,16 @@ func WithMaxBatchDelay(d time.Duration) Option {
 	}
 }
 
+// WithChangeLogLimit returns option to specify the number of the latest
+// changes kept in the change log by TrimChanges.
+func WithChangeLogLimit(n int) Option {
+	return func(c *cfg) {
+		if n > 0 {
+			c.changeLogLimit = n
+		}
+	}
+}
+
 // WithEpochState return option to specify a source of current epoch height.
 func WithEpochState(s EpochState) Option {
 	return func(c *cfg) {
@@ -0,0 +1,356 @@
+package meta
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	// payloadBucketName stores storage IDs of the deduplicated payloads.
+	payloadBucketName = []byte{payloadPrefix}
+	// payloadRefBucketName stores references of the objects to the
+	// deduplicated payloads.
+	payloadRefBucketName = []byte{payloadRefPrefix}
+	// objectPayloadBucketName maps objects to their deduplicated payloads.
+	objectPayloadBucketName = []byte{objectPayloadPrefix}
+)
+
+var errInvalidPayloadHash = errors.New("invalid payload hash length")
+
+// AddPayloadRefPrm groups the parameters of AddPayloadRef operation.
+type AddPayloadRefPrm struct {
+	addr oid.Address
+	hash []byte
+}
+
+// AddPayloadRefRes groups the resulting values of AddPayloadRef operation.
+type AddPayloadRefRes struct {
+	stored    bool
+	storageID []byte
+}
+
+// SetAddress sets address of the object referencing the payload.
+func (p *AddPayloadRefPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// SetPayloadHash sets SHA256 hash of the payload.
+func (p *AddPayloadRefPrm) SetPayloadHash(hash []byte) {
+	p.hash = hash
+}
+
+// Stored returns true if the payload has already been stored.
+func (r AddPayloadRefRes) Stored() bool {
+	return r.stored
+}
+
+// StorageID returns storage ID of the stored payload.
+func (r AddPayloadRefRes) StorageID() []byte {
+	return r.storageID
+}
+
+// AddPayloadRef adds the reference of the object to the deduplicated payload.
+// Adding the same reference twice is a no-op.
+//
+// Result reports whether the payload has already been stored, the storage ID
+// of the newly stored payload must be saved with SetPayloadStorageID.
+func (db *DB) AddPayloadRef(prm AddPayloadRefPrm) (res AddPayloadRefRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	if len(prm.hash) != sha256.Size {
+		return res, errInvalidPayloadHash
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		addrKey := addressKey(prm.addr, make([]byte, addressKeySize))
+
+		objPayload := tx.Bucket(objectPayloadBucketName)
+
+		old := objPayload.Get(addrKey)
+		if old != nil && !bytes.Equal(old, prm.hash) {
+			return fmt.Errorf("object references another payload %x", old)
+		}
+
+		err := tx.Bucket(payloadRefBucketName).Put(payloadRefKey(prm.hash, addrKey), zeroValue)
+		if err != nil {
+			return fmt.Errorf("could not put payload reference: %w", err)
+		}
+
+		err = objPayload.Put(addrKey, prm.hash)
+		if err != nil {
+			return fmt.Errorf("could not put object payload: %w", err)
+		}
+
+		res.storageID, res.stored = payloadStorageID(tx, prm.hash)
+
+		return nil
+	})
+
+	return
+}
+
+// SetPayloadStorageIDPrm groups the parameters of SetPayloadStorageID operation.
+type SetPayloadStorageIDPrm struct {
+	hash      []byte
+	storageID []byte
+}
+
+// SetPayloadStorageIDRes groups the resulting values of SetPayloadStorageID operation.
+type SetPayloadStorageIDRes struct{}
+
+// SetPayloadHash sets SHA256 hash of the payload.
+func (p *SetPayloadStorageIDPrm) SetPayloadHash(hash []byte) {
+	p.hash = hash
+}
+
+// SetStorageID sets storage ID of the stored payload.
+func (p *SetPayloadStorageIDPrm) SetStorageID(id []byte) {
+	p.storageID = id
+}
+
+// SetPayloadStorageID marks the deduplicated payload as stored under
+// the storage ID.
+func (db *DB) SetPayloadStorageID(prm SetPayloadStorageIDPrm) (res SetPayloadStorageIDRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	if len(prm.hash) != sha256.Size {
+		return res, errInvalidPayloadHash
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		val := make([]byte, 1+len(prm.storageID))
+		val[0] = zeroValue[0]
+		copy(val[1:], prm.storageID)
+
+		return tx.Bucket(payloadBucketName).Put(prm.hash, val)
+	})
+
+	return
+}
+
+// RemovePayloadRefPrm groups the parameters of RemovePayloadRef operation.
+type RemovePayloadRefPrm struct {
+	addr oid.Address
+}
+
+// RemovePayloadRefRes groups the resulting values of RemovePayloadRef operation.
+type RemovePayloadRefRes struct {
+	hash      []byte
+	last      bool
+	storageID []byte
+}
+
+// SetAddress sets address of the object referencing the payload.
+func (p *RemovePayloadRefPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// PayloadHash returns SHA256 hash of the payload referenced by the object.
+// Returns nil if the object payload is not deduplicated.
+func (r RemovePayloadRefRes) PayloadHash() []byte {
+	return r.hash
+}
+
+// Last returns true if the removed reference was the last one, so
+// the payload must be deleted.
+func (r RemovePayloadRefRes) Last() bool {
+	return r.last
+}
+
+// StorageID returns storage ID of the payload to delete.
+func (r RemovePayloadRefRes) StorageID() []byte {
+	return r.storageID
+}
+
+// RemovePayloadRef removes the reference of the object to its deduplicated
+// payload. Removing the last reference forgets the payload.
+// Objects without deduplicated payload are ignored.
+func (db *DB) RemovePayloadRef(prm RemovePayloadRefPrm) (res RemovePayloadRefRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		addrKey := addressKey(prm.addr, make([]byte, addressKeySize))
+
+		objPayload := tx.Bucket(objectPayloadBucketName)
+
+		hash := objPayload.Get(addrKey)
+		if hash == nil {
+			return nil
+		}
+
+		res.hash = cloneBytes(hash)
+
+		err := objPayload.Delete(addrKey)
+		if err != nil {
+			return fmt.Errorf("could not remove object payload: %w", err)
+		}
+
+		refs := tx.Bucket(payloadRefBucketName)
+
+		err = refs.Delete(payloadRefKey(res.hash, addrKey))
+		if err != nil {
+			return fmt.Errorf("could not remove payload reference: %w", err)
+		}
+
+		k, _ := refs.Cursor().Seek(res.hash)
+		if bytes.HasPrefix(k, res.hash) {
+			return nil
+		}
+
+		res.last = true
+		res.storageID, _ = payloadStorageID(tx, res.hash)
+
+		return tx.Bucket(payloadBucketName).Delete(res.hash)
+	})
+
+	return
+}
+
+// PayloadHashPrm groups the parameters of PayloadHash operation.
+type PayloadHashPrm struct {
+	addr oid.Address
+}
+
+// PayloadHashRes groups the resulting values of PayloadHash operation.
+type PayloadHashRes struct {
+	hash []byte
+}
+
+// SetAddress sets address of the object.
+func (p *PayloadHashPrm) SetAddress(addr oid.Address) {
+	p.addr = addr
+}
+
+// PayloadHash returns SHA256 hash of the payload referenced by the object.
+// Returns nil if the object payload is not deduplicated.
+func (r PayloadHashRes) PayloadHash() []byte {
+	return r.hash
+}
+
+// PayloadHash returns hash of the deduplicated payload referenced by the object.
+func (db *DB) PayloadHash(prm PayloadHashPrm) (res PayloadHashRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		objPayload := tx.Bucket(objectPayloadBucketName)
+		if objPayload == nil {
+			return nil
+		}
+
+		res.hash = cloneBytes(objPayload.Get(addressKey(prm.addr, make([]byte, addressKeySize))))
+
+		return nil
+	})
+
+	return
+}
+
+// PayloadStorageIDPrm groups the parameters of PayloadStorageID operation.
+type PayloadStorageIDPrm struct {
+	hash []byte
+}
+
+// PayloadStorageIDRes groups the resulting values of PayloadStorageID operation.
+type PayloadStorageIDRes struct {
+	found     bool
+	storageID []byte
+}
+
+// SetPayloadHash sets SHA256 hash of the payload.
+func (p *PayloadStorageIDPrm) SetPayloadHash(hash []byte) {
+	p.hash = hash
+}
+
+// Found returns true if the payload is stored.
+func (r PayloadStorageIDRes) Found() bool {
+	return r.found
+}
+
+// StorageID returns storage ID of the payload.
+func (r PayloadStorageIDRes) StorageID() []byte {
+	return r.storageID
+}
+
+// PayloadStorageID returns storage ID of the deduplicated payload.
+func (db *DB) PayloadStorageID(prm PayloadStorageIDPrm) (res PayloadStorageIDRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.storageID, res.found = payloadStorageID(tx, prm.hash)
+		return nil
+	})
+
+	return
+}
+
+// payloadStorageID returns storage ID of the deduplicated payload
+// and true if the payload is stored.
+func payloadStorageID(tx *bbolt.Tx, hash []byte) ([]byte, bool) {
+	payloads := tx.Bucket(payloadBucketName)
+	if payloads == nil {
+		return nil, false
+	}
+
+	val := payloads.Get(hash)
+	if len(val) == 0 {
+		return nil, false
+	}
+
+	return cloneBytes(val[1:]), true
+}
+
+func payloadRefKey(hash, addrKey []byte) []byte {
+	key := make([]byte, len(hash)+len(addrKey))
+	copy(key, hash)
+	copy(key[len(hash):], addrKey)
+
+	return key
+}
+
+// cloneBytes returns a copy of b which remains valid after the transaction.
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	res := make([]byte, len(b))
+	copy(res, b)
+
+	return res
+}
@@ -0,0 +1,124 @@
+package meta_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_PayloadRefs(t *testing.T) {
+	db := newDB(t)
+
+	hash := sha256.Sum256([]byte("payload"))
+	addr1 := oidtest.Address()
+	addr2 := oidtest.Address()
+
+	stored, _, err := metaAddPayloadRef(db, addr1, hash[:])
+	require.NoError(t, err)
+	require.False(t, stored)
+
+	storageID := []byte("blz")
+	require.NoError(t, metaSetPayloadStorageID(db, hash[:], storageID))
+
+	stored, id, err := metaAddPayloadRef(db, addr2, hash[:])
+	require.NoError(t, err)
+	require.True(t, stored)
+	require.Equal(t, storageID, id)
+
+	// repeated reference is not counted twice
+	_, _, err = metaAddPayloadRef(db, addr2, hash[:])
+	require.NoError(t, err)
+
+	t.Run("another payload", func(t *testing.T) {
+		other := sha256.Sum256([]byte("other"))
+
+		_, _, err := metaAddPayloadRef(db, addr1, other[:])
+		require.Error(t, err)
+	})
+
+	var hashPrm meta.PayloadHashPrm
+	hashPrm.SetAddress(addr1)
+
+	hashRes, err := db.PayloadHash(hashPrm)
+	require.NoError(t, err)
+	require.Equal(t, hash[:], hashRes.PayloadHash())
+
+	res, err := metaRemovePayloadRef(db, addr1)
+	require.NoError(t, err)
+	require.Equal(t, hash[:], res.PayloadHash())
+	require.False(t, res.Last())
+
+	t.Run("reset", func(t *testing.T) {
+		require.NoError(t, db.Reset())
+
+		var prm meta.PayloadStorageIDPrm
+		prm.SetPayloadHash(hash[:])
+
+		res, err := db.PayloadStorageID(prm)
+		require.NoError(t, err)
+		require.True(t, res.Found())
+		require.Equal(t, storageID, res.StorageID())
+	})
+
+	// removing twice is a no-op
+	res, err = metaRemovePayloadRef(db, addr1)
+	require.NoError(t, err)
+	require.Nil(t, res.PayloadHash())
+
+	res, err = metaRemovePayloadRef(db, addr2)
+	require.NoError(t, err)
+	require.True(t, res.Last())
+	require.Equal(t, storageID, res.StorageID())
+
+	var prm meta.PayloadStorageIDPrm
+	prm.SetPayloadHash(hash[:])
+
+	idRes, err := db.PayloadStorageID(prm)
+	require.NoError(t, err)
+	require.False(t, idRes.Found())
+
+	// payload is stored again after all references are removed
+	stored, _, err = metaAddPayloadRef(db, addr1, hash[:])
+	require.NoError(t, err)
+	require.False(t, stored)
+
+	t.Run("empty storage ID", func(t *testing.T) {
+		require.NoError(t, metaSetPayloadStorageID(db, hash[:], []byte{}))
+
+		_, id, err := metaAddPayloadRef(db, addr2, hash[:])
+		require.NoError(t, err)
+		require.NotNil(t, id)
+		require.Empty(t, id)
+	})
+}
+
+func metaAddPayloadRef(db *meta.DB, addr oid.Address, hash []byte) (bool, []byte, error) {
+	var prm meta.AddPayloadRefPrm
+	prm.SetAddress(addr)
+	prm.SetPayloadHash(hash)
+
+	res, err := db.AddPayloadRef(prm)
+
+	return res.Stored(), res.StorageID(), err
+}
+
+func metaSetPayloadStorageID(db *meta.DB, hash, id []byte) error {
+	var prm meta.SetPayloadStorageIDPrm
+	prm.SetPayloadHash(hash)
+	prm.SetStorageID(id)
+
+	_, err := db.SetPayloadStorageID(prm)
+
+	return err
+}
+
+func metaRemovePayloadRef(db *meta.DB, addr oid.Address) (meta.RemovePayloadRefRes, error) {
+	var prm meta.RemovePayloadRefPrm
+	prm.SetAddress(addr)
+
+	return db.RemovePayloadRef(prm)
+}
@@ -160,6 +160,14 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 		}
 	}
 
+	// remove corruption mark, there is no physical copy anymore
+	if corruptedBKT := tx.Bucket(corruptedBucketName); corruptedBKT != nil {
+		err := corruptedBKT.Delete(addrKey)
+		if err != nil {
+			return false, false, fmt.Errorf("could not remove from corrupted bucket: %w", err)
+		}
+	}
+
 	// unmarshal object, work only with physically stored (raw == true) objects
 	obj, err := db.get(tx, addr, key, false, true, currEpoch)
 	if err != nil {
@@ -193,6 +201,11 @@ func (db *DB) delete(tx *bbolt.Tx, addr oid.Address, refCounter referenceCounter
 		nRef.cur++
 	}
 
+	err = changeOwnerUsage(tx, obj, usageDelta{logic: removeAvailableObject, phy: true}, false)
+	if err != nil {
+		return false, false, fmt.Errorf("could not decrease owner usage: %w", err)
+	}
+
 	// remove object
 	err = db.deleteObject(tx, obj, false)
 	if err != nil {
@@ -217,11 +230,16 @@ func (db *DB) deleteObject(
 		return fmt.Errorf("can't remove list indexes: %w", err)
 	}
 
-	err = updateFKBTIndexes(tx, obj, delFKBTIndexItem)
+	err = updateFKBTIndexes(tx, obj, allIndexes, delFKBTIndexItem)
 	if err != nil {
 		return fmt.Errorf("can't remove fake bucket tree indexes: %w", err)
 	}
 
+	err = updateNumericIndexes(tx, obj, allIndexes, delNumericIndexItem)
+	if err != nil {
+		return fmt.Errorf("can't remove numeric indexes: %w", err)
+	}
+
 	return nil
 }
 
@@ -347,6 +365,10 @@ func delUniqueIndexes(tx *bbolt.Tx, obj *objectSDK.Object, isParent bool) error
 		name: toMoveItBucketName,
 		key:  addrKey,
 	})
+	delUniqueIndexItem(tx, namedBucketItem{ // remove from access index
+		name: accessBucketName,
+		key:  addrKey,
+	})
 
 	return nil
 }
@@ -68,6 +68,8 @@ func (db *DB) exists(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) (exists b
 		return false, logicerr.Wrap(apistatus.ObjectAlreadyRemoved{})
 	case 3:
 		return false, ErrObjectIsExpired
+	case 4:
+		return false, logicerr.Wrap(apistatus.ObjectNotFound{})
 	}
 
 	objKey := objectKey(addr.Object(), make([]byte, objectKeySize))
@@ -98,7 +100,8 @@ func (db *DB) exists(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) (exists b
 //   - 0 if object is available;
 //   - 1 if object with GC mark;
 //   - 2 if object is covered with tombstone;
-//   - 3 if object is expired.
+//   - 3 if object is expired;
+//   - 4 if physical copy of the object is corrupted.
 func objectStatus(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) uint8 {
 	// we check only if the object is expired in the current
 	// epoch since it is considered the only corner case: the
@@ -128,7 +131,14 @@ func objectStatus(tx *bbolt.Tx, addr oid.Address, currEpoch uint64) uint8 {
 	graveyardBkt := tx.Bucket(graveyardBucketName)
 	garbageBkt := tx.Bucket(garbageBucketName)
 	addrKey := addressKey(addr, make([]byte, addressKeySize))
-	return inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt)
+	if st := inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt); st > 0 {
+		return st
+	}
+
+	if isCorrupted(tx, addrKey) {
+		return 4
+	}
+	return 0
 }
 
 func inGraveyardWithKey(addrKey []byte, graveyard, garbageBCK *bbolt.Bucket) uint8 {
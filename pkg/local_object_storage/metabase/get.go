@@ -75,6 +75,8 @@ func (db *DB) get(tx *bbolt.Tx, addr oid.Address, key []byte, checkStatus, raw b
 			return nil, logicerr.Wrap(apistatus.ObjectAlreadyRemoved{})
 		case 3:
 			return nil, ErrObjectIsExpired
+		case 4:
+			return nil, logicerr.Wrap(apistatus.ObjectNotFound{})
 		}
 	}
 
@@ -1,12 +1,24 @@
 package meta
 
 import (
+	"bytes"
 	"math"
 	"math/rand"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/io"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
 )
 
 func Test_getVarUint(t *testing.T) {
@@ -60,3 +72,97 @@ func Test_decodeList(t *testing.T) {
 		})
 	})
 }
+
+func Test_encodeNumeric(t *testing.T) {
+	values := []string{
+		strconv.FormatInt(math.MinInt64, 10),
+		"-100",
+		"-1",
+		"0",
+		"1",
+		"100",
+		strconv.FormatInt(math.MaxInt64, 10),
+		strconv.FormatUint(math.MaxUint64, 10),
+	}
+
+	var prev []byte
+	for i := range values {
+		cur, ok := encodeNumeric(values[i])
+		require.True(t, ok, values[i])
+		require.Len(t, cur, numericValueSize)
+
+		if prev != nil {
+			require.Negative(t, bytes.Compare(prev, cur), values[i])
+		}
+
+		prev = cur
+	}
+
+	plus, ok := encodeNumeric("+100")
+	require.True(t, ok)
+	hundred, _ := encodeNumeric("100")
+	require.Equal(t, hundred, plus)
+
+	for _, s := range []string{"", "abc", "1.5", "18446744073709551616", "-9223372036854775809"} {
+		_, ok := encodeNumeric(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestSyncNumericIndexes(t *testing.T) {
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	t.Cleanup(func() { _ = db.Close() })
+
+	cnr := cidtest.ID()
+
+	var a objectSDK.Attribute
+	a.SetKey("Timestamp")
+	a.SetValue("42")
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cnr)
+	obj.SetID(oidtest.ID())
+	obj.SetOwnerID(usertest.ID())
+	obj.SetCreationEpoch(7)
+	obj.SetPayloadChecksum(checksumtest.Checksum())
+	obj.SetAttributes(a)
+
+	var putPrm PutPrm
+	putPrm.SetObject(obj)
+	_, err := db.Put(putPrm)
+	require.NoError(t, err)
+
+	require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+		var names [][]byte
+		_ = tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if name[0] == numericIndexPrefix {
+				names = append(names, append([]byte(nil), name...))
+			}
+			return nil
+		})
+		require.Len(t, names, 3)
+
+		// drop the indexes as if the object was put by the previous version
+		for i := range names {
+			require.NoError(t, tx.DeleteBucket(names[i]))
+		}
+		return nil
+	}))
+
+	require.NoError(t, db.boltDB.Update(syncNumericIndexes))
+
+	var fs objectSDK.SearchFilters
+	fs.AddFilter("Timestamp", "40", objectcore.MatchNumGT)
+	fs.AddFilter(v2object.FilterHeaderCreationEpoch, "7", objectcore.MatchNumLE)
+
+	var selectPrm SelectPrm
+	selectPrm.SetContainerID(cnr)
+	selectPrm.SetFilters(fs)
+
+	res, err := db.Select(selectPrm)
+	require.NoError(t, err)
+	require.Equal(t, []oid.Address{objectcore.AddressOf(obj)}, res.AddressList())
+}
@@ -0,0 +1,614 @@
+package meta
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.etcd.io/bbolt"
+)
+
+// indexSettingsBucketName stores the attribute index settings of the containers.
+var indexSettingsBucketName = []byte{indexSettingsPrefix}
+
+// IndexKind is a kind of the secondary index of the object attribute.
+type IndexKind uint8
+
+const (
+	_ IndexKind = iota
+
+	// IndexExact allows searching the attribute by the string matchers.
+	IndexExact
+
+	// IndexPrefix allows searching the attribute by the string matchers.
+	// It shares the index with IndexExact, the kinds are interchangeable.
+	IndexPrefix
+
+	// IndexNumeric allows searching the integer attribute values
+	// by the numeric matchers.
+	IndexNumeric
+)
+
+// String returns the string representation of IndexKind.
+func (k IndexKind) String() string {
+	switch k {
+	case IndexExact:
+		return "exact"
+	case IndexPrefix:
+		return "prefix"
+	case IndexNumeric:
+		return "numeric"
+	default:
+		return fmt.Sprintf("unknown(%d)", k)
+	}
+}
+
+// FromString parses IndexKind from the string representation.
+// Returns false if s is not a known index kind.
+func (k *IndexKind) FromString(s string) bool {
+	switch s {
+	case "exact":
+		*k = IndexExact
+	case "prefix":
+		*k = IndexPrefix
+	case "numeric":
+		*k = IndexNumeric
+	default:
+		return false
+	}
+
+	return true
+}
+
+// AttributeIndex describes the secondary index of the object attribute.
+type AttributeIndex struct {
+	// Attribute is a key of the indexed attribute.
+	Attribute string
+
+	// Kind is a kind of the index.
+	Kind IndexKind
+}
+
+// indexMask is a set of the index kinds of the attribute.
+type indexMask uint8
+
+const (
+	// indexMaskFKBT marks the attributes indexed in the FKBT buckets.
+	indexMaskFKBT indexMask = 1 << iota
+	// indexMaskNumeric marks the attributes indexed in the numeric buckets.
+	indexMaskNumeric
+)
+
+// indexSet is a set of the attribute indexes of the container.
+type indexSet struct {
+	// all is set if all the attributes are indexed, it is the default
+	all bool
+
+	attrs map[string]indexMask
+}
+
+// allIndexes is an indexSet of the containers without custom settings.
+var allIndexes = indexSet{all: true}
+
+func newIndexSet(indexes []AttributeIndex) indexSet {
+	s := indexSet{attrs: make(map[string]indexMask, len(indexes))}
+
+	for i := range indexes {
+		switch indexes[i].Kind {
+		case IndexExact, IndexPrefix:
+			s.attrs[indexes[i].Attribute] |= indexMaskFKBT
+		case IndexNumeric:
+			s.attrs[indexes[i].Attribute] |= indexMaskNumeric
+		}
+	}
+
+	return s
+}
+
+// has checks whether the attribute has the index of the specified kind.
+// System attributes are always indexed in the FKBT buckets since
+// the metabase relies on them.
+func (s indexSet) has(attr string, m indexMask) bool {
+	if s.all || s.attrs[attr]&m != 0 {
+		return true
+	}
+
+	return m == indexMaskFKBT && strings.HasPrefix(attr, v2object.SysAttributePrefix)
+}
+
+// covers checks whether all the indexes of x are present in s.
+func (s indexSet) covers(x indexSet) bool {
+	if s.all {
+		return true
+	} else if x.all {
+		return false
+	}
+
+	for attr, m := range x.attrs {
+		if s.attrs[attr]&m != m {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s indexSet) equals(x indexSet) bool {
+	return s.covers(x) && x.covers(s)
+}
+
+// union returns the set of the indexes present in s or x.
+func (s indexSet) union(x indexSet) indexSet {
+	if s.all || x.all {
+		return allIndexes
+	}
+
+	res := indexSet{attrs: make(map[string]indexMask, len(s.attrs)+len(x.attrs))}
+
+	for attr, m := range s.attrs {
+		res.attrs[attr] |= m
+	}
+
+	for attr, m := range x.attrs {
+		res.attrs[attr] |= m
+	}
+
+	return res
+}
+
+func (s indexSet) encode(w *io.BinWriter) {
+	w.WriteBool(s.all)
+	w.WriteVarUint(uint64(len(s.attrs)))
+
+	for attr, m := range s.attrs {
+		w.WriteString(attr)
+		w.WriteB(byte(m))
+	}
+}
+
+func (s *indexSet) decode(r *io.BinReader) {
+	s.all = r.ReadBool()
+
+	n := r.ReadVarUint()
+	if r.Err != nil {
+		return
+	}
+
+	s.attrs = make(map[string]indexMask, n)
+
+	for i := uint64(0); i < n && r.Err == nil; i++ {
+		attr := r.ReadString()
+		s.attrs[attr] = indexMask(r.ReadB())
+	}
+}
+
+// indexState is a state of the attribute indexes of the container.
+type indexState struct {
+	// applied is a set of the complete indexes used for the selection
+	applied indexSet
+
+	// pending is a set of the indexes to switch to, nil if the
+	// indexes are up-to-date
+	pending *indexSet
+
+	// cursor is a prefixed key of the last object the pending
+	// indexes are built for
+	cursor []byte
+}
+
+func (s indexState) marshal() ([]byte, error) {
+	w := io.NewBufBinWriter()
+
+	s.applied.encode(w.BinWriter)
+
+	w.WriteBool(s.pending != nil)
+	if s.pending != nil {
+		s.pending.encode(w.BinWriter)
+		w.WriteVarBytes(s.cursor)
+	}
+
+	if w.Err != nil {
+		return nil, w.Err
+	}
+
+	return w.Bytes(), nil
+}
+
+func (s *indexState) unmarshal(data []byte) error {
+	r := io.NewBinReaderFromBuf(data)
+
+	s.applied.decode(r)
+
+	if r.ReadBool() {
+		s.pending = new(indexSet)
+		s.pending.decode(r)
+		s.cursor = r.ReadVarBytes()
+	}
+
+	return r.Err
+}
+
+// initIndexSettings creates the index settings bucket. All the attributes
+// of the stored objects are indexed before, so the bucket is left empty.
+func initIndexSettings(tx *bbolt.Tx) error {
+	_, err := tx.CreateBucketIfNotExists(indexSettingsBucketName)
+	return err
+}
+
+// migrateIndexSettings is initIndexSettings migration, see migration.
+func migrateIndexSettings(tx *bbolt.Tx, _ []byte) ([]byte, error) {
+	return nil, initIndexSettings(tx)
+}
+
+// containerIndexState returns the state of the attribute indexes of the container.
+func containerIndexState(tx *bbolt.Tx, cnr cid.ID) (indexState, error) {
+	st := indexState{applied: allIndexes}
+
+	b := tx.Bucket(indexSettingsBucketName)
+	if b == nil {
+		return st, nil
+	}
+
+	key := make([]byte, cidSize)
+	cnr.Encode(key)
+
+	data := b.Get(key)
+	if data == nil {
+		return st, nil
+	}
+
+	err := st.unmarshal(data)
+	if err != nil {
+		return st, fmt.Errorf("could not decode index settings of the container %s: %w", cnr, err)
+	}
+
+	return st, nil
+}
+
+// selectIndexes returns the set of the indexes to select the objects of the container with.
+func selectIndexes(tx *bbolt.Tx, cnr cid.ID) (indexSet, error) {
+	st, err := containerIndexState(tx, cnr)
+	return st.applied, err
+}
+
+// putIndexes returns the set of the indexes to put the objects of the container to.
+// Objects are added to the pending indexes too, so they are complete after the build.
+func putIndexes(tx *bbolt.Tx, cnr cid.ID) (indexSet, error) {
+	st, err := containerIndexState(tx, cnr)
+	if err != nil || st.pending == nil {
+		return st.applied, err
+	}
+
+	return st.applied.union(*st.pending), nil
+}
+
+func putContainerIndexState(tx *bbolt.Tx, key []byte, st indexState) error {
+	b := tx.Bucket(indexSettingsBucketName)
+
+	if st.pending == nil && st.applied.all {
+		return b.Delete(key)
+	}
+
+	data, err := st.marshal()
+	if err != nil {
+		return fmt.Errorf("could not encode index settings: %w", err)
+	}
+
+	return b.Put(key, data)
+}
+
+// IndexSettingsPrm groups the parameters of SetIndexSettings operation.
+type IndexSettingsPrm struct {
+	settings map[cid.ID][]AttributeIndex
+}
+
+// IndexSettingsRes groups the resulting values of SetIndexSettings operation.
+type IndexSettingsRes struct {
+	pending bool
+}
+
+// SetSettings sets the attribute indexes of the containers. All the attributes
+// of the containers missing in the settings are indexed.
+func (p *IndexSettingsPrm) SetSettings(settings map[cid.ID][]AttributeIndex) {
+	p.settings = settings
+}
+
+// Pending returns true if the indexes must be updated with UpdateIndexes.
+func (r IndexSettingsRes) Pending() bool {
+	return r.pending
+}
+
+// SetIndexSettings changes the attribute indexes of the containers. Indexes
+// are not changed immediately: the selection uses the previous ones until
+// the new indexes are built with UpdateIndexes.
+func (db *DB) SetIndexSettings(prm IndexSettingsPrm) (res IndexSettingsRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	targets := make(map[string]indexSet, len(prm.settings))
+	for cnr, indexes := range prm.settings {
+		key := make([]byte, cidSize)
+		cnr.Encode(key)
+
+		targets[string(key)] = newIndexSet(indexes)
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(indexSettingsBucketName)
+
+		states := make(map[string]indexState)
+
+		err := b.ForEach(func(k, v []byte) error {
+			var st indexState
+			if err := st.unmarshal(v); err != nil {
+				return fmt.Errorf("could not decode index settings: %w", err)
+			}
+
+			states[string(k)] = st
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for k := range targets {
+			if _, ok := states[k]; !ok {
+				states[k] = indexState{applied: allIndexes}
+			}
+		}
+
+		for k, st := range states {
+			target, ok := targets[k]
+			if !ok {
+				target = allIndexes
+			}
+
+			current := st.applied
+			if st.pending != nil {
+				current = *st.pending
+			}
+
+			if !current.equals(target) {
+				// the partially built pending indexes are
+				// dropped when the new ones are applied
+				st.pending = &target
+				st.cursor = nil
+
+				if err := putContainerIndexState(tx, []byte(k), st); err != nil {
+					return err
+				}
+			}
+
+			res.pending = res.pending || st.pending != nil
+		}
+
+		return nil
+	})
+
+	return res, err
+}
+
+// UpdateIndexesPrm groups the parameters of UpdateIndexes operation.
+type UpdateIndexesPrm struct {
+	count uint32
+}
+
+// UpdateIndexesRes groups the resulting values of UpdateIndexes operation.
+type UpdateIndexesRes struct {
+	done bool
+}
+
+// SetCount sets the maximum number of the objects to index at once.
+func (p *UpdateIndexesPrm) SetCount(count uint32) {
+	p.count = count
+}
+
+// Done returns true if there are no more indexes to update.
+func (r UpdateIndexesRes) Done() bool {
+	return r.done
+}
+
+// indexedObjectPrefixes contains prefixes of the buckets with the objects
+// to build the indexes for. Prefixes are sorted since the build cursor is
+// compared with them.
+var indexedObjectPrefixes = []byte{primaryPrefix, lockersPrefix, storageGroupPrefix, tombstonePrefix}
+
+// UpdateIndexes makes a step to apply the index settings of the containers
+// set by SetIndexSettings: builds the new indexes for the next batch of the
+// stored objects or, when they are complete, drops the indexes missing in
+// the settings and starts using the new ones for the selection.
+//
+// The operation must be repeated until the result is done.
+func (db *DB) UpdateIndexes(prm UpdateIndexesPrm) (res UpdateIndexesRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	} else if db.mode.ReadOnly() {
+		return res, ErrReadOnlyMode
+	}
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		var (
+			key []byte
+			st  indexState
+		)
+
+		c := tx.Bucket(indexSettingsBucketName).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			var s indexState
+			if err := s.unmarshal(v); err != nil {
+				return fmt.Errorf("could not decode index settings: %w", err)
+			}
+
+			if s.pending != nil {
+				key, st = append([]byte(nil), k...), s
+				break
+			}
+		}
+
+		if key == nil {
+			res.done = true
+			return nil
+		}
+
+		var cnr cid.ID
+		if err := cnr.Decode(key); err != nil {
+			return fmt.Errorf("invalid container ID in index settings: %w", err)
+		}
+
+		if !st.applied.covers(*st.pending) {
+			built, err := buildIndexes(tx, cnr, &st, int(prm.count))
+			if err != nil {
+				return err
+			}
+
+			if !built {
+				return putContainerIndexState(tx, key, st)
+			}
+		}
+
+		err := dropIndexes(tx, cnr, *st.pending)
+		if err != nil {
+			return err
+		}
+
+		st.applied, st.pending, st.cursor = *st.pending, nil, nil
+
+		return putContainerIndexState(tx, key, st)
+	})
+
+	return res, err
+}
+
+// buildIndexes adds the next batch of the container objects to the pending
+// indexes. Returns true if all the objects are indexed.
+func buildIndexes(tx *bbolt.Tx, cnr cid.ID, st *indexState, count int) (bool, error) {
+	var (
+		objs []*objectSDK.Object
+		name = make([]byte, bucketKeySize)
+	)
+
+	for _, prefix := range indexedObjectPrefixes {
+		if len(st.cursor) != 0 && st.cursor[0] > prefix {
+			continue
+		}
+
+		b := tx.Bucket(bucketName(cnr, prefix, name))
+		if b == nil {
+			continue
+		}
+
+		c := b.Cursor()
+
+		var k, v []byte
+		if len(st.cursor) != 0 && st.cursor[0] == prefix {
+			k, v = c.Seek(st.cursor[1:])
+			if k != nil && bytes.Equal(k, st.cursor[1:]) {
+				k, v = c.Next()
+			}
+		} else {
+			k, v = c.First()
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			if count > 0 && len(objs) >= count {
+				break
+			}
+
+			obj := objectSDK.New()
+			if err := obj.Unmarshal(v); err != nil {
+				return false, fmt.Errorf("could not unmarshal object: %w", err)
+			}
+
+			objs = append(objs, obj)
+			st.cursor = append(append(st.cursor[:0], prefix), k...)
+		}
+
+		if count > 0 && len(objs) >= count {
+			break
+		}
+	}
+
+	// buckets are changed after the iteration to keep the cursors valid
+	for i := range objs {
+		for _, hdr := range indexedHeaders(objs[i]) {
+			err := updateFKBTIndexes(tx, hdr, *st.pending, putFKBTIndexItem)
+			if err != nil {
+				return false, fmt.Errorf("could not put fake bucket tree indexes: %w", err)
+			}
+
+			err = updateNumericIndexes(tx, hdr, *st.pending, putNumericIndexItem)
+			if err != nil {
+				return false, fmt.Errorf("could not put numeric indexes: %w", err)
+			}
+		}
+	}
+
+	return count <= 0 || len(objs) < count, nil
+}
+
+// indexedHeaders returns the headers of the stored object which are put
+// to the indexes: the object itself and its parent if the parent ID is known.
+// Parent objects are not stored separately, their headers are indexed along
+// with the children.
+func indexedHeaders(obj *objectSDK.Object) []*objectSDK.Object {
+	res := []*objectSDK.Object{obj}
+
+	if par := obj.Parent(); par != nil {
+		if _, ok := par.ID(); ok {
+			res = append(res, par)
+		}
+	}
+
+	return res
+}
+
+// dropIndexes removes the attribute indexes of the container missing in s.
+func dropIndexes(tx *bbolt.Tx, cnr cid.ID, s indexSet) error {
+	if s.all {
+		return nil
+	}
+
+	var names [][]byte
+
+	for _, prefix := range []struct {
+		prefix byte
+		mask   indexMask
+	}{
+		{userAttributePrefix, indexMaskFKBT},
+		{numericIndexPrefix, indexMaskNumeric},
+	} {
+		name := bucketName(cnr, prefix.prefix, make([]byte, bucketKeySize))
+
+		c := tx.Cursor()
+		for k, _ := c.Seek(name); k != nil && bytes.HasPrefix(k, name); k, _ = c.Next() {
+			attr := string(k[bucketKeySize:])
+			if isSystemKey(attr) || s.has(attr, prefix.mask) {
+				continue
+			}
+
+			names = append(names, append([]byte(nil), k...))
+		}
+	}
+
+	for i := range names {
+		if err := tx.DeleteBucket(names[i]); err != nil {
+			return fmt.Errorf("could not drop index %v: %w", names[i], err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package meta
+
+import (
+	"path/filepath"
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestIndexKind_FromString(t *testing.T) {
+	for _, k := range []IndexKind{IndexExact, IndexPrefix, IndexNumeric} {
+		var res IndexKind
+		require.True(t, res.FromString(k.String()))
+		require.Equal(t, k, res)
+	}
+
+	var k IndexKind
+	require.False(t, k.FromString("unknown"))
+}
+
+func TestDB_UpdateIndexes(t *testing.T) {
+	db := New(WithPath(filepath.Join(t.TempDir(), "meta")),
+		WithPermissions(0600), WithEpochState(epochStateImpl{}))
+	require.NoError(t, db.Open(false))
+	require.NoError(t, db.Init())
+	t.Cleanup(func() { _ = db.Close() })
+
+	cnr := cidtest.ID()
+
+	putObject := func(typ objectSDK.Type, attrs ...string) *objectSDK.Object {
+		obj := objectSDK.New()
+		obj.SetType(typ)
+		obj.SetContainerID(cnr)
+		obj.SetID(oidtest.ID())
+		obj.SetOwnerID(usertest.ID())
+		obj.SetPayloadChecksum(checksumtest.Checksum())
+
+		var as []objectSDK.Attribute
+		for i := 0; i < len(attrs); i += 2 {
+			var a objectSDK.Attribute
+			a.SetKey(attrs[i])
+			a.SetValue(attrs[i+1])
+			as = append(as, a)
+		}
+		obj.SetAttributes(as...)
+
+		var prm PutPrm
+		prm.SetObject(obj)
+		_, err := db.Put(prm)
+		require.NoError(t, err)
+
+		return obj
+	}
+
+	testSelect := func(key, value string, op objectSDK.SearchMatchType, exp ...*objectSDK.Object) {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter(key, value, op)
+
+		var prm SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+
+		addrs := make([]oid.Address, 0, len(exp))
+		for i := range exp {
+			addrs = append(addrs, objectcore.AddressOf(exp[i]))
+		}
+
+		require.ElementsMatch(t, addrs, res.AddressList(), "%s %s %s", key, op, value)
+	}
+
+	hasBucket := func(name []byte) bool {
+		var ok bool
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			ok = tx.Bucket(name) != nil
+			return nil
+		}))
+		return ok
+	}
+
+	setSettings := func(settings map[cid.ID][]AttributeIndex) bool {
+		var prm IndexSettingsPrm
+		prm.SetSettings(settings)
+
+		res, err := db.SetIndexSettings(prm)
+		require.NoError(t, err)
+
+		return res.Pending()
+	}
+
+	// updateIndexes returns the number of the steps until the update is done
+	updateIndexes := func() int {
+		var prm UpdateIndexesPrm
+		prm.SetCount(1)
+
+		for steps := 1; ; steps++ {
+			res, err := db.UpdateIndexes(prm)
+			require.NoError(t, err)
+
+			if res.Done() {
+				return steps
+			}
+		}
+	}
+
+	obj1 := putObject(objectSDK.TypeRegular, "Name", "a", "Size", "10", "Other", "x")
+	obj2 := putObject(objectSDK.TypeRegular, "Name", "b", "Size", "20")
+
+	buf := make([]byte, bucketKeySize)
+	require.True(t, hasBucket(attributeBucketName(cnr, "Other", buf)))
+	require.False(t, hasBucket(numericIndexBucketName(cnr, "Other", buf)))
+
+	require.True(t, setSettings(map[cid.ID][]AttributeIndex{
+		cnr: {
+			{Attribute: "Name", Kind: IndexExact},
+			{Attribute: "Size", Kind: IndexNumeric},
+		},
+	}))
+
+	// indexes are dropped only on update, the new indexes
+	// are complete, so they are applied at the first step
+	require.True(t, hasBucket(attributeBucketName(cnr, "Other", buf)))
+	require.Equal(t, 2, updateIndexes())
+
+	require.False(t, hasBucket(attributeBucketName(cnr, "Other", buf)))
+	require.False(t, hasBucket(attributeBucketName(cnr, "Size", buf)))
+	require.True(t, hasBucket(attributeBucketName(cnr, "Name", buf)))
+	require.True(t, hasBucket(numericIndexBucketName(cnr, "Size", buf)))
+
+	t.Run("not indexed attributes", func(t *testing.T) {
+		testSelect("Name", "a", objectSDK.MatchStringEqual, obj1)
+		testSelect("Other", "x", objectSDK.MatchStringEqual, obj1)
+		testSelect("Other", "x", objectSDK.MatchStringNotEqual)
+		testSelect("Other", "", objectSDK.MatchNotPresent, obj2)
+		testSelect("Size", "1", objectSDK.MatchCommonPrefix, obj1)
+		testSelect("Size", "15", objectcore.MatchNumGT, obj2)
+		testSelect("Name", "0", objectcore.MatchNumGT)
+	})
+
+	obj3 := putObject(objectSDK.TypeRegular, "Name", "c", "Other", "y")
+	lock := putObject(objectSDK.TypeLock, "Other", "lock")
+	ts := putObject(objectSDK.TypeTombstone, "Other", "ts")
+	require.False(t, hasBucket(attributeBucketName(cnr, "Other", buf)))
+
+	require.False(t, setSettings(map[cid.ID][]AttributeIndex{
+		cnr: {
+			{Attribute: "Size", Kind: IndexNumeric},
+			{Attribute: "Name", Kind: IndexPrefix},
+		},
+	}))
+
+	// default settings are applied to the containers missing in the settings
+	require.True(t, setSettings(nil))
+
+	// the selection uses the applied indexes until the new ones are built
+	testSelect("Other", "y", objectSDK.MatchStringEqual, obj3)
+	// five steps with an object each, the step applying
+	// the indexes and the one reporting the end
+	require.Equal(t, 7, updateIndexes())
+
+	require.True(t, hasBucket(attributeBucketName(cnr, "Other", buf)))
+	require.True(t, hasBucket(attributeBucketName(cnr, "Size", buf)))
+	require.False(t, setSettings(nil))
+
+	testSelect("Other", "y", objectSDK.MatchStringEqual, obj3)
+	testSelect("Other", "", objectSDK.MatchNotPresent, obj2)
+	testSelect("Size", "10", objectSDK.MatchStringEqual, obj1)
+	testSelect("Other", "", objectSDK.MatchCommonPrefix, obj1, obj3, lock, ts)
+}
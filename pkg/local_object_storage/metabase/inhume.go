@@ -168,6 +168,11 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 					// object is available, decrement the
 					// logical counter
 					inhumed++
+
+					err := changeOwnerUsage(tx, obj, usageDelta{logic: true}, false)
+					if err != nil {
+						return fmt.Errorf("could not decrease owner usage: %w", err)
+					}
 				}
 
 				// if object is stored, and it is regular object then update bucket
@@ -220,6 +225,16 @@ func (db *DB) Inhume(prm InhumePrm) (res InhumeRes, err error) {
 				return err
 			}
 
+			var tombKey []byte
+			if prm.tomb != nil {
+				tombKey = value
+			}
+
+			err = logChange(tx, prm.target[i], ChangeInhume, tombKey)
+			if err != nil {
+				return fmt.Errorf("could not log object inhume: %w", err)
+			}
+
 			if prm.lockObjectHandling {
 				// do not perform lock check if
 				// it was already called
@@ -1,6 +1,9 @@
 package meta
 
 import (
+	"errors"
+
+	"github.com/nspcc-dev/neo-go/pkg/util/slice"
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -20,6 +23,33 @@ type Cursor struct {
 	inBucketOffset []byte
 }
 
+// Marshal encodes the cursor into a binary form, e.g. to persist the
+// position of the listing.
+func (c *Cursor) Marshal() []byte {
+	data := make([]byte, 0, 2+len(c.bucketName)+len(c.inBucketOffset))
+	data = append(data, byte(len(c.bucketName)))
+	data = append(data, c.bucketName...)
+	data = append(data, byte(len(c.inBucketOffset)))
+	return append(data, c.inBucketOffset...)
+}
+
+// Unmarshal decodes the cursor from the binary form produced by Marshal.
+func (c *Cursor) Unmarshal(data []byte) error {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return errors.New("invalid cursor: bucket name is truncated")
+	}
+	bucketName := data[1 : 1+data[0]]
+	data = data[1+data[0]:]
+
+	if len(data) == 0 || len(data) != 1+int(data[0]) {
+		return errors.New("invalid cursor: offset is truncated")
+	}
+
+	c.bucketName = slice.Copy(bucketName)
+	c.inBucketOffset = slice.Copy(data[1:])
+	return nil
+}
+
 // ListPrm contains parameters for ListWithCursor operation.
 type ListPrm struct {
 	count  int
@@ -93,6 +123,7 @@ func (db *DB) listWithCursor(tx *bbolt.Tx, result []objectcore.AddressWithType,
 	var offset []byte
 	graveyardBkt := tx.Bucket(graveyardBucketName)
 	garbageBkt := tx.Bucket(garbageBucketName)
+	corruptedBkt := tx.Bucket(corruptedBucketName)
 
 	var rawAddr = make([]byte, cidSize, addressKeySize)
 
@@ -121,7 +152,7 @@ loop:
 		bkt := tx.Bucket(name)
 		if bkt != nil {
 			copy(rawAddr, cidRaw)
-			result, offset, cursor = selectNFromBucket(bkt, objType, graveyardBkt, garbageBkt, rawAddr, containerID,
+			result, offset, cursor = selectNFromBucket(bkt, objType, graveyardBkt, garbageBkt, corruptedBkt, rawAddr, containerID,
 				result, count, cursor, threshold)
 		}
 		bucketName = name
@@ -154,10 +185,11 @@ loop:
 }
 
 // selectNFromBucket similar to selectAllFromBucket but uses cursor to find
-// object to start selecting from. Ignores inhumed objects.
+// object to start selecting from. Ignores inhumed and corrupted objects.
 func selectNFromBucket(bkt *bbolt.Bucket, // main bucket
 	objType object.Type, // type of the objects stored in the main bucket
 	graveyardBkt, garbageBkt *bbolt.Bucket, // cached graveyard buckets
+	corruptedBkt *bbolt.Bucket, // cached corrupted objects bucket
 	cidRaw []byte, // container ID prefix, optimization
 	cnt cid.ID, // container ID
 	to []objectcore.AddressWithType, // listing result
@@ -191,7 +223,11 @@ func selectNFromBucket(bkt *bbolt.Bucket, // main bucket
 		}
 
 		offset = k
-		if inGraveyardWithKey(append(cidRaw, k...), graveyardBkt, garbageBkt) > 0 {
+		addrKey := append(cidRaw, k...)
+		if inGraveyardWithKey(addrKey, graveyardBkt, garbageBkt) > 0 {
+			continue
+		}
+		if corruptedBkt != nil && corruptedBkt.Get(addrKey) != nil {
 			continue
 		}
 
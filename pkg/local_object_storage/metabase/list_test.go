@@ -215,6 +215,30 @@ func TestAddObjectDuringListingWithCursor(t *testing.T) {
 
 }
 
+func TestCursor_Marshal(t *testing.T) {
+	db := newDB(t)
+
+	const total = 5
+	for i := 0; i < total; i++ {
+		require.NoError(t, putBig(db, generateObject(t)))
+	}
+
+	first, cursor, err := metaListWithCursor(db, 2, nil)
+	require.NoError(t, err)
+
+	var restored meta.Cursor
+	require.NoError(t, restored.Unmarshal(cursor.Marshal()))
+
+	rest, _, err := metaListWithCursor(db, total, &restored)
+	require.NoError(t, err)
+	require.Len(t, append(first, rest...), total)
+	require.NotContains(t, rest, first[0])
+	require.NotContains(t, rest, first[1])
+
+	require.Error(t, restored.Unmarshal(nil))
+	require.Error(t, restored.Unmarshal(cursor.Marshal()[:3]))
+}
+
 func sortAddresses(addrWithType []object.AddressWithType) []object.AddressWithType {
 	sort.Slice(addrWithType, func(i, j int) bool {
 		return addrWithType[i].Address.EncodeToString() < addrWithType[j].Address.EncodeToString()
@@ -0,0 +1,240 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// migration upgrades the metabase schema from some version N to N+1.
+// Big metabases are upgraded in batches: the step handles a part of the
+// data starting from the cursor and returns the cursor to continue from.
+// Nil cursor is passed to the first batch and is returned after the last
+// one. Every batch is executed in a separate transaction along with the
+// cursor update, so an interrupted step is resumed from the last completed
+// batch and the version is updated along with the last batch only.
+type migration func(tx *bbolt.Tx, cursor []byte) ([]byte, error)
+
+// migrationBatchSize is the maximum number of objects handled
+// by a single migration transaction.
+var migrationBatchSize = 1000
+
+var migrationCursorKey = []byte("migration_cursor")
+
+// migrations contains schema upgrade steps indexed by the version
+// they are applied to. The step with key N upgrades the metabase
+// to version N+1. Every new metabase version must come with a step
+// here unless the changes are incompatible and resync is required.
+var migrations = map[uint64]migration{
+	2: migrateOwnerUsage,
+	3: migrateNumericIndexes,
+	4: migrateIndexSettings,
+}
+
+// Version returns the schema version of the metabase.
+// Zero is returned for a blank metabase.
+//
+// Returns ErrOutdatedVersion if the metabase is filled
+// but has no version stored.
+func (db *DB) Version() (uint64, error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return 0, ErrDegradedMode
+	}
+
+	var v uint64
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var err error
+		v, _, err = db.storedVersion(tx)
+		return err
+	})
+	return v, err
+}
+
+// Migrate upgrades the metabase schema to the current version step by step.
+// Every step is performed in a series of transactions, so an interrupted
+// migration continues from the last completed batch on the next call.
+//
+// Does nothing for a blank or an up-to-date metabase.
+// Returns ErrOutdatedVersion if there is no way to upgrade the metabase
+// to the current version and resynchronization is required.
+func (db *DB) Migrate() error {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return ErrDegradedMode
+	} else if db.mode.ReadOnly() || db.boltDB.IsReadOnly() {
+		return ErrReadOnlyMode
+	}
+
+	return db.migrate()
+}
+
+func (db *DB) migrate() error {
+	for {
+		var (
+			stored uint64
+			found  bool
+		)
+		err := db.boltDB.View(func(tx *bbolt.Tx) error {
+			var err error
+			stored, found, err = db.storedVersion(tx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if !found || stored == version {
+			return nil
+		}
+
+		step, ok := migrations[stored]
+		if !ok || stored > version {
+			return fmt.Errorf("%w: expected=%d, stored=%d", ErrOutdatedVersion, version, stored)
+		}
+
+		db.log.Info("migrating metabase",
+			zap.Uint64("from", stored),
+			zap.Uint64("to", stored+1))
+
+		for done := false; !done; {
+			err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+				b := tx.Bucket(shardInfoBucket)
+
+				var cursor []byte
+				if c := b.Get(migrationCursorKey); c != nil {
+					cursor = append([]byte(nil), c...)
+				}
+
+				next, err := step(tx, cursor)
+				if err != nil {
+					return err
+				}
+
+				if next != nil {
+					return b.Put(migrationCursorKey, next)
+				}
+
+				done = true
+				if err := b.Delete(migrationCursorKey); err != nil {
+					return err
+				}
+				return updateVersion(tx, stored+1)
+			})
+			if err != nil {
+				return fmt.Errorf("could not migrate metabase from version %d to %d: %w", stored, stored+1, err)
+			}
+		}
+	}
+}
+
+// migrationObject is an object header collected by the migration batch.
+type migrationObject struct {
+	cnr    cid.ID
+	key    []byte // address key of the object
+	header []byte
+}
+
+// collectMigrationBatch returns at most migrationBatchSize headers of the
+// objects stored in the primary, storage group, locker and tombstone buckets
+// starting right after the cursor. The returned cursor points to the last
+// collected object and is nil if there are no more objects.
+//
+// Headers are collected before they are handled, so the step is free to
+// create and modify the buckets.
+func collectMigrationBatch(tx *bbolt.Tx, cursor []byte) ([]migrationObject, []byte, error) {
+	var startName, startKey []byte
+	if len(cursor) > bucketKeySize {
+		startName, startKey = cursor[:bucketKeySize], cursor[bucketKeySize:]
+	}
+
+	var (
+		res  []migrationObject
+		last []byte
+		name []byte
+		rc   = tx.Cursor()
+	)
+
+	if startName != nil {
+		name, _ = rc.Seek(startName)
+	} else {
+		name, _ = rc.First()
+	}
+
+	for ; name != nil; name, _ = rc.Next() {
+		if len(name) != bucketKeySize {
+			continue
+		}
+
+		switch name[0] {
+		case primaryPrefix, storageGroupPrefix, lockersPrefix, tombstonePrefix:
+		default:
+			continue
+		}
+
+		var cnr cid.ID
+		if err := cnr.Decode(name[1:]); err != nil {
+			return nil, nil, fmt.Errorf("invalid container ID in bucket name: %w", err)
+		}
+
+		c := tx.Bucket(name).Cursor()
+
+		var k, v []byte
+		if startKey != nil && bytes.Equal(name, startName) {
+			k, v = c.Seek(startKey)
+			if bytes.Equal(k, startKey) {
+				k, v = c.Next()
+			}
+		} else {
+			k, v = c.First()
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			if v == nil {
+				continue
+			}
+
+			if len(res) == migrationBatchSize {
+				return res, last, nil
+			}
+
+			key := make([]byte, addressKeySize)
+			copy(key, name[1:])
+			copy(key[cidSize:], k)
+
+			res = append(res, migrationObject{
+				cnr:    cnr,
+				key:    key,
+				header: append([]byte(nil), v...),
+			})
+
+			last = append(append(last[:0], name...), k...)
+		}
+	}
+
+	return res, nil, nil
+}
+
+// storedVersion returns the version stored in the metabase.
+// The flag is false for a blank metabase.
+func (db *DB) storedVersion(tx *bbolt.Tx) (uint64, bool, error) {
+	b := tx.Bucket(shardInfoBucket)
+	if b != nil {
+		if data := b.Get(versionKey); len(data) == 8 {
+			return binary.LittleEndian.Uint64(data), true, nil
+		}
+	}
+
+	if db.initialized {
+		return 0, false, ErrOutdatedVersion
+	}
+	return 0, false, nil
+}
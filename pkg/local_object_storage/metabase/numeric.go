@@ -0,0 +1,262 @@
+package meta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// numericValueSize is a size of the sortable representation of the integer.
+const numericValueSize = 1 + 8
+
+// encodeNumeric returns the representation of the decimal integer in which
+// the lexicographic order of the values matches their numeric order.
+// Integers from the minimum int64 to the maximum uint64 are supported.
+//
+// The first byte is 0 for the negative values and 1 otherwise, it is
+// followed by the big-endian two's complement of the value.
+func encodeNumeric(s string) ([]byte, bool) {
+	res := make([]byte, numericValueSize)
+
+	if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+		res[0] = 1
+		binary.BigEndian.PutUint64(res[1:], u)
+		return res, true
+	}
+
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	if n >= 0 {
+		res[0] = 1
+	}
+
+	binary.BigEndian.PutUint64(res[1:], uint64(n))
+
+	return res, true
+}
+
+// numericIndexBucketName returns <CID>_numeric_<attribute key>.
+func numericIndexBucketName(cnr cid.ID, attributeKey string, key []byte) []byte {
+	key[0] = numericIndexPrefix
+	cnr.Encode(key[1:])
+	return append(key[:bucketKeySize], attributeKey...)
+}
+
+// updateNumericIndexes calls f for the numeric index items of the object:
+// creation epoch, payload length and the indexed user attributes with integer
+// values.
+func updateNumericIndexes(tx *bbolt.Tx, obj *objectSDK.Object, indexes indexSet, f updateIndexItemFunc) error {
+	id, _ := obj.ID()
+	cnr, _ := obj.ContainerID()
+	objKey := objectKey(id, make([]byte, objectKeySize))
+
+	updateItem := func(hdr string, val []byte) error {
+		return f(tx, namedBucketItem{
+			name: numericIndexBucketName(cnr, hdr, make([]byte, bucketKeySize)),
+			key:  append(val, objKey...),
+			val:  zeroValue,
+		})
+	}
+
+	numeric := func(u uint64) []byte {
+		val := make([]byte, numericValueSize)
+		val[0] = 1
+		binary.BigEndian.PutUint64(val[1:], u)
+		return val
+	}
+
+	err := updateItem(v2object.FilterHeaderCreationEpoch, numeric(obj.CreationEpoch()))
+	if err != nil {
+		return err
+	}
+
+	err = updateItem(v2object.FilterHeaderPayloadLength, numeric(obj.PayloadSize()))
+	if err != nil {
+		return err
+	}
+
+	attrs := obj.Attributes()
+	for i := range attrs {
+		if !indexes.has(attrs[i].Key(), indexMaskNumeric) {
+			continue
+		}
+
+		val, ok := encodeNumeric(attrs[i].Value())
+		if !ok {
+			continue
+		}
+
+		err = updateItem(attrs[i].Key(), val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func putNumericIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
+	return putUniqueIndexItem(tx, item)
+}
+
+func delNumericIndexItem(tx *bbolt.Tx, item namedBucketItem) error {
+	delUniqueIndexItem(tx, item)
+	return nil
+}
+
+// selectFromNumericIndex looks into <numeric> index to find the objects
+// with the header values in the range specified by the numeric filter.
+func (db *DB) selectFromNumericIndex(
+	tx *bbolt.Tx,
+	name []byte, // numeric index bucket name
+	f objectSDK.SearchFilter, // numeric filter
+	to map[string]int, // resulting cache
+	fNum int, // index of filter
+) {
+	bkt := tx.Bucket(name)
+	if bkt == nil {
+		return
+	}
+
+	val, ok := encodeNumeric(f.Value())
+	if !ok {
+		db.log.Debug("non-integer value of the numeric filter",
+			zap.String("header", f.Header()),
+			zap.String("value", f.Value()))
+
+		return
+	}
+
+	var (
+		c = bkt.Cursor()
+		k []byte
+
+		// inRange checks whether the key is still in range,
+		// the keys are iterated in ascending order
+		inRange func(k []byte) bool
+	)
+
+	switch f.Operation() {
+	case objectcore.MatchNumGT:
+		k, _ = c.Seek(val)
+		for k != nil && bytes.HasPrefix(k, val) {
+			k, _ = c.Next()
+		}
+
+		inRange = func([]byte) bool { return true }
+	case objectcore.MatchNumGE:
+		k, _ = c.Seek(val)
+		inRange = func([]byte) bool { return true }
+	case objectcore.MatchNumLT:
+		k, _ = c.First()
+		inRange = func(k []byte) bool { return bytes.Compare(k[:numericValueSize], val) < 0 }
+	case objectcore.MatchNumLE:
+		k, _ = c.First()
+		inRange = func(k []byte) bool { return bytes.Compare(k[:numericValueSize], val) <= 0 }
+	default:
+		return
+	}
+
+	for ; k != nil && inRange(k); k, _ = c.Next() {
+		if len(k) != numericValueSize+objectKeySize {
+			continue
+		}
+
+		markAddressInCache(to, fNum, string(k[numericValueSize:]))
+	}
+}
+
+// numericMatcher returns matcher comparing the values as integers
+// with the comparison result check.
+func numericMatcher(check func(int) bool) matcher {
+	match := func(key string, objVal []byte, filterVal string) bool {
+		objNum, ok := encodeNumeric(stringifyValue(key, objVal))
+		if !ok {
+			return false
+		}
+
+		filterNum, ok := encodeNumeric(filterVal)
+		if !ok {
+			return false
+		}
+
+		return check(bytes.Compare(objNum, filterNum))
+	}
+
+	return matcher{
+		matchSlow: match,
+		matchBucket: func(b *bbolt.Bucket, fKey string, fValue string, f func([]byte, []byte) error) error {
+			return b.ForEach(func(k, v []byte) error {
+				if match(fKey, k, fValue) {
+					return f(k, v)
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// syncNumericIndexes builds the numeric indexes of all the stored objects.
+func syncNumericIndexes(tx *bbolt.Tx) error {
+	var (
+		cursor []byte
+		err    error
+	)
+
+	for {
+		cursor, err = migrateNumericIndexes(tx, cursor)
+		if err != nil || cursor == nil {
+			return err
+		}
+	}
+}
+
+// migrateNumericIndexes is a batched version of syncNumericIndexes, see migration.
+func migrateNumericIndexes(tx *bbolt.Tx, cursor []byte) ([]byte, error) {
+	objs, next, err := collectMigrationBatch(tx, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate objects: %w", err)
+	}
+
+	// index settings are read once per container bucket
+	cnrIndexes := make(map[cid.ID]indexSet)
+	for i := range objs {
+		if _, ok := cnrIndexes[objs[i].cnr]; ok {
+			continue
+		}
+
+		cnrIndexes[objs[i].cnr], err = putIndexes(tx, objs[i].cnr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range objs {
+		obj := objectSDK.New()
+		if err := obj.Unmarshal(objs[i].header); err != nil {
+			return nil, fmt.Errorf("could not unmarshal object: %w", err)
+		}
+
+		indexes := cnrIndexes[objs[i].cnr]
+
+		for _, hdr := range indexedHeaders(obj) {
+			err = updateNumericIndexes(tx, hdr, indexes, putNumericIndexItem)
+			if err != nil {
+				return nil, fmt.Errorf("could not index objects: %w", err)
+			}
+		}
+	}
+
+	return next, nil
+}
@@ -0,0 +1,351 @@
+package meta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.etcd.io/bbolt"
+)
+
+// ownerUsageBucketName stores the object counters of the object owners.
+var ownerUsageBucketName = []byte{ownerUsagePrefix}
+
+const ownerUsageValueSize = 4 * 8
+
+// OwnerUsage groups the counters of the objects of a single owner.
+// Logical counters include only available objects, physical ones
+// include all stored objects.
+type OwnerUsage struct {
+	owner user.ID
+
+	logicObjects, logicSize uint64
+	phyObjects, phySize     uint64
+}
+
+// NewOwnerUsage creates OwnerUsage of the owner with the given counters.
+func NewOwnerUsage(owner user.ID, logicObjects, logicSize, phyObjects, phySize uint64) OwnerUsage {
+	return OwnerUsage{
+		owner:        owner,
+		logicObjects: logicObjects,
+		logicSize:    logicSize,
+		phyObjects:   phyObjects,
+		phySize:      phySize,
+	}
+}
+
+// Owner returns the owner of the objects.
+func (u OwnerUsage) Owner() user.ID {
+	return u.owner
+}
+
+// LogicObjects returns the number of the available objects.
+func (u OwnerUsage) LogicObjects() uint64 {
+	return u.logicObjects
+}
+
+// LogicSize returns the total payload size of the available objects.
+func (u OwnerUsage) LogicSize() uint64 {
+	return u.logicSize
+}
+
+// PhyObjects returns the number of the physically stored objects.
+func (u OwnerUsage) PhyObjects() uint64 {
+	return u.phyObjects
+}
+
+// PhySize returns the total payload size of the physically stored objects.
+func (u OwnerUsage) PhySize() uint64 {
+	return u.phySize
+}
+
+// Add returns the sum of the u and x counters. The owner is taken from u.
+func (u OwnerUsage) Add(x OwnerUsage) OwnerUsage {
+	u.logicObjects += x.logicObjects
+	u.logicSize += x.logicSize
+	u.phyObjects += x.phyObjects
+	u.phySize += x.phySize
+	return u
+}
+
+// SortOwnerUsage sorts the usage by the physical size in descending order
+// and leaves at most limit items. Zero limit means no limit.
+func SortOwnerUsage(usage []OwnerUsage, limit int) []OwnerUsage {
+	sort.Slice(usage, func(i, j int) bool {
+		return usage[i].phySize > usage[j].phySize
+	})
+
+	if limit > 0 && len(usage) > limit {
+		usage = usage[:limit]
+	}
+
+	return usage
+}
+
+// OwnerUsagePrm groups the parameters of OwnerUsage operation.
+type OwnerUsagePrm struct {
+	limit int
+}
+
+// OwnerUsageRes groups the resulting values of OwnerUsage operation.
+type OwnerUsageRes struct {
+	usage []OwnerUsage
+}
+
+// SetLimit sets the maximum number of the owners to return.
+// Zero means no limit.
+func (p *OwnerUsagePrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// Usage returns the counters of the owners sorted by the physical
+// size in descending order.
+func (r OwnerUsageRes) Usage() []OwnerUsage {
+	return r.usage
+}
+
+// OwnerUsage returns the object counters of the owners with the
+// largest physical size of the stored objects.
+func (db *DB) OwnerUsage(prm OwnerUsagePrm) (res OwnerUsageRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(ownerUsageBucketName)
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			u, err := decodeOwnerUsage(k, v)
+			if err != nil {
+				return err
+			}
+
+			res.usage = append(res.usage, u)
+			return nil
+		})
+	})
+	if err != nil {
+		return OwnerUsageRes{}, err
+	}
+
+	res.usage = SortOwnerUsage(res.usage, prm.limit)
+
+	return res, nil
+}
+
+func decodeOwnerUsage(k, v []byte) (OwnerUsage, error) {
+	var (
+		u OwnerUsage
+		m refs.OwnerID
+	)
+
+	m.SetValue(k)
+	if err := u.owner.ReadFromV2(m); err != nil {
+		return OwnerUsage{}, fmt.Errorf("invalid owner in usage bucket: %w", err)
+	}
+
+	if len(v) != ownerUsageValueSize {
+		return OwnerUsage{}, fmt.Errorf("invalid usage of owner %s: length %d", u.owner, len(v))
+	}
+
+	u.logicObjects = binary.LittleEndian.Uint64(v)
+	u.logicSize = binary.LittleEndian.Uint64(v[8:])
+	u.phyObjects = binary.LittleEndian.Uint64(v[16:])
+	u.phySize = binary.LittleEndian.Uint64(v[24:])
+
+	return u, nil
+}
+
+// usageDelta describes the change of the owner counters.
+type usageDelta struct {
+	logic, phy bool
+}
+
+// changeOwnerUsage updates the counters of the object owner. If inc is true,
+// the counters are increased, decreased otherwise. Tx MUST be writable.
+func changeOwnerUsage(tx *bbolt.Tx, obj *objectSDK.Object, d usageDelta, inc bool) error {
+	owner := obj.OwnerID()
+	if owner == nil || !d.logic && !d.phy {
+		return nil
+	}
+
+	b := tx.Bucket(ownerUsageBucketName)
+	if b == nil {
+		return nil
+	}
+
+	key := owner.WalletBytes()
+
+	val := make([]byte, ownerUsageValueSize)
+	copy(val, b.Get(key))
+
+	update := func(off int, delta uint64) {
+		v := binary.LittleEndian.Uint64(val[off:])
+		if inc {
+			v += delta
+		} else if v > delta {
+			v -= delta
+		} else {
+			v = 0
+		}
+		binary.LittleEndian.PutUint64(val[off:], v)
+	}
+
+	size := obj.PayloadSize()
+	if d.logic {
+		update(0, 1)
+		update(8, size)
+	}
+	if d.phy {
+		update(16, 1)
+		update(24, size)
+	}
+
+	return b.Put(key, val)
+}
+
+// syncOwnerUsage recalculates the object counters of the owners
+// according to the metabase state. Tx MUST be writable.
+func syncOwnerUsage(tx *bbolt.Tx) error {
+	graveyardBKT := tx.Bucket(graveyardBucketName)
+	garbageBKT := tx.Bucket(garbageBucketName)
+	key := make([]byte, addressKeySize)
+
+	usage := make(map[string]OwnerUsage)
+
+	err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+		if len(name) != bucketKeySize {
+			return nil
+		}
+
+		switch name[0] {
+		case primaryPrefix, storageGroupPrefix, lockersPrefix, tombstonePrefix:
+		default:
+			return nil
+		}
+
+		return b.ForEach(func(k, v []byte) error {
+			copy(key, name[1:])
+			copy(key[cidSize:], k)
+
+			return accountOwnerUsage(usage, key, v, graveyardBKT, garbageBKT)
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("could not iterate objects: %w", err)
+	}
+
+	err = resetOwnerUsage(tx)
+	if err != nil {
+		return err
+	}
+
+	return addOwnerUsage(tx, usage)
+}
+
+// migrateOwnerUsage is a batched version of syncOwnerUsage, see migration.
+func migrateOwnerUsage(tx *bbolt.Tx, cursor []byte) ([]byte, error) {
+	if cursor == nil {
+		if err := resetOwnerUsage(tx); err != nil {
+			return nil, err
+		}
+	}
+
+	objs, next, err := collectMigrationBatch(tx, cursor)
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate objects: %w", err)
+	}
+
+	graveyardBKT := tx.Bucket(graveyardBucketName)
+	garbageBKT := tx.Bucket(garbageBucketName)
+	usage := make(map[string]OwnerUsage)
+
+	for i := range objs {
+		err = accountOwnerUsage(usage, objs[i].key, objs[i].header, graveyardBKT, garbageBKT)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return next, addOwnerUsage(tx, usage)
+}
+
+// accountOwnerUsage adds the object with the given address key
+// and the header to the owner counters.
+func accountOwnerUsage(usage map[string]OwnerUsage, key, header []byte, graveyardBKT, garbageBKT *bbolt.Bucket) error {
+	obj := objectSDK.New()
+	if err := obj.Unmarshal(header); err != nil {
+		return fmt.Errorf("could not unmarshal object: %w", err)
+	}
+
+	owner := obj.OwnerID()
+	if owner == nil {
+		return nil
+	}
+
+	strOwner := string(owner.WalletBytes())
+	u := usage[strOwner]
+
+	size := obj.PayloadSize()
+	if inGraveyardWithKey(key, graveyardBKT, garbageBKT) == 0 {
+		u.logicObjects++
+		u.logicSize += size
+	}
+	u.phyObjects++
+	u.phySize += size
+
+	usage[strOwner] = u
+
+	return nil
+}
+
+func resetOwnerUsage(tx *bbolt.Tx) error {
+	err := tx.DeleteBucket(ownerUsageBucketName)
+	if err != nil && !errors.Is(err, bbolt.ErrBucketNotFound) {
+		return fmt.Errorf("could not remove owner usage bucket: %w", err)
+	}
+
+	_, err = tx.CreateBucket(ownerUsageBucketName)
+	if err != nil {
+		return fmt.Errorf("could not create owner usage bucket: %w", err)
+	}
+
+	return nil
+}
+
+// addOwnerUsage adds the counters to the stored ones.
+func addOwnerUsage(tx *bbolt.Tx, usage map[string]OwnerUsage) error {
+	b := tx.Bucket(ownerUsageBucketName)
+
+	for owner, u := range usage {
+		val := make([]byte, ownerUsageValueSize)
+		if old := b.Get([]byte(owner)); len(old) == ownerUsageValueSize {
+			copy(val, old)
+		}
+
+		add := func(off int, v uint64) {
+			binary.LittleEndian.PutUint64(val[off:], binary.LittleEndian.Uint64(val[off:])+v)
+		}
+		add(0, u.logicObjects)
+		add(8, u.logicSize)
+		add(16, u.phyObjects)
+		add(24, u.phySize)
+
+		err := b.Put([]byte(owner), val)
+		if err != nil {
+			return fmt.Errorf("could not put owner usage: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package meta_test
+
+import (
+	"testing"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_OwnerUsage(t *testing.T) {
+	db := newDB(t)
+
+	owners := []user.ID{*usertest.ID(), *usertest.ID()}
+
+	var objs []*object.Object
+	for i, owner := range owners {
+		for j := 0; j < 3; j++ {
+			obj := generateObject(t)
+			obj.SetOwnerID(&owner)
+			obj.SetPayloadSize(uint64(100 * (i + 1)))
+
+			require.NoError(t, putBig(db, obj))
+			objs = append(objs, obj)
+		}
+	}
+
+	checkUsage := func(t *testing.T, limit int, exp ...meta.OwnerUsage) {
+		var prm meta.OwnerUsagePrm
+		prm.SetLimit(limit)
+
+		res, err := db.OwnerUsage(prm)
+		require.NoError(t, err)
+		require.Equal(t, exp, res.Usage())
+	}
+
+	checkUsage(t, 0,
+		meta.NewOwnerUsage(owners[1], 3, 600, 3, 600),
+		meta.NewOwnerUsage(owners[0], 3, 300, 3, 300))
+	checkUsage(t, 1,
+		meta.NewOwnerUsage(owners[1], 3, 600, 3, 600))
+
+	// inhumed object is still stored physically
+	tomb := objectcore.AddressOf(generateObject(t))
+	require.NoError(t, metaInhume(db, objectcore.AddressOf(objs[3]), tomb))
+
+	// repeated inhume doesn't change the counters
+	require.NoError(t, metaInhume(db, objectcore.AddressOf(objs[3]), tomb))
+
+	checkUsage(t, 0,
+		meta.NewOwnerUsage(owners[1], 2, 400, 3, 600),
+		meta.NewOwnerUsage(owners[0], 3, 300, 3, 300))
+
+	// both counters are decreased for available objects
+	require.NoError(t, metaDelete(db, objectcore.AddressOf(objs[0]), objectcore.AddressOf(objs[3])))
+
+	exp := []meta.OwnerUsage{
+		meta.NewOwnerUsage(owners[1], 2, 400, 2, 400),
+		meta.NewOwnerUsage(owners[0], 2, 200, 2, 200),
+	}
+	checkUsage(t, 0, exp...)
+
+	// deletion of a missing object is a no-op
+	addr := objectcore.AddressOf(objs[0])
+	addr.SetObject(oidtest.ID())
+	require.NoError(t, metaDelete(db, addr))
+	checkUsage(t, 0, exp...)
+
+	t.Run("sync", func(t *testing.T) {
+		require.NoError(t, db.SyncCounters())
+		checkUsage(t, 0, exp...)
+	})
+}
@@ -30,7 +30,17 @@ type PutPrm struct {
 }
 
 // PutRes groups the resulting values of Put operation.
-type PutRes struct{}
+type PutRes struct {
+	corrupted   bool
+	corruptedID []byte
+}
+
+// CorruptedCopy returns the storage ID of the corrupted physical copy
+// replaced by the put object. The flag is false if the object has not
+// been marked as corrupted.
+func (r PutRes) CorruptedCopy() ([]byte, bool) {
+	return r.corruptedID, r.corrupted
+}
 
 // SetObject is a Put option to set object to save.
 func (p *PutPrm) SetObject(obj *objectSDK.Object) {
@@ -65,6 +75,19 @@ func (db *DB) Put(prm PutPrm) (res PutRes, err error) {
 	currEpoch := db.epochState.CurrentEpoch()
 
 	err = db.boltDB.Batch(func(tx *bbolt.Tx) error {
+		res = PutRes{}
+
+		addr := objectCore.AddressOf(prm.obj)
+		if isCorrupted(tx, addressKey(addr, make([]byte, addressKeySize))) {
+			id, err := db.storageID(tx, addr)
+			if err != nil {
+				return err
+			}
+
+			res.corrupted = true
+			res.corruptedID = id
+		}
+
 		return db.put(tx, prm.obj, prm.id, nil, currEpoch)
 	})
 	if err == nil {
@@ -86,6 +109,14 @@ func (db *DB) put(
 
 	isParent := si != nil
 
+	if !isParent {
+		// healthy copy of the corrupted object has been stored again
+		healed, err := healCorrupted(tx, object.AddressOf(obj), id)
+		if healed || err != nil {
+			return err
+		}
+	}
+
 	exists, err := db.exists(tx, object.AddressOf(obj), currEpoch)
 
 	if errors.As(err, &splitInfoError) {
@@ -135,11 +166,21 @@ func (db *DB) put(
 		return fmt.Errorf("can't put list indexes: %w", err)
 	}
 
-	err = updateFKBTIndexes(tx, obj, putFKBTIndexItem)
+	indexes, err := putIndexes(tx, cnr)
+	if err != nil {
+		return err
+	}
+
+	err = updateFKBTIndexes(tx, obj, indexes, putFKBTIndexItem)
 	if err != nil {
 		return fmt.Errorf("can't put fake bucket tree indexes: %w", err)
 	}
 
+	err = updateNumericIndexes(tx, obj, indexes, putNumericIndexItem)
+	if err != nil {
+		return fmt.Errorf("can't put numeric indexes: %w", err)
+	}
+
 	// update container volume size estimation
 	if obj.Type() == objectSDK.TypeRegular && !isParent {
 		err = changeContainerSize(tx, cnr, obj.PayloadSize(), true)
@@ -160,6 +201,16 @@ func (db *DB) put(
 		if err != nil {
 			return fmt.Errorf("could not increase logical object counter: %w", err)
 		}
+
+		err = changeOwnerUsage(tx, obj, usageDelta{logic: true, phy: true}, true)
+		if err != nil {
+			return fmt.Errorf("could not increase owner usage: %w", err)
+		}
+
+		err = logChange(tx, object.AddressOf(obj), ChangePut, nil)
+		if err != nil {
+			return fmt.Errorf("could not log object put: %w", err)
+		}
 	}
 
 	return nil
@@ -295,7 +346,7 @@ func updateListIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 	return nil
 }
 
-func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFunc) error {
+func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, indexes indexSet, f updateIndexItemFunc) error {
 	id, _ := obj.ID()
 	cnr, _ := obj.ContainerID()
 	objKey := objectKey(id, make([]byte, objectKeySize))
@@ -314,6 +365,10 @@ func updateFKBTIndexes(tx *bbolt.Tx, obj *objectSDK.Object, f updateIndexItemFun
 
 	// user specified attributes
 	for i := range attrs {
+		if !indexes.has(attrs[i].Key(), indexMaskFKBT) {
+			continue
+		}
+
 		key = attributeBucketName(cnr, attrs[i].Key(), key)
 		err := f(tx, namedBucketItem{
 			name: key,
@@ -1,12 +1,15 @@
 package meta
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -32,11 +35,15 @@ type (
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
 type SelectRes struct {
 	addrList []oid.Address
+	cursor   *oid.ID
 }
 
 // SetContainerID is a Select option to set the container id to search in.
@@ -49,11 +56,71 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetCursor is a Select option to resume the selection after the object
+// with the specified ID. Nil cursor starts the selection from the beginning.
+//
+// If the cursor or the limit is set, objects are selected in ascending
+// order of their IDs.
+func (p *SelectPrm) SetCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// SetLimit is a Select option to set the maximum number of the selected
+// objects. Zero limit means no limit.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = limit
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
 }
 
+// Cursor returns the cursor to continue the selection with. Returns nil
+// if the limit was not reached, so there are no more objects to select.
+func (r SelectRes) Cursor() *oid.ID {
+	return r.cursor
+}
+
+// selectPage groups the pagination parameters of the selection.
+type selectPage struct {
+	// cursor is a raw ID of the last object of the previous
+	// page, nil for the first page
+	cursor []byte
+	// limit is the maximum number of objects in the page,
+	// zero means no limit
+	limit int
+}
+
+func newSelectPage(cursor *oid.ID, limit uint32) selectPage {
+	var p selectPage
+
+	if cursor != nil {
+		p.cursor = make([]byte, objectKeySize)
+		cursor.Encode(p.cursor)
+	}
+
+	p.limit = int(limit)
+
+	return p
+}
+
+// paged checks whether the objects must be selected
+// in ascending order of their IDs.
+func (p selectPage) paged() bool {
+	return p.cursor != nil || p.limit > 0
+}
+
+// full checks whether the page of n objects is full.
+func (p selectPage) full(n int) bool {
+	return p.limit > 0 && n >= p.limit
+}
+
+// after checks whether the raw object ID follows the cursor.
+func (p selectPage) after(id []byte) bool {
+	return p.cursor == nil || bytes.Compare(id, p.cursor) > 0
+}
+
 // Select returns list of addresses of objects that match search filters.
 func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	db.modeMtx.RLock()
@@ -68,16 +135,32 @@ func (db *DB) Select(prm SelectPrm) (res SelectRes, err error) {
 	}
 
 	currEpoch := db.epochState.CurrentEpoch()
+	page := newSelectPage(prm.cursor, prm.limit)
 
-	return res, db.boltDB.View(func(tx *bbolt.Tx) error {
-		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch)
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.addrList, err = db.selectObjects(tx, prm.cnr, prm.filters, currEpoch, page)
 
 		return err
 	})
+	if err != nil {
+		return res, err
+	}
+
+	if n := len(res.addrList); n > 0 && page.full(n) {
+		id := res.addrList[n-1].Object()
+		res.cursor = &id
+	}
+
+	return res, nil
 }
 
-func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64) ([]oid.Address, error) {
-	group, err := groupFilters(fs)
+func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64, page selectPage) ([]oid.Address, error) {
+	indexes, err := selectIndexes(tx, cnr)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := groupFilters(fs, indexes)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +171,10 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		return nil, nil
 	}
 
+	if len(group.fastFilters) == 0 && page.paged() {
+		return db.selectAllPaged(tx, cnr, group.slowFilters, currEpoch, page)
+	}
+
 	// keep matched addresses in this cache
 	// value equal to number (index+1) of latest matched filter
 	mAddr := make(map[string]int)
@@ -104,32 +191,141 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, c
 		}
 	}
 
-	res := make([]oid.Address, 0, len(mAddr))
+	ids := make([]string, 0, len(mAddr))
 
 	for a, ind := range mAddr {
 		if ind != expLen {
 			continue // ignore objects with unmatched fast filters
 		}
 
-		var id oid.ID
-		err = id.Decode([]byte(a))
+		if !page.after([]byte(a)) {
+			continue // ignore objects of the previous pages
+		}
+
+		ids = append(ids, a)
+	}
+
+	if page.paged() {
+		sort.Strings(ids)
+	}
+
+	res := make([]oid.Address, 0, len(ids))
+
+	for i := range ids {
+		addr, ok, err := db.matchObject(tx, cnr, []byte(ids[i]), group.slowFilters, currEpoch)
 		if err != nil {
 			return nil, err
 		}
 
-		var addr oid.Address
-		addr.SetContainer(cnr)
-		addr.SetObject(id)
+		if !ok {
+			continue
+		}
+
+		res = append(res, addr)
+
+		if page.full(len(res)) {
+			break
+		}
+	}
+
+	return res, nil
+}
+
+// matchObject checks whether the object with the raw ID is available
+// and matches the slow filters.
+func (db *DB) matchObject(tx *bbolt.Tx, cnr cid.ID, rawID []byte, fs object.SearchFilters, currEpoch uint64) (oid.Address, bool, error) {
+	var addr oid.Address
+
+	var id oid.ID
+	if err := id.Decode(rawID); err != nil {
+		return addr, false, err
+	}
+
+	addr.SetContainer(cnr)
+	addr.SetObject(id)
+
+	if objectStatus(tx, addr, currEpoch) > 0 {
+		return addr, false, nil // ignore removed objects
+	}
+
+	if !db.matchSlowFilters(tx, addr, fs, currEpoch) {
+		return addr, false, nil // ignore objects with unmatched slow filters
+	}
+
+	return addr, true, nil
+}
+
+// selectAllPaged selects the page of all available objects matching the slow
+// filters in ascending order of their IDs. Unlike selectAll, it doesn't read
+// the objects outside the page.
+func (db *DB) selectAllPaged(tx *bbolt.Tx, cnr cid.ID, fs object.SearchFilters, currEpoch uint64, page selectPage) ([]oid.Address, error) {
+	bucketNames := []func(cid.ID, []byte) []byte{
+		primaryBucketName,
+		tombstoneBucketName,
+		storageGroupBucketName,
+		parentBucketName,
+		bucketNameLockers,
+	}
 
-		if objectStatus(tx, addr, currEpoch) > 0 {
-			continue // ignore removed objects
+	// the keys of each bucket are sorted, so the objects are selected
+	// in order by merging the bucket cursors
+	var (
+		cursors = make([]*bbolt.Cursor, 0, len(bucketNames))
+		keys    = make([][]byte, 0, len(bucketNames))
+	)
+
+	for i := range bucketNames {
+		bkt := tx.Bucket(bucketNames[i](cnr, make([]byte, bucketKeySize)))
+		if bkt == nil {
+			continue
 		}
 
-		if !db.matchSlowFilters(tx, addr, group.slowFilters, currEpoch) {
-			continue // ignore objects with unmatched slow filters
+		c := bkt.Cursor()
+
+		var k []byte
+		if page.cursor == nil {
+			k, _ = c.First()
+		} else {
+			k, _ = c.Seek(page.cursor)
+			if k != nil && bytes.Equal(k, page.cursor) {
+				k, _ = c.Next()
+			}
 		}
 
-		res = append(res, addr)
+		cursors = append(cursors, c)
+		keys = append(keys, k)
+	}
+
+	var res []oid.Address
+
+	for !page.full(len(res)) {
+		var minKey []byte
+		for i := range keys {
+			if keys[i] != nil && (minKey == nil || bytes.Compare(keys[i], minKey) < 0) {
+				minKey = keys[i]
+			}
+		}
+
+		if minKey == nil {
+			break
+		}
+
+		addr, ok, err := db.matchObject(tx, cnr, minKey, fs, currEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			res = append(res, addr)
+		}
+
+		// move all the cursors pointing to the selected object,
+		// keys stay valid for the life of the transaction
+		for i := range keys {
+			if keys[i] != nil && bytes.Equal(keys[i], minKey) {
+				keys[i], _ = cursors[i].Next()
+			}
+		}
 	}
 
 	return res, nil
@@ -192,6 +388,9 @@ func (db *DB) selectFastFilter(
 		db.selectFromList(tx, bucketName, f, to, fNum)
 	case v2object.FilterPropertyRoot:
 		selectAllFromBucket(tx, rootBucketName(cnr, bucketName), to, fNum)
+	case v2object.FilterHeaderCreationEpoch, v2object.FilterHeaderPayloadLength:
+		// only numeric filters are processed as the fast ones, see groupFilters
+		db.selectFromNumericIndex(tx, numericIndexBucketName(cnr, f.Header(), bucketName), f, to, fNum)
 	case v2object.FilterPropertyPhy:
 		selectAllFromBucket(tx, primaryBucketName(cnr, bucketName), to, fNum)
 		selectAllFromBucket(tx, tombstoneBucketName(cnr, bucketName), to, fNum)
@@ -200,7 +399,9 @@ func (db *DB) selectFastFilter(
 	default: // user attribute
 		bucketName := attributeBucketName(cnr, f.Header(), bucketName)
 
-		if f.Operation() == object.MatchNotPresent {
+		if objectcore.IsNumericMatcher(f.Operation()) {
+			db.selectFromNumericIndex(tx, numericIndexBucketName(cnr, f.Header(), bucketName), f, to, fNum)
+		} else if f.Operation() == object.MatchNotPresent {
 			selectOutsideFKBT(tx, allBucketNames(cnr), bucketName, to, fNum)
 		} else {
 			db.selectFromFKBT(tx, bucketName, f, to, fNum)
@@ -472,6 +673,15 @@ func (db *DB) matchSlowFilters(tx *bbolt.Tx, addr oid.Address, f object.SearchFi
 	}
 
 	for i := range f {
+		if !isSystemKey(f[i].Header()) {
+			// user attributes are matched here if they are not indexed
+			if !db.matchAttribute(obj, f[i]) {
+				return false
+			}
+
+			continue
+		}
+
 		matchFunc, ok := db.matchers[f[i].Operation()]
 		if !ok {
 			return false
@@ -503,10 +713,31 @@ func (db *DB) matchSlowFilters(tx *bbolt.Tx, addr oid.Address, f object.SearchFi
 	return true
 }
 
+// matchAttribute checks whether the object attribute matches the filter.
+func (db *DB) matchAttribute(obj *object.Object, f object.SearchFilter) bool {
+	attrs := obj.Attributes()
+
+	for i := range attrs {
+		if attrs[i].Key() != f.Header() {
+			continue
+		}
+
+		if f.Operation() == object.MatchNotPresent {
+			return false
+		}
+
+		matchFunc, ok := db.matchers[f.Operation()]
+
+		return ok && matchFunc.matchSlow(f.Header(), []byte(attrs[i].Value()), f.Value())
+	}
+
+	return f.Operation() == object.MatchNotPresent
+}
+
 // groupFilters divides filters in two groups: fast and slow. Fast filters
 // processed by indexes and slow filters processed after by unmarshaling
-// object headers.
-func groupFilters(filters object.SearchFilters) (filterGroup, error) {
+// object headers. User attributes missing in the indexes are slow filters.
+func groupFilters(filters object.SearchFilters, indexes indexSet) (filterGroup, error) {
 	res := filterGroup{
 		fastFilters: make(object.SearchFilters, 0, len(filters)),
 		slowFilters: make(object.SearchFilters, 0, len(filters)),
@@ -521,13 +752,24 @@ func groupFilters(filters object.SearchFilters) (filterGroup, error) {
 			}
 
 			res.withCnrFilter = true
+		case // numeric filters use sortable indexes
+			v2object.FilterHeaderCreationEpoch,
+			v2object.FilterHeaderPayloadLength:
+			if objectcore.IsNumericMatcher(filters[i].Operation()) {
+				res.fastFilters = append(res.fastFilters, filters[i])
+			} else {
+				res.slowFilters = append(res.slowFilters, filters[i])
+			}
 		case // slow filters
 			v2object.FilterHeaderVersion,
-			v2object.FilterHeaderCreationEpoch,
-			v2object.FilterHeaderPayloadLength,
 			v2object.FilterHeaderHomomorphicHash:
 			res.slowFilters = append(res.slowFilters, filters[i])
 		default: // fast filters or user attributes if unknown
+			if !isSystemKey(filters[i].Header()) && !indexes.has(filters[i].Header(), filterIndexMask(filters[i])) {
+				res.slowFilters = append(res.slowFilters, filters[i])
+				break
+			}
+
 			res.fastFilters = append(res.fastFilters, filters[i])
 		}
 	}
@@ -535,6 +777,15 @@ func groupFilters(filters object.SearchFilters) (filterGroup, error) {
 	return res, nil
 }
 
+// filterIndexMask returns the kind of the attribute index the filter is processed with.
+func filterIndexMask(f object.SearchFilter) indexMask {
+	if objectcore.IsNumericMatcher(f.Operation()) {
+		return indexMaskNumeric
+	}
+
+	return indexMaskFKBT
+}
+
 func markAddressInCache(cache map[string]int, fNum int, addr string) {
 	if num := cache[addr]; num == fNum {
 		cache[addr] = num + 1
@@ -1,7 +1,9 @@
 package meta_test
 
 import (
+	"bytes"
 	"encoding/hex"
+	"sort"
 	"strconv"
 	"testing"
 
@@ -805,6 +807,206 @@ func BenchmarkSelect(b *testing.B) {
 	})
 }
 
+func TestDB_SelectPaged(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	const objCount = 10
+
+	var all, withAttr []oid.Address
+
+	for i := 0; i < objCount; i++ {
+		obj := generateObjectWithCID(t, cnr)
+		if i%2 == 0 {
+			addAttribute(obj, "foo", "bar")
+			withAttr = append(withAttr, object.AddressOf(obj))
+		}
+
+		require.NoError(t, putBig(db, obj))
+		all = append(all, object.AddressOf(obj))
+	}
+
+	// removed objects are skipped
+	ts := generateObjectWithCID(t, cnr)
+	require.NoError(t, metaInhume(db, all[1], object.AddressOf(ts)))
+	all = append(all[:1], all[2:]...)
+
+	sortAddrs := func(addrs []oid.Address) {
+		sort.Slice(addrs, func(i, j int) bool {
+			a, b := addrs[i].Object(), addrs[j].Object()
+			return bytes.Compare(a[:], b[:]) < 0
+		})
+	}
+
+	sortAddrs(all)
+	sortAddrs(withAttr)
+
+	selectAll := func(t *testing.T, fs objectSDK.SearchFilters, limit uint32) []oid.Address {
+		var (
+			prm meta.SelectPrm
+			res []oid.Address
+		)
+
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+		prm.SetLimit(limit)
+
+		for {
+			r, err := db.Select(prm)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(r.AddressList()), int(limit))
+
+			res = append(res, r.AddressList()...)
+
+			if r.Cursor() == nil {
+				return res
+			}
+
+			prm.SetCursor(r.Cursor())
+		}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		require.Equal(t, all, selectAll(t, nil, 2))
+		require.Equal(t, all, selectAll(t, nil, 3))
+		require.Equal(t, all, selectAll(t, nil, objCount))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+
+		require.Equal(t, withAttr, selectAll(t, fs, 2))
+	})
+
+	t.Run("cursor without limit", func(t *testing.T) {
+		var prm meta.SelectPrm
+		prm.SetContainerID(cnr)
+
+		cursor := all[2].Object()
+		prm.SetCursor(&cursor)
+
+		res, err := db.Select(prm)
+		require.NoError(t, err)
+		require.Equal(t, all[3:], res.AddressList())
+		require.Nil(t, res.Cursor())
+	})
+}
+
+func TestDB_SelectNumeric(t *testing.T) {
+	db := newDB(t)
+
+	cnr := cidtest.ID()
+
+	newObject := func(timestamp string, epoch, size uint64) *objectSDK.Object {
+		obj := generateObjectWithCID(t, cnr)
+		addAttribute(obj, "Timestamp", timestamp)
+		obj.SetCreationEpoch(epoch)
+		obj.SetPayloadSize(size)
+
+		require.NoError(t, putBig(db, obj))
+
+		return obj
+	}
+
+	raw1 := newObject("-5", 10, 100)
+	raw2 := newObject("10", 20, 200)
+	raw3 := newObject("20", 30, 300)
+	raw4 := newObject("abc", 40, 400)
+
+	fs := objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "10", object.MatchNumGT)
+	testSelect(t, db, cnr, fs, object.AddressOf(raw3))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "10", object.MatchNumGE)
+	testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw3))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "10", object.MatchNumLT)
+	testSelect(t, db, cnr, fs, object.AddressOf(raw1))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "10", object.MatchNumLE)
+	testSelect(t, db, cnr, fs, object.AddressOf(raw1), object.AddressOf(raw2))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "-6", object.MatchNumGT)
+	fs.AddFilter("Timestamp", "0", object.MatchNumLT)
+	testSelect(t, db, cnr, fs, object.AddressOf(raw1))
+
+	fs = objectSDK.SearchFilters{}
+	fs.AddFilter("Timestamp", "abc", object.MatchNumGE)
+	testSelect(t, db, cnr, fs)
+
+	t.Run("system headers", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "20", object.MatchNumGE)
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "40", object.MatchNumLT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw3))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderPayloadLength, "300", object.MatchNumGT)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw4))
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderPayloadLength, "300", object.MatchNumLE)
+		fs.AddFilter("Timestamp", "0", object.MatchNumGE)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw3))
+
+		// string matchers are still processed by the slow filters
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderPayloadLength, "400", objectSDK.MatchStringEqual)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw4))
+	})
+
+	t.Run("v2 filters", func(t *testing.T) {
+		fromV2 := func(m objectSDK.SearchMatchType) []v2object.SearchFilter {
+			var f v2object.SearchFilter
+			f.SetKey("Timestamp")
+			f.SetValue("10")
+			f.SetMatchType(v2object.MatchType(m))
+
+			return []v2object.SearchFilter{f}
+		}
+
+		for _, tc := range []struct {
+			m   objectSDK.SearchMatchType
+			exp []*objectSDK.Object
+		}{
+			{m: object.MatchNumGT, exp: []*objectSDK.Object{raw3}},
+			{m: object.MatchNumGE, exp: []*objectSDK.Object{raw2, raw3}},
+			{m: object.MatchNumLT, exp: []*objectSDK.Object{raw1}},
+			{m: object.MatchNumLE, exp: []*objectSDK.Object{raw1, raw2}},
+		} {
+			exp := make([]oid.Address, 0, len(tc.exp))
+			for i := range tc.exp {
+				exp = append(exp, object.AddressOf(tc.exp[i]))
+			}
+
+			fs := object.SearchFiltersFromV2(fromV2(tc.m))
+			require.Equal(t, tc.m, fs[0].Operation())
+			testSelect(t, db, cnr, fs, exp...)
+
+			// SDK conversion loses the numeric matchers
+			testSelect(t, db, cnr, objectSDK.NewSearchFiltersFromV2(fromV2(tc.m)))
+		}
+	})
+
+	t.Run("removed object", func(t *testing.T) {
+		require.NoError(t, metaDelete(db, object.AddressOf(raw3)))
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("Timestamp", "10", object.MatchNumGT)
+		testSelect(t, db, cnr, fs)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderCreationEpoch, "20", object.MatchNumGE)
+		testSelect(t, db, cnr, fs, object.AddressOf(raw2), object.AddressOf(raw4))
+	})
+}
+
 func TestExpiredObjects(t *testing.T) {
 	db := newDB(t, meta.WithEpochState(epochState{currEpoch}))
 
@@ -0,0 +1,52 @@
+package meta
+
+import (
+	"go.etcd.io/bbolt"
+)
+
+// SnapshotPrm groups the parameters of Snapshot operation.
+type SnapshotPrm struct {
+	path string
+}
+
+// SnapshotRes groups the resulting values of Snapshot operation.
+type SnapshotRes struct {
+	cursor uint64
+}
+
+// SetPath is a Snapshot option to set the path of the snapshot file.
+// If the path is empty, no copy is written and only the cursor is returned.
+func (p *SnapshotPrm) SetPath(path string) {
+	p.path = path
+}
+
+// Cursor returns the identifier of the metabase state the snapshot
+// was taken at. It increases with every metabase modification.
+func (r SnapshotRes) Cursor() uint64 {
+	return r.cursor
+}
+
+// Snapshot writes a consistent copy of the metabase to the file at the
+// provided path. The copy can be opened as a separate read-only metabase
+// instance, allowing long-running operations to observe the metabase state
+// at a point in time without holding a transaction.
+//
+// Existing file at the provided path is overwritten.
+func (db *DB) Snapshot(prm SnapshotPrm) (res SnapshotRes, err error) {
+	db.modeMtx.RLock()
+	defer db.modeMtx.RUnlock()
+
+	if db.mode.NoMetabase() {
+		return res, ErrDegradedMode
+	}
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		res.cursor = uint64(tx.ID())
+		if prm.path == "" {
+			return nil
+		}
+		return tx.CopyFile(prm.path, db.info.Permission)
+	})
+
+	return res, err
+}
@@ -111,6 +111,76 @@ const (
 	//  Key: split ID
 	//  Value: list of object IDs
 	splitPrefix
+
+	//=================
+	// Change log.
+	//=================
+
+	// changeLogPrefix is used for the bucket containing metabase modifications.
+	//  Key: transaction ID as big-endian uint64 + object address
+	//  Value: change type, followed by the tombstone address for inhumed objects
+	changeLogPrefix
+
+	// corruptedPrefix is used for the bucket containing objects with corrupted physical copies.
+	//  Key: object address
+	//  Value: reason of the corruption
+	corruptedPrefix
+
+	//=================
+	// Payload deduplication.
+	//=================
+
+	// payloadPrefix is used for the bucket containing deduplicated payloads.
+	//  Key: payload SHA256 hash
+	//  Value: 0xFF byte followed by the storage ID of the payload
+	payloadPrefix
+
+	// payloadRefPrefix is used for the bucket containing references to the deduplicated payloads.
+	//  Key: payload SHA256 hash + object address
+	//  Value: dummy value
+	payloadRefPrefix
+
+	// objectPayloadPrefix is used for the bucket mapping objects to their deduplicated payloads.
+	//  Key: object address
+	//  Value: payload SHA256 hash
+	objectPayloadPrefix
+
+	//=================
+	// Tiering.
+	//=================
+
+	// accessPrefix is used for the bucket containing the last access epochs of the objects.
+	//  Key: object address
+	//  Value: epoch as little-endian uint64
+	accessPrefix
+
+	//=================
+	// Usage accounting.
+	//=================
+
+	// ownerUsagePrefix is used for the bucket containing object counters of the owners.
+	//  Key: owner ID
+	//  Value: logical objects, logical payload size, physical objects and physical
+	//  payload size as little-endian uint64 values
+	ownerUsagePrefix
+
+	//=================
+	// Numeric index buckets.
+	//=================
+
+	// numericIndexPrefix is used for prefixing buckets indexing integer header values.
+	//  Key: sortable integer representation + object ID
+	//  Value: dummy value
+	numericIndexPrefix
+
+	//=================
+	// Index settings.
+	//=================
+
+	// indexSettingsPrefix is used for the bucket containing attribute index settings of the containers.
+	//  Key: container ID
+	//  Value: applied and pending attribute indexes, build cursor
+	indexSettingsPrefix
 )
 
 const (
@@ -9,13 +9,13 @@ import (
 )
 
 // version contains current metabase version.
-const version = 2
+const version = 5
 
 var versionKey = []byte("version")
 
 // ErrOutdatedVersion is returned on initializing
 // an existing metabase that is not compatible with
-// the current code version.
+// the current code version and can't be migrated.
 var ErrOutdatedVersion = logicerr.New("invalid version, resynchronization is required")
 
 func checkVersion(tx *bbolt.Tx, initialized bool) error {
@@ -7,6 +7,12 @@ import (
 	"path/filepath"
 	"testing"
 
+	checksumtest "github.com/nspcc-dev/neofs-sdk-go/checksum/test"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/user"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 	"go.etcd.io/bbolt"
 )
@@ -84,4 +90,162 @@ func TestVersion(t *testing.T) {
 			require.NoError(t, db.Close())
 		})
 	})
+	t.Run("migration", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			return updateVersion(tx, version-2)
+		}))
+
+		v, err := db.Version()
+		require.NoError(t, err)
+		require.Equal(t, uint64(version-2), v)
+
+		var applied []uint64
+		errStep := errors.New("step failed")
+		fail := true
+
+		defer func(m map[uint64]migration) { migrations = m }(migrations)
+		migrations = map[uint64]migration{
+			version - 2: func(tx *bbolt.Tx, _ []byte) ([]byte, error) {
+				applied = append(applied, version-2)
+				return nil, tx.Bucket(shardInfoBucket).Put([]byte("migrated"), []byte{1})
+			},
+			version - 1: func(tx *bbolt.Tx, _ []byte) ([]byte, error) {
+				if fail {
+					// must be rolled back along with the version update
+					require.NoError(t, tx.Bucket(shardInfoBucket).Put([]byte("partial"), []byte{1}))
+					return nil, errStep
+				}
+				applied = append(applied, version-1)
+				return nil, nil
+			},
+		}
+
+		require.ErrorIs(t, db.Migrate(), errStep)
+		require.Equal(t, []uint64{version - 2}, applied)
+
+		v, err = db.Version()
+		require.NoError(t, err)
+		require.Equal(t, uint64(version-1), v)
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(shardInfoBucket).Get([]byte("partial")))
+			require.NotNil(t, tx.Bucket(shardInfoBucket).Get([]byte("migrated")))
+			return nil
+		}))
+		require.NoError(t, db.Close())
+
+		t.Run("resume", func(t *testing.T) {
+			fail = false
+
+			require.NoError(t, db.Open(false))
+			require.NoError(t, db.Init())
+			check(t, db)
+			require.Equal(t, []uint64{version - 2, version - 1}, applied)
+			require.NoError(t, db.Close())
+		})
+		t.Run("no path", func(t *testing.T) {
+			require.NoError(t, db.Open(false))
+			require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+				return updateVersion(tx, version-2)
+			}))
+			delete(migrations, version-2)
+			require.ErrorIs(t, db.Migrate(), ErrOutdatedVersion)
+			require.ErrorIs(t, db.Init(), ErrOutdatedVersion)
+			require.NoError(t, db.Close())
+		})
+		t.Run("read-only", func(t *testing.T) {
+			require.NoError(t, db.Open(true))
+			require.ErrorIs(t, db.Migrate(), ErrReadOnlyMode)
+			require.NoError(t, db.Close())
+		})
+	})
+	t.Run("batches", func(t *testing.T) {
+		db := newDB(t)
+		require.NoError(t, db.Open(false))
+		require.NoError(t, db.Init())
+
+		owners := []*user.ID{usertest.ID(), usertest.ID()}
+		for i := 0; i < 7; i++ {
+			obj := objectSDK.New()
+			obj.SetContainerID(cidtest.ID())
+			obj.SetID(oidtest.ID())
+			obj.SetOwnerID(owners[i%len(owners)])
+			obj.SetPayloadSize(uint64(i))
+			obj.SetPayloadChecksum(checksumtest.Checksum())
+
+			var putPrm PutPrm
+			putPrm.SetObject(obj)
+			_, err := db.Put(putPrm)
+			require.NoError(t, err)
+		}
+
+		readUsage := func() map[string][]byte {
+			res := make(map[string][]byte)
+			require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+				return tx.Bucket(ownerUsageBucketName).ForEach(func(k, v []byte) error {
+					res[string(k)] = append([]byte(nil), v...)
+					return nil
+				})
+			}))
+			return res
+		}
+
+		expected := readUsage()
+		require.Len(t, expected, len(owners))
+
+		require.NoError(t, db.boltDB.Update(func(tx *bbolt.Tx) error {
+			if err := tx.DeleteBucket(ownerUsageBucketName); err != nil {
+				return err
+			}
+			return updateVersion(tx, version-1)
+		}))
+
+		defer func(sz int) { migrationBatchSize = sz }(migrationBatchSize)
+		migrationBatchSize = 2
+
+		defer func(m map[uint64]migration) { migrations = m }(migrations)
+
+		var cursors [][]byte
+		errStep := errors.New("step failed")
+		migrations = map[uint64]migration{
+			version - 1: func(tx *bbolt.Tx, cursor []byte) ([]byte, error) {
+				if len(cursors) == 2 {
+					cursors = append(cursors, cursor)
+					return nil, errStep
+				}
+				cursors = append(cursors, cursor)
+				return migrateOwnerUsage(tx, cursor)
+			},
+		}
+
+		require.ErrorIs(t, db.Migrate(), errStep)
+		require.Len(t, cursors, 3)
+		require.Nil(t, cursors[0])
+		require.NotNil(t, cursors[1])
+		require.NotEqual(t, cursors[1], cursors[2])
+
+		v, err := db.Version()
+		require.NoError(t, err)
+		require.Equal(t, uint64(version-1), v)
+
+		// the step is resumed from the persisted cursor
+		migrations[version-1] = func(tx *bbolt.Tx, cursor []byte) ([]byte, error) {
+			cursors = append(cursors, cursor)
+			return migrateOwnerUsage(tx, cursor)
+		}
+
+		require.NoError(t, db.Migrate())
+		require.Equal(t, cursors[2], cursors[3])
+		require.Len(t, cursors, 5) // 4 batches of 7 objects by 2 and the failed one
+		require.Equal(t, expected, readUsage())
+		check(t, db)
+
+		require.NoError(t, db.boltDB.View(func(tx *bbolt.Tx) error {
+			require.Nil(t, tx.Bucket(shardInfoBucket).Get(migrationCursorKey))
+			return nil
+		}))
+		require.NoError(t, db.Close())
+	})
 }
@@ -744,6 +744,41 @@ func (t *boltForest) TreeList(cid cidSDK.ID) ([]string, error) {
 	return ids, nil
 }
 
+// TreeListAll implements the pilorama.ForestStorage interface.
+func (t *boltForest) TreeListAll() ([]ContainerIDTreeID, error) {
+	t.modeMtx.RLock()
+	defer t.modeMtx.RUnlock()
+
+	if t.mode.NoMetabase() {
+		return nil, ErrDegradedMode
+	}
+
+	var ids []ContainerIDTreeID
+
+	err := t.db.View(func(tx *bbolt.Tx) error {
+		return tx.ForEach(func(name []byte, _ *bbolt.Bucket) error {
+			if len(name) < 32 {
+				// data and log buckets of the forest itself
+				return nil
+			}
+
+			var id ContainerIDTreeID
+			if err := id.CID.Decode(name[:32]); err != nil {
+				return fmt.Errorf("invalid container ID in the bucket name: %w", err)
+			}
+			id.TreeID = string(name[32:])
+
+			ids = append(ids, id)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list trees: %w", err)
+	}
+
+	return ids, nil
+}
+
 // TreeGetOpLog implements the pilorama.Forest interface.
 func (t *boltForest) TreeGetOpLog(cid cidSDK.ID, treeID string, height uint64) (Move, error) {
 	t.modeMtx.RLock()
@@ -220,6 +220,25 @@ func (f *memoryForest) TreeList(cid cidSDK.ID) ([]string, error) {
 	return res, nil
 }
 
+// TreeListAll implements the pilorama.ForestStorage interface.
+func (f *memoryForest) TreeListAll() ([]ContainerIDTreeID, error) {
+	var res []ContainerIDTreeID
+
+	for k := range f.treeMap {
+		cidAndTree := strings.SplitN(k, "/", 2)
+
+		var id ContainerIDTreeID
+		if err := id.CID.DecodeString(cidAndTree[0]); err != nil {
+			return nil, err
+		}
+		id.TreeID = cidAndTree[1]
+
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
 // TreeExists implements the pilorama.Forest interface.
 func (f *memoryForest) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	fullID := cid.EncodeToString() + "/" + treeID
@@ -240,6 +240,35 @@ func testForestTreeDrop(t *testing.T, s Forest) {
 	}
 }
 
+func TestForest_TreeListAll(t *testing.T) {
+	for i := range providers {
+		t.Run(providers[i].name, func(t *testing.T) {
+			testForestTreeListAll(t, providers[i].construct(t).(ForestStorage))
+		})
+	}
+}
+
+func testForestTreeListAll(t *testing.T, s ForestStorage) {
+	list, err := s.TreeListAll()
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	var expected []ContainerIDTreeID
+	for i := 0; i < 3; i++ {
+		cid := cidtest.ID()
+		for _, treeID := range []string{"tree1", "tree/2"} {
+			_, err := s.TreeAddByPath(CIDDescriptor{cid, 0, 1}, treeID, AttributeFilename, []string{"path"}, nil)
+			require.NoError(t, err)
+
+			expected = append(expected, ContainerIDTreeID{CID: cid, TreeID: treeID})
+		}
+	}
+
+	list, err = s.TreeListAll()
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, list)
+}
+
 func TestForest_TreeAdd(t *testing.T) {
 	for i := range providers {
 		t.Run(providers[i].name, func(t *testing.T) {
@@ -53,9 +53,19 @@ type ForestStorage interface {
 	Open(bool) error
 	Close() error
 	SetMode(m mode.Mode) error
+	// TreeListAll returns the identifiers of all the trees stored in the forest.
+	// Nil slice should be returned if no tree found.
+	TreeListAll() ([]ContainerIDTreeID, error)
 	Forest
 }
 
+// ContainerIDTreeID is a pair of the container ID and the tree ID
+// identifying the tree in the forest.
+type ContainerIDTreeID struct {
+	CID    cidSDK.ID
+	TreeID string
+}
+
 const (
 	AttributeFilename = "FileName"
 	AttributeVersion  = "Version"
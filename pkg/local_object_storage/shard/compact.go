@@ -0,0 +1,49 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"go.uber.org/zap"
+)
+
+type compactionCfg struct {
+	interval           time.Duration
+	deadSpaceThreshold float64
+	rateLimit          uint64
+}
+
+// compactBlobStor compacts the blobstor if the shard is in read-write mode.
+// The compaction is interrupted on mode change.
+func (s *Shard) compactBlobStor(ctx context.Context) {
+	s.m.RLock()
+	m := s.info.Mode
+	s.m.RUnlock()
+
+	if m != mode.ReadWrite {
+		return
+	}
+
+	res, err := s.blobStor.Compact(common.CompactPrm{
+		Context:            ctx,
+		DeadSpaceThreshold: s.compactionCfg.deadSpaceThreshold,
+		RateLimit:          s.compactionCfg.rateLimit,
+	})
+
+	if res.Compacted > 0 {
+		if s.cfg.metricsWriter != nil {
+			s.cfg.metricsWriter.AddCompaction(res.Compacted, res.Reclaimed)
+		}
+
+		s.log.Info("blobstor compaction finished",
+			zap.Int("compacted", res.Compacted),
+			zap.Uint64("reclaimed", res.Reclaimed))
+	}
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		s.log.Warn("blobstor compaction failed", zap.Error(err))
+	}
+}
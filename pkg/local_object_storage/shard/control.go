@@ -146,13 +146,32 @@ func (s *Shard) Init() error {
 					s.collectExpiredObjects,
 					s.collectExpiredTombstones,
 					s.collectExpiredLocks,
+					s.trimChangeLog,
 				},
 			},
 		},
 	}
 
+	if s.accessTracker != nil {
+		h := s.gc.mEventHandler[eventNewEpoch]
+		h.handlers = append(h.handlers, s.updateTieringEpoch)
+	}
+
 	s.gc.init()
 
+	s.compactor = newPeriodicJob(s.compactionCfg.interval, s.compactBlobStor)
+	s.compactor.start()
+
+	s.scrubber = newPeriodicJob(s.scrubCfg.interval, s.scrubBlobStor)
+	s.scrubber.start()
+
+	s.tierer = newPeriodicJob(s.tieringCfg.interval, s.tierBlobStor)
+	s.tierer.start()
+
+	s.indexer = newPeriodicJob(indexUpdateInterval, s.updateIndexes)
+	s.indexer.start()
+	s.indexer.trigger()
+
 	return nil
 }
 
@@ -243,6 +262,20 @@ func (s *Shard) refillMetabase() error {
 
 // Close releases all Shard's components.
 func (s *Shard) Close() error {
+	// If Init/Open was unsuccessful background jobs can be nil.
+	if s.compactor != nil {
+		s.compactor.stop()
+	}
+	if s.scrubber != nil {
+		s.scrubber.stop()
+	}
+	if s.tierer != nil {
+		s.tierer.stop()
+	}
+	if s.indexer != nil {
+		s.indexer.stop()
+	}
+
 	components := []interface{ Close() error }{}
 
 	if s.pilorama != nil {
@@ -281,6 +314,14 @@ func (s *Shard) Reload(opts ...Option) error {
 		opts[i](&c)
 	}
 
+	if c.ioLimits != nil {
+		s.limiter.SetLimits(*c.ioLimits)
+	}
+
+	if c.indexSettings != nil {
+		s.indexState.setSettings(c.indexSettings.settings)
+	}
+
 	s.m.Lock()
 	defer s.m.Unlock()
 
@@ -307,8 +348,13 @@ func (s *Shard) Reload(opts ...Option) error {
 			_ = s.setMode(mode.DegradedReadOnly)
 			return err
 		}
+
+		// index settings are stored in the metabase
+		s.indexState.invalidate()
 	}
 
+	s.indexer.trigger()
+
 	s.log.Info("trying to restore read-write mode")
 	return s.setMode(mode.ReadWrite)
 }
@@ -0,0 +1,59 @@
+package shard
+
+import (
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// dedupIndex is a blobstor.DedupIndex which stores the references
+// to the deduplicated payloads in the metabase.
+type dedupIndex struct {
+	db *meta.DB
+}
+
+func (x dedupIndex) AddPayloadRef(hash []byte, addr oid.Address) (bool, error) {
+	var prm meta.AddPayloadRefPrm
+	prm.SetPayloadHash(hash)
+	prm.SetAddress(addr)
+
+	res, err := x.db.AddPayloadRef(prm)
+
+	return res.Stored(), err
+}
+
+func (x dedupIndex) SetPayloadStorageID(hash []byte, storageID []byte) error {
+	var prm meta.SetPayloadStorageIDPrm
+	prm.SetPayloadHash(hash)
+	prm.SetStorageID(storageID)
+
+	_, err := x.db.SetPayloadStorageID(prm)
+
+	return err
+}
+
+func (x dedupIndex) PayloadHash(addr oid.Address) ([]byte, error) {
+	var prm meta.PayloadHashPrm
+	prm.SetAddress(addr)
+
+	res, err := x.db.PayloadHash(prm)
+
+	return res.PayloadHash(), err
+}
+
+func (x dedupIndex) RemovePayloadRef(addr oid.Address) ([]byte, bool, error) {
+	var prm meta.RemovePayloadRefPrm
+	prm.SetAddress(addr)
+
+	res, err := x.db.RemovePayloadRef(prm)
+
+	return res.StorageID(), res.Last(), err
+}
+
+func (x dedupIndex) PayloadStorageID(hash []byte) ([]byte, bool, error) {
+	var prm meta.PayloadStorageIDPrm
+	prm.SetPayloadHash(hash)
+
+	res, err := x.db.PayloadStorageID(prm)
+
+	return res.StorageID(), res.Found(), err
+}
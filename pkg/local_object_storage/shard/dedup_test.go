@@ -0,0 +1,119 @@
+package shard_test
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_PayloadDeduplication(t *testing.T) {
+	rootPath := t.TempDir()
+	blobPath := filepath.Join(rootPath, "blob")
+
+	newShard := func(t *testing.T, refill bool) *shard.Shard {
+		sh := shard.New(
+			shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+			shard.WithBlobStorOptions(
+				blobstor.WithStorages([]blobstor.SubStorage{{
+					Storage: fstree.New(fstree.WithPath(blobPath)),
+				}})),
+			shard.WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(rootPath, "meta")),
+				meta.WithEpochState(epochState{})),
+			shard.WithRefillMetabase(refill),
+			shard.WithPayloadDeduplication(1))
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+
+		return sh
+	}
+
+	sh := newShard(t, false)
+
+	payload := []byte("deduplicated payload")
+	objs := make([]*object.Object, 2)
+	for i := range objs {
+		objs[i] = generateObjectWithPayload(cidtest.ID(), payload)
+		objs[i].SetPayloadSize(uint64(len(payload)))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(objs[i])
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	// 2 objects without payload and the payload
+	require.Equal(t, 3, countFiles(t, blobPath))
+
+	checkGet := func(t *testing.T, sh *shard.Shard, obj *object.Object) {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(objectCore.AddressOf(obj))
+
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+	}
+
+	for i := range objs {
+		checkGet(t, sh, objs[i])
+	}
+
+	var delPrm shard.DeletePrm
+	delPrm.SetAddresses(objectCore.AddressOf(objs[0]))
+
+	_, err := sh.Delete(delPrm)
+	require.NoError(t, err)
+	require.Equal(t, 2, countFiles(t, blobPath))
+
+	checkGet(t, sh, objs[1])
+
+	t.Run("resync", func(t *testing.T) {
+		releaseShard(sh, t)
+
+		sh = newShard(t, true)
+
+		checkGet(t, sh, objs[1])
+	})
+
+	delPrm.SetAddresses(objectCore.AddressOf(objs[1]))
+
+	_, err = sh.Delete(delPrm)
+	require.NoError(t, err)
+	require.Equal(t, 0, countFiles(t, blobPath))
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(objectCore.AddressOf(objs[1]))
+
+	_, err = sh.Get(getPrm)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	releaseShard(sh, t)
+}
+
+func countFiles(t *testing.T, root string) int {
+	var n int
+
+	err := filepath.WalkDir(root, func(_ string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			n++
+		}
+
+		return err
+	})
+	require.NoError(t, err)
+
+	return n
+}
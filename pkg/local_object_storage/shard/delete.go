@@ -1,18 +1,23 @@
 package shard
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // DeletePrm groups the parameters of Delete operation.
 type DeletePrm struct {
 	addr []oid.Address
+	ctx  context.Context
 }
 
 // DeleteRes groups the resulting values of Delete operation.
@@ -25,9 +30,19 @@ func (p *DeletePrm) SetAddresses(addr ...oid.Address) {
 	p.addr = append(p.addr, addr...)
 }
 
+// SetContext is a Delete option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *DeletePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Delete removes data from the shard's writeCache, metaBase and
 // blobStor.
-func (s *Shard) Delete(prm DeletePrm) (DeleteRes, error) {
+func (s *Shard) Delete(prm DeletePrm) (_ DeleteRes, err error) {
+	_, span := s.startSpan(prm.ctx, "Delete", attribute.Int("objects", len(prm.addr)))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricDelete)()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -41,6 +56,9 @@ func (s *Shard) delete(prm DeletePrm) (DeleteRes, error) {
 		return DeleteRes{}, ErrDegradedMode
 	}
 
+	s.migrationLocks.lock(prm.addr...)
+	defer s.migrationLocks.unlock(prm.addr...)
+
 	ln := len(prm.addr)
 
 	smalls := make(map[oid.Address][]byte, ln)
@@ -87,6 +105,9 @@ func (s *Shard) delete(prm DeletePrm) (DeleteRes, error) {
 		id := smalls[prm.addr[i]]
 		delPrm.StorageID = id
 
+		// the metabase records are already removed, so the removal is not interrupted
+		_ = s.limiter.Wait(context.Background(), limiter.Background, 0)
+
 		_, err = s.blobStor.Delete(delPrm)
 		if err != nil {
 			s.log.Debug("can't remove object from blobStor",
@@ -2,21 +2,54 @@ package shard
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
 )
 
 var dumpMagic = []byte("NEOF")
 
+// dumpVersion is the current version of the dump format.
+//
+// Version 1 dump is a sequence of marshaled objects each prefixed with
+// its size. Since version 2 the magic is followed by the zero size and
+// the version number, so the versions can be distinguished. Every entry
+// is prefixed with its type then.
+const dumpVersion = 2
+
+// Types of the dump entries.
+const (
+	// dumpEntryObject contains marshaled object.
+	dumpEntryObject byte = iota
+	// dumpEntryInhume contains the address of an inhumed object,
+	// optionally followed by the address of the tombstone.
+	dumpEntryInhume
+)
+
+// dumpAddressSize is the size of the binary encoded object address.
+const dumpAddressSize = 64
+
+// dumpListBatchSize is the number of addresses listed from the metabase
+// snapshot at once during the online dump.
+const dumpListBatchSize = 1000
+
 // DumpPrm groups the parameters of Dump operation.
 type DumpPrm struct {
 	path         string
 	stream       io.Writer
 	ignoreErrors bool
+	since        uint64
+	truncate     bool
 }
 
 // WithPath is an Dump option to set the destination path.
@@ -36,9 +69,27 @@ func (p *DumpPrm) WithIgnoreErrors(ignore bool) {
 	p.ignoreErrors = ignore
 }
 
+// WithSince is an Dump option to write only the changes made after
+// the dump with the provided cursor. Objects added after the cursor
+// are written along with the inhumed object addresses.
+// Zero cursor means full dump.
+func (p *DumpPrm) WithSince(cursor uint64) {
+	p.since = cursor
+}
+
+// WithTruncateChanges is an Dump option to remove the changes written to
+// the dump from the metabase change log after the successful dump, so the
+// change log doesn't grow indefinitely. Incremental dumps can be taken only
+// after the cursor of the last dump with the truncation.
+// Requires shard to be in read-write mode.
+func (p *DumpPrm) WithTruncateChanges(truncate bool) {
+	p.truncate = truncate
+}
+
 // DumpRes groups the result fields of Dump operation.
 type DumpRes struct {
-	count int
+	count  int
+	cursor uint64
 }
 
 // Count return amount of object written.
@@ -46,17 +97,44 @@ func (r DumpRes) Count() int {
 	return r.count
 }
 
+// Cursor returns the identifier of the metabase state the dump
+// corresponds to. It is zero if the metabase is unavailable.
+func (r DumpRes) Cursor() uint64 {
+	return r.cursor
+}
+
 var ErrMustBeReadOnly = logicerr.New("shard must be in read-only mode")
 
+// ErrInvalidCursor is returned when the dump cursor doesn't correspond to the
+// metabase state, e.g. if the metabase has been recreated since the cursor was taken.
+var ErrInvalidCursor = logicerr.New("dump cursor is ahead of the metabase state")
+
 // Dump dumps all objects from the shard to a file or stream.
 //
+// In read-write mode the metabase state is captured at the beginning
+// and only objects present in the metabase at this moment are written,
+// so the dump is consistent while the shard keeps serving writes. In
+// read-only modes all objects are read directly from the storage components.
+// Degraded shard must be read-only to be dumped.
+//
+// Incremental dump requires metabase to be available.
+//
 // Returns any error encountered.
 func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
 
-	if !s.info.Mode.ReadOnly() {
-		return DumpRes{}, ErrMustBeReadOnly
+	if s.info.Mode.NoMetabase() {
+		if !s.info.Mode.ReadOnly() {
+			return DumpRes{}, ErrMustBeReadOnly
+		}
+		if prm.since != 0 {
+			return DumpRes{}, ErrDegradedMode
+		}
+	}
+
+	if prm.truncate && s.info.Mode.ReadOnly() {
+		return DumpRes{}, ErrReadOnlyMode
 	}
 
 	w := prm.stream
@@ -70,29 +148,51 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
-	if err != nil {
+	if err := writeDumpHeader(w); err != nil {
 		return DumpRes{}, err
 	}
 
-	var count int
+	if !s.info.Mode.ReadOnly() {
+		res, err := s.dumpSnapshot(w, prm)
+		if err != nil || !prm.truncate {
+			return res, err
+		}
+
+		var truncPrm meta.TruncateChangesPrm
+		truncPrm.SetUntil(res.cursor)
+
+		if _, err := s.metaBase.TruncateChanges(truncPrm); err != nil {
+			return DumpRes{}, fmt.Errorf("could not truncate metabase change log: %w", err)
+		}
+		return res, nil
+	}
+
+	var res DumpRes
+
+	if !s.info.Mode.NoMetabase() {
+		// Metabase can't be modified in read-only mode,
+		// so the current state is described by the dump.
+		snRes, err := s.metaBase.Snapshot(meta.SnapshotPrm{})
+		if err != nil {
+			return DumpRes{}, fmt.Errorf("could not read metabase state: %w", err)
+		}
+		res.cursor = snRes.Cursor()
+	}
+
+	if prm.since != 0 {
+		return s.dumpChanges(w, s.metaBase, res.cursor, prm)
+	}
 
 	if s.hasWriteCache() {
 		var iterPrm writecache.IterationPrm
 
 		iterPrm.WithIgnoreErrors(prm.ignoreErrors)
 		iterPrm.WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
+			if err := writeDumpObject(w, data); err != nil {
 				return err
 			}
 
-			if _, err := w.Write(data); err != nil {
-				return err
-			}
-
-			count++
+			res.count++
 			return nil
 		})
 
@@ -105,19 +205,11 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 	var pi common.IteratePrm
 	pi.IgnoreErrors = prm.ignoreErrors
 	pi.Handler = func(elem common.IterationElement) error {
-		data := elem.ObjectData
-
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
+		if err := writeDumpObject(w, elem.ObjectData); err != nil {
 			return err
 		}
 
-		if _, err := w.Write(data); err != nil {
-			return err
-		}
-
-		count++
+		res.count++
 		return nil
 	}
 
@@ -125,5 +217,242 @@ func (s *Shard) Dump(prm DumpPrm) (DumpRes, error) {
 		return DumpRes{}, err
 	}
 
-	return DumpRes{count: count}, nil
+	return res, nil
+}
+
+// dumpSnapshot writes all objects from the metabase snapshot to w.
+// Objects removed after the snapshot has been taken are skipped.
+func (s *Shard) dumpSnapshot(w io.Writer, prm DumpPrm) (DumpRes, error) {
+	metaPath := s.metaBase.DumpInfo().Path
+
+	f, err := os.CreateTemp(filepath.Dir(metaPath), filepath.Base(metaPath)+".snapshot*")
+	if err != nil {
+		return DumpRes{}, fmt.Errorf("could not create metabase snapshot file: %w", err)
+	}
+
+	snapshotPath := f.Name()
+	_ = f.Close()
+
+	defer func() {
+		if err := os.Remove(snapshotPath); err != nil {
+			s.log.Warn("could not remove metabase snapshot",
+				zap.String("path", snapshotPath),
+				zap.Error(err))
+		}
+	}()
+
+	var snPrm meta.SnapshotPrm
+	snPrm.SetPath(snapshotPath)
+
+	snRes, err := s.metaBase.Snapshot(snPrm)
+	if err != nil {
+		return DumpRes{}, fmt.Errorf("could not take metabase snapshot: %w", err)
+	}
+
+	// Bolt options are reset, because they are shared with the
+	// main metabase and are modified on open.
+	snapshot := meta.New(append(s.metaOpts[:len(s.metaOpts):len(s.metaOpts)],
+		meta.WithPath(snapshotPath),
+		meta.WithBoltDBOptions(nil),
+		meta.WithLogger(s.log),
+	)...)
+	if err := snapshot.Open(true); err != nil {
+		return DumpRes{}, fmt.Errorf("could not open metabase snapshot: %w", err)
+	}
+	defer snapshot.Close()
+
+	if prm.since != 0 {
+		return s.dumpChanges(w, snapshot, snRes.Cursor(), prm)
+	}
+
+	res := DumpRes{cursor: snRes.Cursor()}
+
+	var listPrm meta.ListPrm
+	listPrm.SetCount(dumpListBatchSize)
+
+	for {
+		listRes, err := snapshot.ListWithCursor(listPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				return res, nil
+			}
+			return DumpRes{}, fmt.Errorf("could not list metabase snapshot: %w", err)
+		}
+
+		for _, addr := range listRes.AddressList() {
+			ok, err := s.dumpMetaObject(w, snapshot, addr.Address, prm.ignoreErrors)
+			if err != nil {
+				return DumpRes{}, err
+			}
+			if ok {
+				res.count++
+			}
+		}
+
+		listPrm.SetCursor(listRes.Cursor())
+	}
+}
+
+// dumpChanges writes objects put to the metabase and addresses of the objects
+// inhumed after the prm.since cursor up to the cursor.
+func (s *Shard) dumpChanges(w io.Writer, db *meta.DB, cursor uint64, prm DumpPrm) (DumpRes, error) {
+	if prm.since > cursor {
+		return DumpRes{}, fmt.Errorf("%w: cursor=%d, state=%d", ErrInvalidCursor, prm.since, cursor)
+	}
+
+	res := DumpRes{cursor: cursor}
+
+	var iterPrm meta.ChangesIterationPrm
+	iterPrm.SetSince(prm.since)
+	iterPrm.SetHandler(func(ch meta.Change) error {
+		switch ch.Type() {
+		case meta.ChangePut:
+			ok, err := s.dumpMetaObject(w, db, ch.Address(), prm.ignoreErrors)
+			if err != nil || !ok {
+				return err
+			}
+		case meta.ChangeInhume:
+			var tomb *oid.Address
+			if t, ok := ch.Tombstone(); ok {
+				tomb = &t
+			}
+
+			err := writeDumpInhume(w, ch.Address(), tomb)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown metabase change type %d", ch.Type())
+		}
+
+		res.count++
+		return nil
+	})
+
+	if err := db.IterateChanges(iterPrm); err != nil {
+		return DumpRes{}, fmt.Errorf("could not iterate over metabase changes: %w", err)
+	}
+
+	return res, nil
+}
+
+// dumpMetaObject writes the object found in the metabase to w.
+// Returns false if the object has been skipped.
+func (s *Shard) dumpMetaObject(w io.Writer, db *meta.DB, addr oid.Address, ignoreErrors bool) (bool, error) {
+	data, err := s.readDumpObject(db, addr)
+	if err != nil {
+		if IsErrNotFound(err) {
+			// removed after the snapshot has been taken
+			return false, nil
+		}
+		if ignoreErrors {
+			s.log.Warn("could not read object for dump",
+				zap.Stringer("address", addr),
+				zap.Error(err))
+			return false, nil
+		}
+		return false, fmt.Errorf("could not read object %s: %w", addr, err)
+	}
+
+	return true, writeDumpObject(w, data)
+}
+
+// readDumpObject reads marshaled object from the write-cache or the blobstor
+// using the storage ID from the metabase snapshot.
+func (s *Shard) readDumpObject(snapshot *meta.DB, addr oid.Address) ([]byte, error) {
+	if s.hasWriteCache() {
+		obj, err := s.writeCache.Get(addr)
+		if err == nil {
+			return obj.Marshal()
+		}
+	}
+
+	var sidPrm meta.StorageIDPrm
+	sidPrm.SetAddress(addr)
+
+	sidRes, err := snapshot.StorageID(sidPrm)
+	if err != nil {
+		return nil, fmt.Errorf("could not read storage ID: %w", err)
+	}
+
+	var getPrm common.GetPrm
+	getPrm.Address = addr
+	getPrm.StorageID = sidRes.StorageID()
+
+	if getPrm.StorageID == nil {
+		// Object is either big or has been flushed from the
+		// write-cache after the snapshot was taken.
+		getPrm.StorageID = emptyStorageID
+
+		res, err := s.blobStor.Get(getPrm)
+		if err == nil || !IsErrNotFound(err) {
+			return res.RawData, err
+		}
+
+		getPrm.StorageID = nil
+	}
+
+	res, err := s.blobStor.Get(getPrm)
+	if err != nil {
+		return nil, err
+	}
+	return res.RawData, nil
+}
+
+func writeDumpHeader(w io.Writer) error {
+	var header [12]byte
+	copy(header[:], dumpMagic)
+	// header[4:8] is zero which is an invalid size of the version 1 entry
+	binary.LittleEndian.PutUint32(header[8:], dumpVersion)
+
+	_, err := w.Write(header[:])
+	return err
+}
+
+func writeDumpObject(w io.Writer, data []byte) error {
+	return writeDumpEntry(w, dumpEntryObject, data)
+}
+
+func writeDumpInhume(w io.Writer, addr oid.Address, tomb *oid.Address) error {
+	data := make([]byte, dumpAddressSize, 2*dumpAddressSize)
+	encodeDumpAddress(data, addr)
+	if tomb != nil {
+		data = data[:2*dumpAddressSize]
+		encodeDumpAddress(data[dumpAddressSize:], *tomb)
+	}
+
+	return writeDumpEntry(w, dumpEntryInhume, data)
+}
+
+func writeDumpEntry(w io.Writer, typ byte, data []byte) error {
+	var header [5]byte
+	binary.LittleEndian.PutUint32(header[:], uint32(len(data)+1))
+	header[4] = typ
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+func encodeDumpAddress(dst []byte, addr oid.Address) {
+	addr.Container().Encode(dst)
+	addr.Object().Encode(dst[dumpAddressSize/2:])
+}
+
+func decodeDumpAddress(dst *oid.Address, data []byte) error {
+	var cnr cid.ID
+	if err := cnr.Decode(data[:dumpAddressSize/2]); err != nil {
+		return fmt.Errorf("invalid container ID: %w", err)
+	}
+
+	var obj oid.ID
+	if err := obj.Decode(data[dumpAddressSize/2 : dumpAddressSize]); err != nil {
+		return fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	dst.SetContainer(cnr)
+	dst.SetObject(obj)
+	return nil
 }
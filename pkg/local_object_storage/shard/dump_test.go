@@ -2,6 +2,7 @@ package shard_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"io"
 	"math/rand"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
@@ -63,7 +65,10 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	var prm shard.DumpPrm
 	prm.WithPath(out)
 
-	t.Run("must be read-only", func(t *testing.T) {
+	t.Run("degraded must be read-only", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(mode.Degraded))
+		t.Cleanup(func() { require.NoError(t, sh.SetMode(mode.ReadWrite)) })
+
 		_, err := sh.Dump(prm)
 		require.ErrorIs(t, err, shard.ErrMustBeReadOnly)
 	})
@@ -106,6 +111,15 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 		require.NoError(t, err)
 	}
 
+	outOnline := out + ".online"
+	var onlinePrm shard.DumpPrm
+	onlinePrm.WithPath(outOnline)
+
+	onlineRes, err := sh.Dump(onlinePrm)
+	require.NoError(t, err)
+	require.Equal(t, objCount, onlineRes.Count())
+	require.NotZero(t, onlineRes.Cursor())
+
 	require.NoError(t, sh.SetMode(mode.ReadOnly))
 
 	t.Run("invalid path", func(t *testing.T) {
@@ -119,6 +133,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	res, err = sh.Dump(prm)
 	require.NoError(t, err)
 	require.Equal(t, objCount, res.Count())
+	require.Equal(t, onlineRes.Cursor(), res.Cursor())
 
 	t.Run("restore", func(t *testing.T) {
 		sh := newShard(t, false)
@@ -214,6 +229,237 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 
 		checkRestore(t, sh, prm, objects)
 	})
+
+	t.Run("restore online dump", func(t *testing.T) {
+		sh := newShard(t, false)
+		defer releaseShard(sh, t)
+
+		var prm shard.RestorePrm
+		prm.WithPath(outOnline)
+
+		checkRestore(t, sh, prm, objects)
+	})
+}
+
+func TestDumpOnline(t *testing.T) {
+	sh := newCustomShard(t, t.TempDir(), false, nil, nil)
+	defer releaseShard(sh, t)
+
+	const objCount = 10
+	objects := make([]*objectSDK.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+
+		var prm shard.PutPrm
+		prm.SetObject(objects[i])
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	var inhumePrm shard.InhumePrm
+	inhumePrm.MarkAsGarbage(object.AddressOf(objects[0]))
+	_, err := sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	b := bytes.NewBuffer(nil)
+
+	var dumpPrm shard.DumpPrm
+	dumpPrm.WithStream(b)
+
+	res, err := sh.Dump(dumpPrm)
+	require.NoError(t, err)
+	require.Equal(t, objCount-1, res.Count(), "inhumed object must not be dumped")
+	require.NotZero(t, res.Cursor())
+
+	// Shard must remain writable after the dump.
+	var prm shard.PutPrm
+	prm.SetObject(generateObjectWithCID(t, cidtest.ID()))
+	_, err = sh.Put(prm)
+	require.NoError(t, err)
+
+	b2 := bytes.NewBuffer(nil)
+	dumpPrm.WithStream(b2)
+
+	res2, err := sh.Dump(dumpPrm)
+	require.NoError(t, err)
+	require.Equal(t, objCount, res2.Count())
+	require.Greater(t, res2.Cursor(), res.Cursor())
+
+	sh2 := newCustomShard(t, t.TempDir(), false, nil, nil)
+	defer releaseShard(sh2, t)
+
+	var restorePrm shard.RestorePrm
+	restorePrm.WithStream(b)
+
+	checkRestore(t, sh2, restorePrm, objects[1:])
+}
+
+func TestDumpIncremental(t *testing.T) {
+	for _, readOnly := range []bool{false, true} {
+		name := "read-write"
+		if readOnly {
+			name = "read-only"
+		}
+		t.Run(name, func(t *testing.T) {
+			testDumpIncremental(t, readOnly)
+		})
+	}
+}
+
+func testDumpIncremental(t *testing.T, readOnly bool) {
+	sh := newCustomShard(t, t.TempDir(), false, nil, nil)
+	defer releaseShard(sh, t)
+
+	put := func(obj *objectSDK.Object) {
+		var prm shard.PutPrm
+		prm.SetObject(obj)
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	const objCount = 5
+	objects := make([]*objectSDK.Object, objCount)
+	for i := range objects {
+		objects[i] = generateObjectWithCID(t, cidtest.ID())
+		put(objects[i])
+	}
+
+	full := bytes.NewBuffer(nil)
+
+	var dumpPrm shard.DumpPrm
+	dumpPrm.WithStream(full)
+
+	res, err := sh.Dump(dumpPrm)
+	require.NoError(t, err)
+	require.Equal(t, objCount, res.Count())
+
+	added := generateObjectWithCID(t, cidtest.ID())
+	put(added)
+
+	tomb := objecttest.Address()
+
+	var inhumePrm shard.InhumePrm
+	inhumePrm.SetTarget(tomb, object.AddressOf(objects[0]))
+	_, err = sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	inhumePrm = shard.InhumePrm{}
+	inhumePrm.MarkAsGarbage(object.AddressOf(objects[1]))
+	_, err = sh.Inhume(inhumePrm)
+	require.NoError(t, err)
+
+	if readOnly {
+		require.NoError(t, sh.SetMode(mode.ReadOnly))
+	}
+
+	incremental := bytes.NewBuffer(nil)
+	dumpPrm.WithStream(incremental)
+	dumpPrm.WithSince(res.Cursor())
+
+	incRes, err := sh.Dump(dumpPrm)
+	require.NoError(t, err)
+	require.Equal(t, 3, incRes.Count())
+	require.Greater(t, incRes.Cursor(), res.Cursor())
+
+	dumpPrm.WithStream(bytes.NewBuffer(nil))
+	dumpPrm.WithSince(incRes.Cursor())
+
+	emptyRes, err := sh.Dump(dumpPrm)
+	require.NoError(t, err)
+	require.Equal(t, 0, emptyRes.Count())
+	require.Equal(t, incRes.Cursor(), emptyRes.Cursor())
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		dumpPrm.WithStream(bytes.NewBuffer(nil))
+		dumpPrm.WithSince(incRes.Cursor() + 1)
+
+		_, err := sh.Dump(dumpPrm)
+		require.ErrorIs(t, err, shard.ErrInvalidCursor)
+	})
+
+	t.Run("truncate changes", func(t *testing.T) {
+		dumpPrm.WithStream(bytes.NewBuffer(nil))
+		dumpPrm.WithSince(res.Cursor())
+		dumpPrm.WithTruncateChanges(true)
+		defer dumpPrm.WithTruncateChanges(false)
+
+		truncRes, err := sh.Dump(dumpPrm)
+		if readOnly {
+			require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, 3, truncRes.Count())
+
+		dumpPrm.WithStream(bytes.NewBuffer(nil))
+		_, err = sh.Dump(dumpPrm)
+		require.ErrorIs(t, err, meta.ErrChangesTruncated)
+
+		dumpPrm.WithStream(bytes.NewBuffer(nil))
+		dumpPrm.WithSince(truncRes.Cursor())
+		emptyRes, err := sh.Dump(dumpPrm)
+		require.NoError(t, err)
+		require.Equal(t, 0, emptyRes.Count())
+	})
+
+	sh2 := newCustomShard(t, t.TempDir(), false, nil, nil)
+	defer releaseShard(sh2, t)
+
+	var restorePrm shard.RestorePrm
+	restorePrm.WithStream(full)
+	checkRestore(t, sh2, restorePrm, objects)
+
+	restorePrm.WithStream(incremental)
+	restoreRes, err := sh2.Restore(restorePrm)
+	require.NoError(t, err)
+	require.Equal(t, 1, restoreRes.Count())
+	require.Equal(t, 2, restoreRes.InhumeCount())
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(object.AddressOf(added))
+	_, err = sh2.Get(getPrm)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		getPrm.SetAddress(object.AddressOf(objects[i]))
+		_, err = sh2.Get(getPrm)
+		require.True(t, shard.IsErrRemoved(err) || shard.IsErrNotFound(err), err)
+	}
+	for i := 2; i < objCount; i++ {
+		getPrm.SetAddress(object.AddressOf(objects[i]))
+		_, err = sh2.Get(getPrm)
+		require.NoError(t, err)
+	}
+}
+
+func TestRestoreVersion(t *testing.T) {
+	sh := newCustomShard(t, t.TempDir(), false, nil, nil)
+	defer releaseShard(sh, t)
+
+	t.Run("version 1", func(t *testing.T) {
+		obj := generateObjectWithCID(t, cidtest.ID())
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		dump := make([]byte, 8, 8+len(data))
+		copy(dump, "NEOF")
+		binary.LittleEndian.PutUint32(dump[4:], uint32(len(data)))
+		dump = append(dump, data...)
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithStream(bytes.NewReader(dump))
+
+		checkRestore(t, sh, restorePrm, []*objectSDK.Object{obj})
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		dump := []byte{'N', 'E', 'O', 'F', 0, 0, 0, 0, 0xFF, 0, 0, 0}
+
+		var restorePrm shard.RestorePrm
+		restorePrm.WithStream(bytes.NewReader(dump))
+
+		_, err := sh.Restore(restorePrm)
+		require.ErrorIs(t, err, shard.ErrUnsupportedVersion)
+	})
 }
 
 func TestStream(t *testing.T) {
@@ -34,6 +34,8 @@ func (p ExistsRes) Exists() bool {
 // Returns an error of type apistatus.ObjectAlreadyRemoved if object has been marked as removed.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
 func (s *Shard) Exists(prm ExistsPrm) (ExistsRes, error) {
+	defer s.elapsed(metricExists)()
+
 	var exists bool
 	var err error
 
@@ -349,6 +349,27 @@ func (s *Shard) collectExpiredLocks(ctx context.Context, e Event) {
 	s.expiredLocksCallback(ctx, expired)
 }
 
+// trimChangeLog removes the oldest metabase changes, so the change log
+// does not grow for the whole shard lifetime.
+func (s *Shard) trimChangeLog(_ context.Context, _ Event) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() || s.info.Mode.ReadOnly() {
+		return
+	}
+
+	res, err := s.metaBase.TrimChanges()
+	if err != nil {
+		s.log.Warn("could not trim metabase change log", zap.String("error", err.Error()))
+		return
+	}
+
+	if res.Count() > 0 {
+		s.log.Debug("metabase change log trimmed", zap.Int("removed", res.Count()))
+	}
+}
+
 func (s *Shard) getExpiredObjects(ctx context.Context, epoch uint64, typeCond func(object.Type) bool) ([]oid.Address, error) {
 	s.m.RLock()
 	defer s.m.RUnlock()
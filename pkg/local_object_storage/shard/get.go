@@ -1,16 +1,21 @@
 package shard
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +27,8 @@ type storFetcher = func(stor *blobstor.BlobStor, id []byte) (*objectSDK.Object,
 type GetPrm struct {
 	addr     oid.Address
 	skipMeta bool
+	ioClass  limiter.Class
+	ctx      context.Context
 }
 
 // GetRes groups the resulting values of Get operation.
@@ -43,6 +50,18 @@ func (p *GetPrm) SetIgnoreMeta(ignore bool) {
 	p.skipMeta = ignore
 }
 
+// SetIOClass is a Get option to set the I/O class of the operation.
+// By default, the operation is limited as limiter.ClientRead.
+func (p *GetPrm) SetIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// SetContext is a Get option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *GetPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object.
 func (r GetRes) Object() *objectSDK.Object {
 	return r.obj
@@ -61,7 +80,13 @@ func (r GetRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing in shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Get(prm GetPrm) (GetRes, error) {
+func (s *Shard) Get(prm GetPrm) (res GetRes, err error) {
+	ctx, span := s.startSpan(prm.ctx, "Get", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricGet)()
+
+	ioClass := readIOClass(prm.ioClass)
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -83,7 +108,15 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	wait := func() error {
+		return s.limiter.Wait(ctx, ioClass, 0)
+	}
+
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc, wait)
+	if err == nil {
+		s.limiter.Charge(ioClass, uint64(len(obj.Payload())))
+		s.accessTracker.touch(prm.addr)
+	}
 
 	return GetRes{
 		obj:     obj,
@@ -96,7 +129,10 @@ func (s *Shard) Get(prm GetPrm) (GetRes, error) {
 var emptyStorageID = make([]byte, 0)
 
 // fetchObjectData looks through writeCache and blobStor to find object.
-func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error)) (*objectSDK.Object, bool, error) {
+// The wait function is called before reading the object, after the metabase
+// has confirmed the object is stored in the shard, so the I/O limits are
+// charged only for the objects the shard holds.
+func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher, wc func(w writecache.Cache) (*objectSDK.Object, error), wait func() error) (*objectSDK.Object, bool, error) {
 	var (
 		mErr error
 		mRes meta.ExistsRes
@@ -114,6 +150,12 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 		exists = mRes.Exists()
 	}
 
+	if skipMeta || mErr != nil || exists {
+		if err := wait(); err != nil {
+			return nil, false, err
+		}
+	}
+
 	if s.hasWriteCache() {
 		res, err := wc(s.writeCache)
 		if err == nil || IsErrOutOfRange(err) {
@@ -158,6 +200,17 @@ func (s *Shard) fetchObjectData(addr oid.Address, skipMeta bool, cb storFetcher,
 	}
 
 	res, err := cb(s.blobStor, storageID)
+	if err != nil && IsErrNotFound(err) {
+		// object may have been moved to another storage tier
+		if id, mErr := s.metaBase.StorageID(mPrm); mErr == nil && !bytes.Equal(id.StorageID(), storageID) {
+			storageID = id.StorageID()
+			if storageID == nil {
+				storageID = emptyStorageID
+			}
+
+			res, err = cb(s.blobStor, storageID)
+		}
+	}
 
 	return res, true, err
 }
@@ -1,15 +1,22 @@
 package shard
 
 import (
+	"context"
+
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
-	addr oid.Address
-	raw  bool
+	addr    oid.Address
+	raw     bool
+	ioClass limiter.Class
+	ctx     context.Context
 }
 
 // HeadRes groups the resulting values of Head operation.
@@ -31,6 +38,18 @@ func (p *HeadPrm) SetRaw(raw bool) {
 	p.raw = raw
 }
 
+// SetIOClass is a Head option to set the I/O class of the operation.
+// By default, the operation is limited as limiter.ClientRead.
+func (p *HeadPrm) SetIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// SetContext is a Head option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *HeadPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object header.
 func (r HeadRes) Object() *objectSDK.Object {
 	return r.obj
@@ -43,13 +62,18 @@ func (r HeadRes) Object() *objectSDK.Object {
 // Returns an error of type apistatus.ObjectNotFound if object is missing in Shard.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
+func (s *Shard) Head(prm HeadPrm) (_ HeadRes, err error) {
+	ctx, span := s.startSpan(prm.ctx, "Head", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricHead)()
+
 	var obj *objectSDK.Object
-	var err error
 	if s.GetMode().NoMetabase() {
 		var getPrm GetPrm
 		getPrm.SetAddress(prm.addr)
 		getPrm.SetIgnoreMeta(true)
+		getPrm.SetIOClass(prm.ioClass)
+		getPrm.SetContext(ctx)
 
 		var res GetRes
 		res, err = s.Get(getPrm)
@@ -62,6 +86,11 @@ func (s *Shard) Head(prm HeadPrm) (HeadRes, error) {
 		var res meta.GetRes
 		res, err = s.metaBase.Get(headParams)
 		obj = res.Header()
+
+		// only the headers of the objects the shard holds are charged
+		if err == nil {
+			err = s.limiter.Wait(ctx, readIOClass(prm.ioClass), 0)
+		}
 	}
 
 	return HeadRes{
@@ -0,0 +1,106 @@
+package shard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.uber.org/zap"
+)
+
+// indexUpdateInterval is the interval of the checks for the attribute
+// indexes to update. Updates are also triggered on the settings change.
+const indexUpdateInterval = time.Minute
+
+// indexUpdateBatchSize is the number of objects indexed in
+// a single metabase transaction.
+const indexUpdateBatchSize = 1000
+
+type indexSettings struct {
+	settings map[cid.ID][]meta.AttributeIndex
+}
+
+// indexState holds the attribute index settings of the containers
+// until they are applied to the metabase.
+type indexState struct {
+	mtx sync.Mutex
+
+	settings map[cid.ID][]meta.AttributeIndex
+
+	// applied is set if the settings are passed to the metabase
+	applied bool
+}
+
+func (st *indexState) setSettings(settings map[cid.ID][]meta.AttributeIndex) {
+	st.mtx.Lock()
+	st.settings = settings
+	st.applied = false
+	st.mtx.Unlock()
+}
+
+// invalidate makes the settings to be passed to the metabase again.
+func (st *indexState) invalidate() {
+	st.mtx.Lock()
+	st.applied = false
+	st.mtx.Unlock()
+}
+
+// WithIndexSettings returns option to set the attribute indexes of the
+// containers. All the attributes of the containers missing in the settings
+// are indexed.
+func WithIndexSettings(settings map[cid.ID][]meta.AttributeIndex) Option {
+	return func(c *cfg) {
+		c.indexSettings = &indexSettings{settings: settings}
+	}
+}
+
+// updateIndexes applies the attribute index settings to the metabase
+// and builds the new indexes in batches.
+//
+// Indexes are updated only if the shard is in read-write mode.
+// The update is interrupted on mode change.
+func (s *Shard) updateIndexes(ctx context.Context) {
+	s.m.RLock()
+	m := s.info.Mode
+	s.m.RUnlock()
+
+	if m != mode.ReadWrite {
+		return
+	}
+
+	st := s.indexState
+
+	st.mtx.Lock()
+	if !st.applied {
+		var prm meta.IndexSettingsPrm
+		prm.SetSettings(st.settings)
+
+		_, err := s.metaBase.SetIndexSettings(prm)
+		if err != nil {
+			st.mtx.Unlock()
+			s.log.Warn("could not apply attribute index settings", zap.Error(err))
+			return
+		}
+
+		st.applied = true
+	}
+	st.mtx.Unlock()
+
+	var prm meta.UpdateIndexesPrm
+	prm.SetCount(indexUpdateBatchSize)
+
+	for ctx.Err() == nil {
+		res, err := s.metaBase.UpdateIndexes(prm)
+		if err != nil {
+			s.log.Warn("could not update attribute indexes", zap.Error(err))
+			return
+		}
+
+		if res.Done() {
+			return
+		}
+	}
+}
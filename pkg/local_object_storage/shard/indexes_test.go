@@ -0,0 +1,90 @@
+package shard
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_UpdateIndexes(t *testing.T) {
+	p := t.TempDir()
+
+	l := &logger.Logger{Logger: zaptest.NewLogger(t)}
+	opts := []Option{
+		WithLogger(l),
+		WithBlobStorOptions(
+			blobstor.WithLogger(l),
+			blobstor.WithStorages([]blobstor.SubStorage{
+				{
+					Storage: fstree.New(
+						fstree.WithPath(filepath.Join(p, "blob")),
+						fstree.WithDepth(1)),
+				},
+			})),
+		WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(p, "meta")),
+			meta.WithEpochState(epochState{})),
+	}
+
+	obj := newObject()
+	cnr, _ := obj.ContainerID()
+
+	var a objectSDK.Attribute
+	a.SetKey("Other")
+	a.SetValue("x")
+	obj.SetAttributes(a)
+
+	settings := map[cid.ID][]meta.AttributeIndex{
+		cnr: {{Attribute: "Name", Kind: meta.IndexExact}},
+	}
+
+	sh := New(append(opts, WithIndexSettings(settings))...)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { require.NoError(t, sh.Close()) })
+
+	require.NoError(t, putObject(sh, obj))
+
+	checkApplied := func(settings map[cid.ID][]meta.AttributeIndex) {
+		sh.updateIndexes(context.Background())
+
+		var prm meta.IndexSettingsPrm
+		prm.SetSettings(settings)
+
+		res, err := sh.metaBase.SetIndexSettings(prm)
+		require.NoError(t, err)
+		require.False(t, res.Pending())
+	}
+
+	checkSelect := func() {
+		var fs objectSDK.SearchFilters
+		fs.AddFilter("Other", "x", objectSDK.MatchStringEqual)
+
+		var prm SelectPrm
+		prm.SetContainerID(cnr)
+		prm.SetFilters(fs)
+
+		res, err := sh.Select(prm)
+		require.NoError(t, err)
+		require.Equal(t, []oid.Address{objectCore.AddressOf(obj)}, res.AddressList())
+	}
+
+	checkApplied(settings)
+	checkSelect()
+
+	require.NoError(t, sh.Reload(append(opts, WithIndexSettings(nil))...))
+
+	checkApplied(nil)
+	checkSelect()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 )
@@ -33,9 +34,20 @@ type Info struct {
 
 	// PiloramaInfo contains information about trees stored on this shard.
 	PiloramaInfo pilorama.Info
+
+	// IOLimits contains I/O rate limits of the shard.
+	IOLimits limiter.Limits
+
+	// ScrubStatus contains the state of the data scrubbing.
+	ScrubStatus ScrubStatus
 }
 
 // DumpInfo returns information about the Shard.
 func (s *Shard) DumpInfo() Info {
-	return s.info
+	info := s.info
+	info.WeightValues = s.WeightValues()
+	info.IOLimits = s.IOLimits()
+	info.ScrubStatus = s.ScrubStatus()
+
+	return info
 }
@@ -6,7 +6,9 @@ import (
 	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +17,7 @@ type InhumePrm struct {
 	target       []oid.Address
 	tombstone    *oid.Address
 	forceRemoval bool
+	ctx          context.Context
 }
 
 // InhumeRes encapsulates results of inhume operation.
@@ -49,6 +52,12 @@ func (p *InhumePrm) ForceRemoval() {
 	}
 }
 
+// SetContext is an Inhume option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *InhumePrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // ErrLockObjectRemoval is returned when inhume operation is being
 // performed on lock object, and it is not a forced object removal.
 var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
@@ -60,7 +69,11 @@ var ErrLockObjectRemoval = meta.ErrLockObjectRemoval
 // if at least one object is locked.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Inhume(prm InhumePrm) (InhumeRes, error) {
+func (s *Shard) Inhume(prm InhumePrm) (_ InhumeRes, err error) {
+	_, span := s.startSpan(prm.ctx, "Inhume", attribute.Int("objects", len(prm.target)))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricInhume)()
+
 	s.m.RLock()
 
 	if s.info.Mode.ReadOnly() {
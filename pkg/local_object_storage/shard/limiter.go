@@ -0,0 +1,23 @@
+package shard
+
+import "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+
+// IOLimits returns current I/O rate limits of the Shard.
+func (s *Shard) IOLimits() limiter.Limits {
+	return s.limiter.Limits()
+}
+
+// SetIOLimits changes I/O rate limits of the Shard.
+func (s *Shard) SetIOLimits(l limiter.Limits) {
+	s.limiter.SetLimits(l)
+
+	s.log.Info("shard I/O limits have been changed")
+}
+
+// readIOClass returns I/O class of the read operation.
+func readIOClass(c limiter.Class) limiter.Class {
+	if c == limiter.Undefined {
+		return limiter.ClientRead
+	}
+	return c
+}
@@ -0,0 +1,346 @@
+package limiter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// Class represents enumeration of the shard I/O operation classes.
+// Classes with the lower values have the higher priority.
+type Class uint8
+
+const (
+	// Undefined class means that the class is determined by the operation:
+	// ClientRead for the read operations and ClientWrite for the write ones.
+	Undefined Class = iota
+
+	// ClientRead is a class of the object reads requested by the clients.
+	ClientRead
+
+	// ClientWrite is a class of the object writes requested by the clients.
+	ClientWrite
+
+	// Replication is a class of the operations performed to replicate objects.
+	Replication
+
+	// Background is a class of the maintenance operations, such as
+	// garbage collection, write-cache flushing and shard evacuation.
+	Background
+
+	classCount
+)
+
+func (c Class) String() string {
+	switch c {
+	default:
+		return "UNDEFINED"
+	case ClientRead:
+		return "CLIENT_READ"
+	case ClientWrite:
+		return "CLIENT_WRITE"
+	case Replication:
+		return "REPLICATION"
+	case Background:
+		return "BACKGROUND"
+	}
+}
+
+// preemptionDelay is the time the operation is delayed for to let
+// the operation of the higher priority class go first.
+const preemptionDelay = time.Millisecond
+
+// Limit represents I/O rate limit. Zero values mean no limit.
+type Limit struct {
+	// Ops is the maximum number of operations per second.
+	Ops uint64
+
+	// Bytes is the maximum number of bytes per second.
+	Bytes uint64
+}
+
+// Limits groups the I/O rate limits of the shard.
+type Limits struct {
+	// Total is the limit of all the shard operations. When it is
+	// reached, operations are served in the order of their class priority.
+	Total Limit
+
+	// ClientRead is the limit of the ClientRead class operations.
+	ClientRead Limit
+
+	// ClientWrite is the limit of the ClientWrite class operations.
+	ClientWrite Limit
+
+	// Replication is the limit of the Replication class operations.
+	Replication Limit
+
+	// Background is the limit of the Background class operations.
+	Background Limit
+}
+
+// Class returns the limit of the operation class.
+// Total limit is returned for the Undefined class.
+func (l Limits) Class(c Class) Limit {
+	switch c {
+	default:
+		return l.Total
+	case ClientRead:
+		return l.ClientRead
+	case ClientWrite:
+		return l.ClientWrite
+	case Replication:
+		return l.Replication
+	case Background:
+		return l.Background
+	}
+}
+
+// SetClass sets the limit of the operation class.
+// Total limit is set for the Undefined class.
+func (l *Limits) SetClass(c Class, v Limit) {
+	switch c {
+	default:
+		l.Total = v
+	case ClientRead:
+		l.ClientRead = v
+	case ClientWrite:
+		l.ClientWrite = v
+	case Replication:
+		l.Replication = v
+	case Background:
+		l.Background = v
+	}
+}
+
+func (l Limits) isZero() bool {
+	return l == Limits{}
+}
+
+// Limiter is a priority-aware I/O rate limiter.
+//
+// Nil Limiter does not limit anything.
+type Limiter struct {
+	enabled *atomic.Bool
+
+	mtx sync.Mutex
+
+	limits Limits
+
+	total   buckets
+	classes [classCount]buckets
+
+	// blocked contains the number of operations
+	// waiting for the total limit by their classes.
+	blocked [classCount]int
+}
+
+type buckets struct {
+	ops, bytes bucket
+}
+
+// bucket is a token bucket with one second burst.
+type bucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// New creates new Limiter with the limits.
+func New(l Limits) *Limiter {
+	res := &Limiter{enabled: atomic.NewBool(false)}
+	res.SetLimits(l)
+
+	return res
+}
+
+// SetLimits changes the limits of the Limiter.
+func (l *Limiter) SetLimits(v Limits) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now()
+
+	l.limits = v
+	l.total.set(v.Total, now)
+	for c := ClientRead; c < classCount; c++ {
+		l.classes[c].set(v.Class(c), now)
+	}
+
+	l.enabled.Store(!v.isZero())
+}
+
+// Limits returns the current limits of the Limiter.
+func (l *Limiter) Limits() Limits {
+	if l == nil {
+		return Limits{}
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	return l.limits
+}
+
+// Wait blocks until the operation of the class processing
+// size bytes is allowed by the limits and accounts it.
+//
+// Undefined class is accounted in the total limit only.
+// Returns the context error if it is done before the operation
+// is allowed, the operation is not accounted in this case.
+func (l *Limiter) Wait(ctx context.Context, c Class, size uint64) error {
+	if l == nil || !l.enabled.Load() {
+		return nil
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	blocked := false
+	defer func() {
+		if blocked {
+			l.blocked[c]--
+		}
+	}()
+
+	for {
+		now := time.Now()
+
+		d := l.classes[c].delay(size, now)
+
+		td := l.total.delay(size, now)
+		if td == 0 && l.preempted(c) {
+			td = preemptionDelay
+		}
+
+		if td > 0 && !blocked {
+			l.blocked[c]++
+			blocked = true
+		}
+
+		if td > d {
+			d = td
+		}
+
+		if d == 0 {
+			l.take(c, 1, size, now)
+			return nil
+		}
+
+		l.mtx.Unlock()
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			l.mtx.Lock()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		l.mtx.Lock()
+	}
+}
+
+// Charge accounts size bytes processed by the operation of the class
+// without waiting. It is used when the size of the data is unknown
+// until the operation is done, the following operations are delayed
+// accordingly.
+func (l *Limiter) Charge(c Class, size uint64) {
+	if l == nil || !l.enabled.Load() || size == 0 {
+		return
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.take(c, 0, size, time.Now())
+}
+
+// preempted checks whether operations of the higher
+// priority classes are waiting for the total limit.
+func (l *Limiter) preempted(c Class) bool {
+	for i := Class(0); i < c; i++ {
+		if l.blocked[i] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Limiter) take(c Class, ops, size uint64, now time.Time) {
+	l.total.take(ops, size, now)
+	l.classes[c].take(ops, size, now)
+}
+
+func (b *buckets) set(l Limit, now time.Time) {
+	b.ops.setRate(l.Ops, now)
+	b.bytes.setRate(l.Bytes, now)
+}
+
+func (b *buckets) delay(size uint64, now time.Time) time.Duration {
+	d := b.ops.delay(1, now)
+	if bd := b.bytes.delay(size, now); bd > d {
+		d = bd
+	}
+	return d
+}
+
+func (b *buckets) take(ops, size uint64, now time.Time) {
+	b.ops.take(ops, now)
+	b.bytes.take(size, now)
+}
+
+func (b *bucket) setRate(rate uint64, now time.Time) {
+	b.refill(now)
+
+	if b.rate == 0 {
+		// start with the full bucket
+		b.tokens = float64(rate)
+	}
+
+	b.rate = float64(rate)
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+	}
+	b.last = now
+}
+
+// delay returns the time to wait until n tokens are available.
+// Bucket is allowed to go in debt for the values bigger than the
+// rate, so at most the rate of tokens is waited for.
+func (b *bucket) delay(n uint64, now time.Time) time.Duration {
+	if b.rate == 0 {
+		return 0
+	}
+
+	b.refill(now)
+
+	need := float64(n)
+	if need < 1 {
+		need = 1
+	} else if need > b.rate {
+		need = b.rate
+	}
+
+	if b.tokens >= need {
+		return 0
+	}
+
+	return time.Duration((need - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *bucket) take(n uint64, now time.Time) {
+	if b.rate > 0 {
+		b.refill(now)
+		b.tokens -= float64(n)
+	}
+}
@@ -0,0 +1,116 @@
+package limiter_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Unlimited(t *testing.T) {
+	var nilLimiter *limiter.Limiter
+	require.NoError(t, nilLimiter.Wait(context.Background(), limiter.ClientRead, 1<<30))
+	nilLimiter.Charge(limiter.ClientRead, 1<<30)
+	require.Equal(t, limiter.Limits{}, nilLimiter.Limits())
+
+	l := limiter.New(limiter.Limits{})
+
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, l.Wait(context.Background(), limiter.Background, 1<<20))
+	}
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestLimiter_Ops(t *testing.T) {
+	const rate = 20
+
+	var lim limiter.Limits
+	lim.SetClass(limiter.Background, limiter.Limit{Ops: rate})
+
+	l := limiter.New(lim)
+	require.Equal(t, lim, l.Limits())
+
+	start := time.Now()
+	for i := 0; i < rate; i++ {
+		require.NoError(t, l.Wait(context.Background(), limiter.Background, 0))
+	}
+	require.Less(t, time.Since(start), 100*time.Millisecond, "burst must not be limited")
+
+	// other classes are not affected
+	require.NoError(t, l.Wait(context.Background(), limiter.ClientRead, 0))
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	for i := 0; i < rate/2; i++ {
+		require.NoError(t, l.Wait(context.Background(), limiter.Background, 0))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
+
+func TestLimiter_Bytes(t *testing.T) {
+	const rate = 1000
+
+	l := limiter.New(limiter.Limits{Total: limiter.Limit{Bytes: rate}})
+
+	start := time.Now()
+	require.NoError(t, l.Wait(context.Background(), limiter.ClientWrite, rate))
+	l.Charge(limiter.ClientRead, rate/2)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// bucket is in debt
+	require.NoError(t, l.Wait(context.Background(), limiter.ClientRead, 0))
+	require.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	l.SetLimits(limiter.Limits{})
+	l.Charge(limiter.ClientRead, 1<<30)
+	require.NoError(t, l.Wait(context.Background(), limiter.ClientRead, 1<<30))
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestLimiter_Priority(t *testing.T) {
+	const rate = 10
+
+	l := limiter.New(limiter.Limits{Total: limiter.Limit{Ops: rate}})
+	for i := 0; i < rate; i++ {
+		require.NoError(t, l.Wait(context.Background(), limiter.Background, 0))
+	}
+
+	var (
+		wg    sync.WaitGroup
+		mtx   sync.Mutex
+		order []limiter.Class
+	)
+
+	wait := func(c limiter.Class) {
+		defer wg.Done()
+
+		require.NoError(t, l.Wait(context.Background(), c, 0))
+
+		mtx.Lock()
+		order = append(order, c)
+		mtx.Unlock()
+	}
+
+	wg.Add(2)
+	go wait(limiter.Background)
+	time.Sleep(10 * time.Millisecond)
+	go wait(limiter.ClientRead)
+	wg.Wait()
+
+	require.Equal(t, []limiter.Class{limiter.ClientRead, limiter.Background}, order)
+}
+
+func TestLimiter_Context(t *testing.T) {
+	l := limiter.New(limiter.Limits{Total: limiter.Limit{Ops: 1}})
+	require.NoError(t, l.Wait(context.Background(), limiter.ClientRead, 0))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	require.ErrorIs(t, l.Wait(ctx, limiter.ClientRead, 0), context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
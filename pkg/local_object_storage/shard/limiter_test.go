@@ -0,0 +1,82 @@
+package shard_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_IOLimits(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	require.Equal(t, limiter.Limits{}, sh.IOLimits())
+
+	const rate = 5
+
+	var limits limiter.Limits
+	limits.SetClass(limiter.ClientWrite, limiter.Limit{Ops: rate})
+
+	sh.SetIOLimits(limits)
+	require.Equal(t, limits, sh.IOLimits())
+	require.Equal(t, limits, sh.DumpInfo().IOLimits)
+
+	put := func(c limiter.Class) {
+		var prm shard.PutPrm
+		prm.SetObject(generateObject(t))
+		prm.SetIOClass(c)
+
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+
+	start := time.Now()
+	for i := 0; i < rate; i++ {
+		put(limiter.Undefined)
+	}
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	// other classes are not limited
+	put(limiter.Background)
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	put(limiter.ClientWrite)
+	put(limiter.ClientWrite)
+	require.GreaterOrEqual(t, time.Since(start), 300*time.Millisecond)
+}
+
+func TestShard_IOLimitsReadOnly(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	const rate = 5
+
+	var limits limiter.Limits
+	limits.SetClass(limiter.ClientWrite, limiter.Limit{Ops: rate})
+	sh.SetIOLimits(limits)
+
+	require.NoError(t, sh.SetMode(mode.ReadOnly))
+
+	var prm shard.PutPrm
+	prm.SetObject(generateObject(t))
+
+	// rejected writes are not charged
+	start := time.Now()
+	for i := 0; i < 2*rate; i++ {
+		_, err := sh.Put(prm)
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	}
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+
+	require.NoError(t, sh.SetMode(mode.ReadWrite))
+
+	for i := 0; i < rate; i++ {
+		_, err := sh.Put(prm)
+		require.NoError(t, err)
+	}
+	require.Less(t, time.Since(start), 200*time.Millisecond)
+}
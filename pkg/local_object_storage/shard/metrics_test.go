@@ -3,6 +3,7 @@ package shard_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
 
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
@@ -59,6 +60,24 @@ func (m metricsStore) SetReadonly(r bool) {
 	}
 }
 
+func (m metricsStore) AddCompressedSize(int, int) {}
+
+func (m metricsStore) IncCompressionSkipped() {}
+
+func (m metricsStore) AddCompaction(int, uint64) {}
+
+func (m metricsStore) AddScrubbedObjects(int, int) {}
+
+func (m metricsStore) AddTierMigrations(int, int) {}
+
+func (m metricsStore) AddWriteCacheBatch(time.Duration) {}
+
+func (m metricsStore) SetFlushBacklog(uint64) {}
+
+func (m metricsStore) AddOperationDuration(string, time.Duration) {}
+
+func (m metricsStore) AddStorageOperationDuration(string, string, time.Duration) {}
+
 const physical = "phy"
 const logical = "logic"
 const readonly = "readonly"
@@ -29,6 +29,12 @@ func (s *Shard) setMode(m mode.Mode) error {
 		zap.Stringer("old_mode", s.info.Mode),
 		zap.Stringer("new_mode", m))
 
+	// blobstor can't change mode until the compaction is finished
+	s.compactor.interrupt()
+	s.scrubber.interrupt()
+	s.tierer.interrupt()
+	s.indexer.interrupt()
+
 	components := []interface{ SetMode(mode.Mode) error }{
 		s.metaBase, s.blobStor,
 	}
@@ -0,0 +1,52 @@
+package shard
+
+import (
+	"fmt"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+)
+
+// OwnerUsagePrm groups the parameters of OwnerUsage operation.
+type OwnerUsagePrm struct {
+	limit int
+}
+
+// OwnerUsageRes groups the resulting values of OwnerUsage operation.
+type OwnerUsageRes struct {
+	usage []meta.OwnerUsage
+}
+
+// SetLimit sets the maximum number of the owners to return.
+// Zero means no limit.
+func (p *OwnerUsagePrm) SetLimit(limit int) {
+	p.limit = limit
+}
+
+// Usage returns the object counters of the owners sorted by the
+// physical size in descending order.
+func (r OwnerUsageRes) Usage() []meta.OwnerUsage {
+	return r.usage
+}
+
+// OwnerUsage returns the object counters of the owners with the
+// largest physical size of the objects stored in the shard.
+func (s *Shard) OwnerUsage(prm OwnerUsagePrm) (OwnerUsageRes, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode.NoMetabase() {
+		return OwnerUsageRes{}, ErrDegradedMode
+	}
+
+	var metaPrm meta.OwnerUsagePrm
+	metaPrm.SetLimit(prm.limit)
+
+	res, err := s.metaBase.OwnerUsage(metaPrm)
+	if err != nil {
+		return OwnerUsageRes{}, fmt.Errorf("could not get owner usage: %w", err)
+	}
+
+	return OwnerUsageRes{
+		usage: res.Usage(),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package shard
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// periodicJob runs the background maintenance routine of the shard
+// with the specified interval. The routine can also be triggered to
+// run out of schedule.
+type periodicJob struct {
+	interval time.Duration
+	run      func(context.Context)
+
+	mtx    sync.Mutex
+	cancel context.CancelFunc
+
+	triggerChannel chan struct{}
+
+	onceStop    sync.Once
+	stopChannel chan struct{}
+	wg          sync.WaitGroup
+}
+
+func newPeriodicJob(interval time.Duration, run func(context.Context)) *periodicJob {
+	return &periodicJob{
+		interval:       interval,
+		run:            run,
+		triggerChannel: make(chan struct{}, 1),
+		stopChannel:    make(chan struct{}),
+	}
+}
+
+func (j *periodicJob) start() {
+	if j.interval <= 0 {
+		return
+	}
+
+	j.wg.Add(1)
+	go j.tick()
+}
+
+func (j *periodicJob) tick() {
+	defer j.wg.Done()
+
+	timer := time.NewTimer(j.interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-j.stopChannel:
+			return
+		case <-j.triggerChannel:
+			if !timer.Stop() {
+				<-timer.C
+			}
+
+			j.runOnce()
+			timer.Reset(j.interval)
+		case <-timer.C:
+			j.runOnce()
+			timer.Reset(j.interval)
+		}
+	}
+}
+
+func (j *periodicJob) runOnce() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	j.mtx.Lock()
+	j.cancel = cancel
+	j.mtx.Unlock()
+
+	j.run(ctx)
+
+	j.mtx.Lock()
+	j.cancel = nil
+	j.mtx.Unlock()
+	cancel()
+}
+
+// trigger makes the started job run the routine as soon as possible.
+// Does nothing if the run is already scheduled.
+func (j *periodicJob) trigger() {
+	if j == nil {
+		return
+	}
+
+	select {
+	case j.triggerChannel <- struct{}{}:
+	default:
+	}
+}
+
+// interrupt cancels the running routine if any.
+func (j *periodicJob) interrupt() {
+	if j == nil {
+		return
+	}
+
+	j.mtx.Lock()
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.mtx.Unlock()
+}
+
+func (j *periodicJob) stop() {
+	j.onceStop.Do(func() {
+		close(j.stopChannel)
+	})
+	j.interrupt()
+	j.wg.Wait()
+}
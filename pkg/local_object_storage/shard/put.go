@@ -1,18 +1,25 @@
 package shard
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
-	obj *object.Object
+	obj     *object.Object
+	ioClass limiter.Class
+	ctx     context.Context
 }
 
 // PutRes groups the resulting values of Put operation.
@@ -23,13 +30,34 @@ func (p *PutPrm) SetObject(obj *object.Object) {
 	p.obj = obj
 }
 
+// SetIOClass is a Put option to set the I/O class of the operation.
+// By default, the operation is limited as limiter.ClientWrite.
+func (p *PutPrm) SetIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// SetContext is a Put option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *PutPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Put saves the object in shard.
 //
 // Returns any error encountered that
 // did not allow to completely save the object.
 //
 // Returns ErrReadOnlyMode error if shard is in "read-only" mode.
-func (s *Shard) Put(prm PutPrm) (PutRes, error) {
+func (s *Shard) Put(prm PutPrm) (_ PutRes, err error) {
+	ctx, span := s.startSpan(prm.ctx, "Put", attribute.Stringer("address", objectCore.AddressOf(prm.obj)))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricPut)()
+
+	ioClass := prm.ioClass
+	if ioClass == limiter.Undefined {
+		ioClass = limiter.ClientWrite
+	}
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -38,6 +66,11 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 		return PutRes{}, ErrReadOnlyMode
 	}
 
+	// rejected writes are not charged
+	if err := s.limiter.Wait(ctx, ioClass, prm.obj.PayloadSize()); err != nil {
+		return PutRes{}, err
+	}
+
 	data, err := prm.obj.Marshal()
 	if err != nil {
 		return PutRes{}, fmt.Errorf("cannot marshal object: %w", err)
@@ -48,13 +81,17 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 	putPrm.RawData = data
 	putPrm.Address = objectCore.AddressOf(prm.obj)
 
-	var res common.PutRes
+	var (
+		res    common.PutRes
+		cached bool
+	)
 
 	// exist check are not performed there, these checks should be executed
 	// ahead of `Put` by storage engine
 	tryCache := s.hasWriteCache() && !m.NoMetabase()
 	if tryCache {
 		res, err = s.writeCache.Put(putPrm)
+		cached = err == nil
 	}
 	if err != nil || !tryCache {
 		if err != nil {
@@ -72,12 +109,19 @@ func (s *Shard) Put(prm PutPrm) (PutRes, error) {
 		var pPrm meta.PutPrm
 		pPrm.SetObject(prm.obj)
 		pPrm.SetStorageID(res.StorageID)
-		if _, err := s.metaBase.Put(pPrm); err != nil {
+		mRes, err := s.metaBase.Put(pPrm)
+		if err != nil {
 			// may we need to handle this case in a special way
 			// since the object has been successfully written to BlobStor
 			return PutRes{}, fmt.Errorf("could not put object to metabase: %w", err)
 		}
 
+		// the corrupted copy kept by the scrubber is replaced with the healthy one,
+		// it is overwritten if the healthy copy is put to the same place
+		if id, ok := mRes.CorruptedCopy(); ok && (cached || !bytes.Equal(id, res.StorageID)) {
+			s.deleteCorruptedCopy(putPrm.Address, id)
+		}
+
 		s.incObjectCounter()
 	}
 
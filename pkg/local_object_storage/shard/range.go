@@ -1,13 +1,18 @@
 package shard
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // RngPrm groups the parameters of GetRange operation.
@@ -19,6 +24,10 @@ type RngPrm struct {
 	addr oid.Address
 
 	skipMeta bool
+
+	ioClass limiter.Class
+
+	ctx context.Context
 }
 
 // RngRes groups the resulting values of GetRange operation.
@@ -45,6 +54,18 @@ func (p *RngPrm) SetIgnoreMeta(ignore bool) {
 	p.skipMeta = ignore
 }
 
+// SetIOClass is a GetRange option to set the I/O class of the operation.
+// By default, the operation is limited as limiter.ClientRead.
+func (p *RngPrm) SetIOClass(c limiter.Class) {
+	p.ioClass = c
+}
+
+// SetContext is a GetRange option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *RngPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // Object returns the requested object part.
 //
 // Instance payload contains the requested range of the original object.
@@ -66,7 +87,13 @@ func (r RngRes) HasMeta() bool {
 // Returns an error of type apistatus.ObjectNotFound if the requested object is missing.
 // Returns an error of type apistatus.ObjectAlreadyRemoved if the requested object has been marked as removed in shard.
 // Returns the object.ErrObjectIsExpired if the object is presented but already expired.
-func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
+func (s *Shard) GetRange(prm RngPrm) (_ RngRes, err error) {
+	ctx, span := s.startSpan(prm.ctx, "GetRange", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricGetRange)()
+
+	ioClass := readIOClass(prm.ioClass)
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -107,7 +134,15 @@ func (s *Shard) GetRange(prm RngPrm) (RngRes, error) {
 	}
 
 	skipMeta := prm.skipMeta || s.info.Mode.NoMetabase()
-	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc)
+	wait := func() error {
+		return s.limiter.Wait(ctx, ioClass, 0)
+	}
+
+	obj, hasMeta, err := s.fetchObjectData(prm.addr, skipMeta, cb, wc, wait)
+	if err == nil {
+		s.limiter.Charge(ioClass, uint64(len(obj.Payload())))
+		s.accessTracker.touch(prm.addr)
+	}
 
 	return RngRes{
 		obj:     obj,
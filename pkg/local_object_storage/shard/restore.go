@@ -4,16 +4,21 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util/logicerr"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 // ErrInvalidMagic is returned when dump format is invalid.
 var ErrInvalidMagic = logicerr.New("invalid magic")
 
+// ErrUnsupportedVersion is returned when dump format version is not supported.
+var ErrUnsupportedVersion = logicerr.New("unsupported dump version")
+
 // RestorePrm groups the parameters of Restore operation.
 type RestorePrm struct {
 	path         string
@@ -40,8 +45,9 @@ func (p *RestorePrm) WithIgnoreErrors(ignore bool) {
 
 // RestoreRes groups the result fields of Restore operation.
 type RestoreRes struct {
-	count  int
-	failed int
+	count   int
+	inhumed int
+	failed  int
 }
 
 // Count return amount of object written.
@@ -49,6 +55,11 @@ func (r RestoreRes) Count() int {
 	return r.count
 }
 
+// InhumeCount return amount of object inhumed by the incremental dump entries.
+func (r RestoreRes) InhumeCount() int {
+	return r.inhumed
+}
+
 // FailCount return amount of object skipped.
 func (r RestoreRes) FailCount() int {
 	return r.failed
@@ -83,12 +94,12 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		return RestoreRes{}, ErrInvalidMagic
 	}
 
-	var putPrm PutPrm
-
-	var count, failCount int
+	var res RestoreRes
 	var data []byte
 	var size [4]byte
-	for {
+	var version uint32 = 1
+
+	for first := true; ; first = false {
 		// If there are less than 4 bytes left, `Read` returns nil error instead of
 		// io.ErrUnexpectedEOF, thus `ReadFull` is used.
 		_, err := io.ReadFull(r, size[:])
@@ -100,35 +111,108 @@ func (s *Shard) Restore(prm RestorePrm) (RestoreRes, error) {
 		}
 
 		sz := binary.LittleEndian.Uint32(size[:])
+		if first && sz == 0 {
+			// Zero size is never written by version 1, version number follows.
+			if _, err := io.ReadFull(r, size[:]); err != nil {
+				return RestoreRes{}, fmt.Errorf("could not read dump version: %w", err)
+			}
+
+			version = binary.LittleEndian.Uint32(size[:])
+			if version != dumpVersion {
+				return RestoreRes{}, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+			}
+			continue
+		}
+
 		if uint32(cap(data)) < sz {
 			data = make([]byte, sz)
 		} else {
 			data = data[:sz]
 		}
 
-		_, err = r.Read(data)
+		_, err = io.ReadFull(r, data)
 		if err != nil {
 			return RestoreRes{}, err
 		}
 
-		obj := object.New()
-		err = obj.Unmarshal(data)
-		if err != nil {
+		typ := dumpEntryObject
+		if version != 1 {
+			if len(data) == 0 {
+				return RestoreRes{}, errors.New("empty dump entry")
+			}
+			typ, data = data[0], data[1:]
+		}
+
+		switch typ {
+		case dumpEntryObject:
+			obj := object.New()
+			err = obj.Unmarshal(data)
+			if err != nil {
+				if prm.ignoreErrors {
+					res.failed++
+					continue
+				}
+				return RestoreRes{}, err
+			}
+
+			var putPrm PutPrm
+			putPrm.SetObject(obj)
+
+			_, err = s.Put(putPrm)
+			if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
+				return RestoreRes{}, err
+			}
+
+			res.count++
+		case dumpEntryInhume:
+			var inhumePrm InhumePrm
+			err = decodeDumpInhume(&inhumePrm, data)
+			if err != nil {
+				if prm.ignoreErrors {
+					res.failed++
+					continue
+				}
+				return RestoreRes{}, err
+			}
+
+			_, err = s.Inhume(inhumePrm)
+			if err != nil {
+				return RestoreRes{}, err
+			}
+
+			res.inhumed++
+		default:
 			if prm.ignoreErrors {
-				failCount++
+				res.failed++
 				continue
 			}
-			return RestoreRes{}, err
+			return RestoreRes{}, fmt.Errorf("unknown dump entry type %d", typ)
 		}
+	}
 
-		putPrm.SetObject(obj)
-		_, err = s.Put(putPrm)
-		if err != nil && !IsErrObjectExpired(err) && !IsErrRemoved(err) {
-			return RestoreRes{}, err
-		}
+	return res, nil
+}
+
+func decodeDumpInhume(prm *InhumePrm, data []byte) error {
+	if len(data) != dumpAddressSize && len(data) != 2*dumpAddressSize {
+		return fmt.Errorf("invalid inhume entry size %d", len(data))
+	}
+
+	var addr oid.Address
+	if err := decodeDumpAddress(&addr, data); err != nil {
+		return fmt.Errorf("invalid inhumed object address: %w", err)
+	}
+
+	if len(data) == dumpAddressSize {
+		prm.MarkAsGarbage(addr)
+		return nil
+	}
 
-		count++
+	var tomb oid.Address
+	if err := decodeDumpAddress(&tomb, data[dumpAddressSize:]); err != nil {
+		return fmt.Errorf("invalid tombstone address: %w", err)
 	}
 
-	return RestoreRes{count: count, failed: failCount}, nil
+	prm.SetTarget(tomb, addr)
+	return nil
 }
@@ -0,0 +1,288 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// scrubBatchSize is the number of objects listed from the metabase at once
+// during the data scrubbing.
+const scrubBatchSize = 100
+
+type scrubCfg struct {
+	interval       time.Duration
+	rateLimit      uint64
+	quarantinePath string
+}
+
+// ScrubStatus describes the state of the shard data scrubbing.
+// Counters are accumulated since the shard has been created.
+type ScrubStatus struct {
+	// Checked is the number of the verified objects.
+	Checked uint64
+
+	// Corrupted is the number of the corrupted objects found.
+	Corrupted uint64
+
+	// Passes is the number of completed passes over all the objects of the shard.
+	Passes uint64
+
+	// LastPassFinishedAt is the time the last pass has been completed at.
+	// Zero if there were no completed passes.
+	LastPassFinishedAt time.Time
+}
+
+// scrubState holds the progress of the data scrubbing between the runs,
+// so the interrupted pass continues from the last verified batch.
+type scrubState struct {
+	mtx sync.Mutex
+
+	cursor *meta.Cursor
+
+	status ScrubStatus
+}
+
+// ScrubStatus returns the state of the data scrubbing of the Shard.
+func (s *Shard) ScrubStatus() ScrubStatus {
+	s.scrubState.mtx.Lock()
+	defer s.scrubState.mtx.Unlock()
+
+	return s.scrubState.status
+}
+
+// scrubBlobStor reads the objects stored in the blobstor and verifies their
+// payload checksum, header signature and ID. Corrupted objects are marked in
+// the metabase, so they are considered missing and get replicated from the
+// healthy copies, see quarantine.
+//
+// Objects are verified only if the shard is in read-write mode.
+// The scrubbing is interrupted on mode change.
+func (s *Shard) scrubBlobStor(ctx context.Context) {
+	s.m.RLock()
+	m := s.info.Mode
+	s.m.RUnlock()
+
+	if m != mode.ReadWrite {
+		return
+	}
+
+	st := s.scrubState
+
+	var (
+		read    uint64
+		started = time.Now()
+	)
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		st.mtx.Lock()
+		cursor := st.cursor
+		st.mtx.Unlock()
+
+		var lPrm meta.ListPrm
+		lPrm.SetCount(scrubBatchSize)
+		lPrm.SetCursor(cursor)
+
+		res, err := s.metaBase.ListWithCursor(lPrm)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				st.mtx.Lock()
+				st.cursor = nil
+				st.status.Passes++
+				st.status.LastPassFinishedAt = time.Now()
+				status := st.status
+				st.mtx.Unlock()
+
+				s.log.Info("data scrubbing pass finished",
+					zap.Uint64("checked", status.Checked),
+					zap.Uint64("corrupted", status.Corrupted))
+			} else {
+				s.log.Warn("could not list objects for data scrubbing", zap.Error(err))
+			}
+			return
+		}
+
+		var checked, corrupted int
+
+		addrs := res.AddressList()
+		for i := range addrs {
+			if ctx.Err() != nil {
+				break
+			}
+
+			n, ok := s.scrubObject(ctx, addrs[i].Address)
+			if !ok {
+				corrupted++
+			}
+			checked++
+
+			read += n
+			if s.scrubCfg.rateLimit > 0 {
+				expected := time.Duration(float64(read) / float64(s.scrubCfg.rateLimit) * float64(time.Second))
+				if d := expected - time.Since(started); d > 0 {
+					select {
+					case <-ctx.Done():
+					case <-time.After(d):
+					}
+				}
+			}
+		}
+
+		st.mtx.Lock()
+		// interrupted batch is verified again on the next run
+		if ctx.Err() == nil {
+			st.cursor = res.Cursor()
+		}
+		st.status.Checked += uint64(checked)
+		st.status.Corrupted += uint64(corrupted)
+		st.mtx.Unlock()
+
+		if s.cfg.metricsWriter != nil && checked > 0 {
+			s.cfg.metricsWriter.AddScrubbedObjects(checked, corrupted)
+		}
+	}
+}
+
+// scrubObject verifies the object stored in the blobstor and quarantines it
+// if the stored data is corrupted. Returns the number of bytes read and
+// false if the object is corrupted.
+//
+// Only the data which has been read but is invalid is considered corrupted.
+// Objects which can't be read are skipped and are verified on the next pass.
+func (s *Shard) scrubObject(ctx context.Context, addr oid.Address) (uint64, bool) {
+	var sPrm meta.StorageIDPrm
+	sPrm.SetAddress(addr)
+
+	sRes, err := s.metaBase.StorageID(sPrm)
+	if err != nil {
+		s.log.Debug("can't get storage ID from metabase",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return 0, true
+	}
+
+	storageID := sRes.StorageID()
+	if storageID == nil {
+		storageID = emptyStorageID
+	}
+
+	if err = s.limiter.Wait(ctx, limiter.Background, 0); err != nil {
+		return 0, true
+	}
+
+	res, err := s.blobStor.Get(common.GetPrm{Address: addr, StorageID: storageID})
+	switch {
+	case err == nil:
+	case errors.As(err, new(apistatus.ObjectNotFound)):
+		// removed concurrently
+		return 0, true
+	case errors.Is(err, common.ErrInvalidData):
+		s.quarantine(addr, sRes.StorageID(), nil, err.Error())
+		return 0, false
+	default:
+		s.log.Warn("could not read object for data scrubbing",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return 0, true
+	}
+
+	s.limiter.Charge(limiter.Background, uint64(len(res.RawData)))
+
+	if err := verifyStoredObject(addr, res.Object); err != nil {
+		s.quarantine(addr, sRes.StorageID(), res.RawData, err.Error())
+		return uint64(len(res.RawData)), false
+	}
+
+	return uint64(len(res.RawData)), true
+}
+
+// verifyStoredObject checks that obj is a valid object with the address addr.
+func verifyStoredObject(addr oid.Address, obj *objectSDK.Object) error {
+	if stored := object.AddressOf(obj); stored != addr {
+		return fmt.Errorf("address mismatch: stored %s", stored)
+	}
+
+	return objectSDK.CheckVerificationFields(obj)
+}
+
+// quarantine marks the object as corrupted in the metabase and saves its
+// data to the quarantine directory if configured. The object is removed
+// from the blobstor only if its data has been saved, otherwise it is kept
+// until a healthy copy is put to the shard.
+func (s *Shard) quarantine(addr oid.Address, storageID []byte, data []byte, reason string) {
+	s.log.Warn("corrupted object found",
+		zap.Stringer("address", addr),
+		zap.String("reason", reason))
+
+	var mPrm meta.MarkCorruptedPrm
+	mPrm.SetAddress(addr)
+	mPrm.SetReason(reason)
+
+	_, err := s.metaBase.MarkCorrupted(mPrm)
+	if err != nil {
+		// keep the data, otherwise the metabase would
+		// reference the missing object
+		s.log.Warn("could not mark object as corrupted",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return
+	}
+
+	if s.scrubCfg.quarantinePath == "" || data == nil {
+		return
+	}
+
+	if err := s.saveToQuarantine(addr, data); err != nil {
+		s.log.Warn("could not save corrupted object to quarantine",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return
+	}
+
+	s.deleteCorruptedCopy(addr, storageID)
+}
+
+// deleteCorruptedCopy removes the corrupted copy of the object from the blobstor.
+func (s *Shard) deleteCorruptedCopy(addr oid.Address, storageID []byte) {
+	if storageID == nil {
+		storageID = emptyStorageID
+	}
+
+	// the object is already marked as corrupted, so the removal is not interrupted
+	_ = s.limiter.Wait(context.Background(), limiter.Background, 0)
+
+	_, err := s.blobStor.Delete(common.DeletePrm{Address: addr, StorageID: storageID})
+	if err != nil && !errors.As(err, new(apistatus.ObjectNotFound)) {
+		s.log.Debug("can't remove corrupted object from blobStor",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+	}
+}
+
+// saveToQuarantine writes the object data to <quarantine path>/<container ID>/<object ID>.
+func (s *Shard) saveToQuarantine(addr oid.Address, data []byte) error {
+	dir := filepath.Join(s.scrubCfg.quarantinePath, addr.Container().EncodeToString())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("could not create quarantine directory: %w", err)
+	}
+
+	return os.WriteFile(filepath.Join(dir, addr.Object().EncodeToString()), data, 0600)
+}
@@ -0,0 +1,199 @@
+package shard_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestShard_Scrub(t *testing.T) {
+	rootPath := t.TempDir()
+	quarantinePath := filepath.Join(rootPath, "quarantine")
+
+	sh := shard.New(
+		shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{{
+				Storage: fstree.New(fstree.WithPath(filepath.Join(rootPath, "blob"))),
+			}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(rootPath, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithScrubInterval(10*time.Millisecond),
+		shard.WithScrubQuarantinePath(quarantinePath))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	cnr := cidtest.ID()
+	objs := make([]*object.Object, 3)
+	for i := range objs {
+		objs[i] = generateObjectWithCID(t, cnr)
+		require.NoError(t, object.SetIDWithSignature(key.PrivateKey, objs[i]))
+
+		var putPrm shard.PutPrm
+		putPrm.SetObject(objs[i])
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+	}
+
+	// flip the last payload byte of the stored object
+	corrupted := objs[1]
+	data, err := corrupted.Marshal()
+	require.NoError(t, err)
+
+	corruptStoredObject(t, filepath.Join(rootPath, "blob"), data, func(stored []byte) []byte {
+		stored[len(stored)-1]++
+		return stored
+	})
+
+	// wait for the pass started after the corruption
+	passes := sh.ScrubStatus().Passes
+	require.Eventually(t, func() bool {
+		return sh.ScrubStatus().Passes > passes+1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	status := sh.ScrubStatus()
+	require.GreaterOrEqual(t, status.Checked, uint64(len(objs)))
+	require.EqualValues(t, 1, status.Corrupted)
+	require.False(t, status.LastPassFinishedAt.IsZero())
+	require.EqualValues(t, 1, sh.DumpInfo().ScrubStatus.Corrupted)
+
+	for i := range objs {
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(objectCore.AddressOf(objs[i]))
+
+		_, err := sh.Get(getPrm)
+		if objs[i] == corrupted {
+			require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+		} else {
+			require.NoError(t, err)
+		}
+	}
+
+	addr := objectCore.AddressOf(corrupted)
+	quarantined, err := os.ReadFile(filepath.Join(quarantinePath,
+		addr.Container().EncodeToString(), addr.Object().EncodeToString()))
+	require.NoError(t, err)
+	require.Len(t, quarantined, len(data))
+	require.NotEqual(t, data, quarantined)
+
+	t.Run("replicated copy", func(t *testing.T) {
+		var putPrm shard.PutPrm
+		putPrm.SetObject(corrupted)
+
+		_, err := sh.Put(putPrm)
+		require.NoError(t, err)
+
+		var getPrm shard.GetPrm
+		getPrm.SetAddress(addr)
+
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, corrupted.Payload(), res.Object().Payload())
+	})
+}
+
+func TestShard_ScrubWithoutQuarantine(t *testing.T) {
+	rootPath := t.TempDir()
+
+	sh := shard.New(
+		shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		shard.WithBlobStorOptions(
+			blobstor.WithStorages([]blobstor.SubStorage{{
+				Storage: fstree.New(fstree.WithPath(filepath.Join(rootPath, "blob"))),
+			}})),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(rootPath, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithScrubInterval(10*time.Millisecond))
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+	require.NoError(t, object.SetIDWithSignature(key.PrivateKey, obj))
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	data, err := obj.Marshal()
+	require.NoError(t, err)
+
+	// truncated data can't be decoded
+	p := corruptStoredObject(t, filepath.Join(rootPath, "blob"), data, func(stored []byte) []byte {
+		return stored[:len(stored)/2]
+	})
+
+	passes := sh.ScrubStatus().Passes
+	require.Eventually(t, func() bool {
+		return sh.ScrubStatus().Passes > passes+1
+	}, 5*time.Second, 10*time.Millisecond)
+	require.EqualValues(t, 1, sh.ScrubStatus().Corrupted)
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(objectCore.AddressOf(obj))
+
+	_, err = sh.Get(getPrm)
+	require.ErrorAs(t, err, new(apistatus.ObjectNotFound))
+
+	// there is no quarantine, so the data is kept
+	stored, err := os.ReadFile(p)
+	require.NoError(t, err)
+	require.Len(t, stored, len(data)/2)
+
+	_, err = sh.Put(putPrm)
+	require.NoError(t, err)
+
+	res, err := sh.Get(getPrm)
+	require.NoError(t, err)
+	require.Equal(t, obj.Payload(), res.Object().Payload())
+}
+
+// corruptStoredObject modifies the file of the object with the given data
+// stored in the FSTree and returns the path to it.
+func corruptStoredObject(t *testing.T, root string, data []byte, corrupt func([]byte) []byte) string {
+	var found string
+	err := filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		stored, err := os.ReadFile(p)
+		if err != nil || !bytes.Equal(stored, data) {
+			return err
+		}
+
+		found = p
+		return os.WriteFile(p, corrupt(stored), info.Mode())
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, found)
+
+	return found
+}
@@ -1,18 +1,25 @@
 package shard
 
 import (
+	"context"
 	"fmt"
 
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SelectPrm groups the parameters of Select operation.
 type SelectPrm struct {
 	cnr     cid.ID
 	filters object.SearchFilters
+	ctx     context.Context
+
+	cursor *oid.ID
+	limit  uint32
 }
 
 // SelectRes groups the resulting values of Select operation.
@@ -30,6 +37,25 @@ func (p *SelectPrm) SetFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
 
+// SetCursor is a Select option to resume the selection after the object
+// with the specified ID. If the cursor or the limit is set, objects are
+// selected in ascending order of their IDs.
+func (p *SelectPrm) SetCursor(cursor *oid.ID) {
+	p.cursor = cursor
+}
+
+// SetLimit is a Select option to set the maximum number of the selected
+// objects. Zero limit means no limit.
+func (p *SelectPrm) SetLimit(limit uint32) {
+	p.limit = limit
+}
+
+// SetContext is a Select option to set the context of the operation.
+// The context is used to trace the operation.
+func (p *SelectPrm) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
 // AddressList returns list of addresses of the selected objects.
 func (r SelectRes) AddressList() []oid.Address {
 	return r.addrList
@@ -39,7 +65,11 @@ func (r SelectRes) AddressList() []oid.Address {
 //
 // Returns any error encountered that
 // did not allow to completely select the objects.
-func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
+func (s *Shard) Select(prm SelectPrm) (_ SelectRes, err error) {
+	_, span := s.startSpan(prm.ctx, "Select", attribute.Stringer("container", prm.cnr))
+	defer func() { tracing.Finish(span, err) }()
+	defer s.elapsed(metricSelect)()
+
 	s.m.RLock()
 	defer s.m.RUnlock()
 
@@ -50,6 +80,8 @@ func (s *Shard) Select(prm SelectPrm) (SelectRes, error) {
 	var selectPrm meta.SelectPrm
 	selectPrm.SetFilters(prm.filters)
 	selectPrm.SetContainerID(prm.cnr)
+	selectPrm.SetCursor(prm.cursor)
+	selectPrm.SetLimit(prm.limit)
 
 	mRes, err := s.metaBase.Select(selectPrm)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -31,6 +32,31 @@ type Shard struct {
 	metaBase *meta.DB
 
 	tsSource TombstoneSource
+
+	weight *weightCache
+
+	limiter *limiter.Limiter
+
+	compactor *periodicJob
+
+	scrubber *periodicJob
+
+	scrubState *scrubState
+
+	tierer *periodicJob
+
+	indexer *periodicJob
+
+	indexState *indexState
+
+	accessTracker *accessTracker
+
+	// migrationLocks protects objects moved between storage tiers from removal
+	migrationLocks *objectLocks
+
+	// coldCursor is the position of the cold objects listing,
+	// accessed by the tiering job only
+	coldCursor *meta.Cursor
 }
 
 // Option represents Shard's constructor option.
@@ -64,6 +90,32 @@ type MetricsWriter interface {
 	SetShardID(id string)
 	// SetReadonly must set shard readonly state.
 	SetReadonly(readonly bool)
+	// AddCompressedSize must account data of originalSize bytes
+	// which was stored compressed to compressedSize bytes.
+	AddCompressedSize(originalSize, compressedSize int)
+	// IncCompressionSkipped must increment the number of objects
+	// stored uncompressed because they were found to be incompressible.
+	IncCompressionSkipped()
+	// AddCompaction must account the number of storage files rewritten
+	// by the compaction and the disk space reclaimed by them.
+	AddCompaction(compacted int, reclaimed uint64)
+	// AddScrubbedObjects must account the number of objects verified
+	// by the data scrubbing and the number of corrupted ones among them.
+	AddScrubbedObjects(checked, corrupted int)
+	// AddTierMigrations must account the number of objects moved
+	// to the hot and to the cold storage tiers.
+	AddTierMigrations(promoted, demoted int)
+	// AddWriteCacheBatch must account the time d the small object PUT
+	// waited for its write-cache database batch to be persisted.
+	AddWriteCacheBatch(d time.Duration)
+	// SetFlushBacklog must set the number of objects in the write-cache
+	// waiting for the flush to the main storage.
+	SetFlushBacklog(count uint64)
+	// AddOperationDuration must account the duration of the op shard operation.
+	AddOperationDuration(op string, d time.Duration)
+	// AddStorageOperationDuration must account the duration of the op
+	// operation performed by the blobstor sub-storage of the storageType type.
+	AddStorageOperationDuration(storageType, op string, d time.Duration)
 }
 
 type cfg struct {
@@ -85,10 +137,22 @@ type cfg struct {
 
 	piloramaOpts []pilorama.Option
 
+	ioLimits *limiter.Limits
+
+	indexSettings *indexSettings
+
 	log *logger.Logger
 
 	gcCfg gcCfg
 
+	compactionCfg compactionCfg
+
+	scrubCfg scrubCfg
+
+	tieringCfg tieringCfg
+
+	dedupMinSize uint64
+
 	expiredTombstonesCallback ExpiredTombstonesCallback
 
 	expiredLocksCallback ExpiredObjectsCallback
@@ -119,14 +183,44 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
-	bs := blobstor.New(c.blobOpts...)
+	blobOpts := c.blobOpts
+	if c.metricsWriter != nil {
+		blobOpts = append(blobOpts,
+			blobstor.WithCompressionMetrics(c.metricsWriter),
+			blobstor.WithMetrics(c.metricsWriter),
+		)
+	}
+
 	mb := meta.New(c.metaOpts...)
 
+	// index is always set to read the objects deduplicated before
+	blobOpts = append(blobOpts, blobstor.WithPayloadDeduplication(c.dedupMinSize, dedupIndex{db: mb}))
+
+	bs := blobstor.New(blobOpts...)
+
+	var ioLimits limiter.Limits
+	if c.ioLimits != nil {
+		ioLimits = *c.ioLimits
+	}
+
 	s := &Shard{
-		cfg:      c,
-		blobStor: bs,
-		metaBase: mb,
-		tsSource: c.tsSource,
+		cfg:            c,
+		blobStor:       bs,
+		metaBase:       mb,
+		tsSource:       c.tsSource,
+		weight:         new(weightCache),
+		limiter:        limiter.New(ioLimits),
+		scrubState:     new(scrubState),
+		indexState:     new(indexState),
+		migrationLocks: newObjectLocks(),
+	}
+
+	if c.tieringCfg.interval > 0 {
+		s.accessTracker = newAccessTracker()
+	}
+
+	if c.indexSettings != nil {
+		s.indexState.setSettings(c.indexSettings.settings)
 	}
 
 	reportFunc := func(msg string, err error) {
@@ -136,11 +230,16 @@ func New(opts ...Option) *Shard {
 	s.blobStor.SetReportErrorFunc(reportFunc)
 
 	if c.useWriteCache {
-		s.writeCache = writecache.New(
-			append(c.writeCacheOpts,
-				writecache.WithReportErrorFunc(reportFunc),
-				writecache.WithBlobstor(bs),
-				writecache.WithMetabase(mb))...)
+		wcOpts := append(c.writeCacheOpts,
+			writecache.WithReportErrorFunc(reportFunc),
+			writecache.WithBlobstor(bs),
+			writecache.WithIOLimiter(s.limiter),
+			writecache.WithMetabase(mb))
+		if c.metricsWriter != nil {
+			wcOpts = append(wcOpts, writecache.WithMetrics(c.metricsWriter))
+		}
+
+		s.writeCache = writecache.New(wcOpts...)
 	}
 
 	if s.piloramaOpts != nil {
@@ -152,6 +251,13 @@ func New(opts ...Option) *Shard {
 	return s
 }
 
+// WithIOLimits returns option to set I/O rate limits of the shard.
+func WithIOLimits(l limiter.Limits) Option {
+	return func(c *cfg) {
+		c.ioLimits = &l
+	}
+}
+
 // WithID returns option to set the default shard identifier.
 func WithID(id *ID) Option {
 	return func(c *cfg) {
@@ -236,6 +342,100 @@ func WithGCRemoverSleepInterval(dur time.Duration) Option {
 	}
 }
 
+// WithCompactionInterval returns option to specify the interval
+// between blobstor compaction runs. Zero value disables the compaction.
+func WithCompactionInterval(dur time.Duration) Option {
+	return func(c *cfg) {
+		c.compactionCfg.interval = dur
+	}
+}
+
+// WithCompactionDeadSpaceThreshold returns option to specify the minimum
+// fraction of the storage file occupied by the removed objects for the
+// file to be compacted.
+func WithCompactionDeadSpaceThreshold(v float64) Option {
+	return func(c *cfg) {
+		c.compactionCfg.deadSpaceThreshold = v
+	}
+}
+
+// WithCompactionRateLimit returns option to specify the maximum number
+// of bytes rewritten by the compaction per second. Zero value means no limit.
+func WithCompactionRateLimit(v uint64) Option {
+	return func(c *cfg) {
+		c.compactionCfg.rateLimit = v
+	}
+}
+
+// WithScrubInterval returns option to specify the interval between
+// the data scrubbing passes. Zero value disables the scrubbing.
+func WithScrubInterval(dur time.Duration) Option {
+	return func(c *cfg) {
+		c.scrubCfg.interval = dur
+	}
+}
+
+// WithScrubRateLimit returns option to specify the maximum number
+// of bytes read by the data scrubbing per second. Zero value means no limit.
+func WithScrubRateLimit(v uint64) Option {
+	return func(c *cfg) {
+		c.scrubCfg.rateLimit = v
+	}
+}
+
+// WithScrubQuarantinePath returns option to specify the directory
+// the corrupted objects found by the data scrubbing are saved to before
+// the removal. Empty value means that corrupted objects are not saved.
+func WithScrubQuarantinePath(p string) Option {
+	return func(c *cfg) {
+		c.scrubCfg.quarantinePath = p
+	}
+}
+
+// WithTieringInterval returns option to specify the interval between
+// the runs moving objects between the storage tiers. Zero value disables
+// the tiering.
+func WithTieringInterval(dur time.Duration) Option {
+	return func(c *cfg) {
+		c.tieringCfg.interval = dur
+	}
+}
+
+// WithTieringColdEpochs returns option to specify the number of epochs
+// without reads after which the object is moved to the cold storage tier.
+func WithTieringColdEpochs(v uint64) Option {
+	return func(c *cfg) {
+		c.tieringCfg.coldEpochs = v
+	}
+}
+
+// WithTieringColdTier returns option to specify the index of the blobstor
+// sub-storage used as the cold storage tier. The last sub-storage is used
+// if the index is not positive or there is no such sub-storage.
+func WithTieringColdTier(ind int) Option {
+	return func(c *cfg) {
+		c.tieringCfg.coldTier = ind
+	}
+}
+
+// WithTieringHotReads returns option to specify the number of reads
+// between the tiering runs after which the object is moved to the hot
+// storage tier.
+func WithTieringHotReads(v uint32) Option {
+	return func(c *cfg) {
+		c.tieringCfg.hotReads = v
+	}
+}
+
+// WithPayloadDeduplication returns option to specify the minimum size
+// of the object payload to be stored once for all objects of the shard
+// with the same payload. Zero value disables the deduplication.
+func WithPayloadDeduplication(minSize uint64) Option {
+	return func(c *cfg) {
+		c.dedupMinSize = minSize
+	}
+}
+
 // WithExpiredTombstonesCallback returns option to specify callback
 // of the expired tombstones handler.
 func WithExpiredTombstonesCallback(cb ExpiredTombstonesCallback) Option {
@@ -339,6 +539,15 @@ func (s *Shard) updateObjectCounter() {
 	}
 }
 
+// ObjectCounters returns physical and logical object counters of the shard.
+func (s *Shard) ObjectCounters() (meta.ObjectCounters, error) {
+	if s.GetMode().NoMetabase() {
+		return meta.ObjectCounters{}, ErrDegradedMode
+	}
+
+	return s.metaBase.ObjectCounters()
+}
+
 // incObjectCounter increment both physical and logical object
 // counters.
 func (s *Shard) incObjectCounter() {
@@ -353,3 +562,30 @@ func (s *Shard) decObjectCounterBy(typ string, v uint64) {
 		s.cfg.metricsWriter.AddToObjectCounter(typ, -int(v))
 	}
 }
+
+// Shard operations accounted by MetricsWriter.
+const (
+	metricGet      = "get"
+	metricHead     = "head"
+	metricGetRange = "range"
+	metricPut      = "put"
+	metricSelect   = "search"
+	metricInhume   = "inhume"
+	metricDelete   = "delete"
+	metricExists   = "exists"
+)
+
+// elapsed returns the function accounting the duration of the op
+// operation since the elapsed call. The duration is not accounted
+// if the metrics writer is not set.
+func (s *Shard) elapsed(op string) func() {
+	if s.cfg.metricsWriter == nil {
+		return func() {}
+	}
+
+	t := time.Now()
+
+	return func() {
+		s.cfg.metricsWriter.AddOperationDuration(op, time.Since(t))
+	}
+}
@@ -0,0 +1,299 @@
+package shard
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// tieringBatchSize is the maximum number of objects moved to the cold
+// tier during a single tiering run.
+const tieringBatchSize = 1000
+
+// maxTrackedObjects is the maximum number of objects which reads are
+// counted between the tiering runs.
+const maxTrackedObjects = 1 << 16
+
+type tieringCfg struct {
+	interval   time.Duration
+	coldEpochs uint64
+	hotReads   uint32
+	coldTier   int
+}
+
+// objectLocks excludes the concurrent operations over the same objects.
+type objectLocks struct {
+	mtx    sync.Mutex
+	cond   *sync.Cond
+	locked map[oid.Address]struct{}
+}
+
+func newObjectLocks() *objectLocks {
+	l := &objectLocks{
+		locked: make(map[oid.Address]struct{}),
+	}
+	l.cond = sync.NewCond(&l.mtx)
+
+	return l
+}
+
+// lock waits until none of the objects is locked and locks all of them.
+func (l *objectLocks) lock(addrs ...oid.Address) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for l.anyLocked(addrs) {
+		l.cond.Wait()
+	}
+
+	for i := range addrs {
+		l.locked[addrs[i]] = struct{}{}
+	}
+}
+
+// unlock unlocks the objects locked by lock.
+func (l *objectLocks) unlock(addrs ...oid.Address) {
+	l.mtx.Lock()
+	for i := range addrs {
+		delete(l.locked, addrs[i])
+	}
+	l.mtx.Unlock()
+
+	l.cond.Broadcast()
+}
+
+func (l *objectLocks) anyLocked(addrs []oid.Address) bool {
+	for i := range addrs {
+		if _, ok := l.locked[addrs[i]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// accessTracker counts the reads of the objects between the tiering runs.
+type accessTracker struct {
+	mtx   sync.Mutex
+	reads map[oid.Address]uint32
+
+	// last epoch received by the shard
+	epoch uint64
+}
+
+func newAccessTracker() *accessTracker {
+	return &accessTracker{
+		reads: make(map[oid.Address]uint32),
+	}
+}
+
+// touch accounts the read of the object.
+func (t *accessTracker) touch(addr oid.Address) {
+	if t == nil {
+		return
+	}
+
+	t.mtx.Lock()
+	if _, ok := t.reads[addr]; ok || len(t.reads) < maxTrackedObjects {
+		t.reads[addr]++
+	}
+	t.mtx.Unlock()
+}
+
+// reset returns the read counters and starts counting from scratch.
+func (t *accessTracker) reset() map[oid.Address]uint32 {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	reads := t.reads
+	t.reads = make(map[oid.Address]uint32, len(reads))
+
+	return reads
+}
+
+// updateTieringEpoch saves the new epoch to mark the objects accessed with.
+func (s *Shard) updateTieringEpoch(_ context.Context, e Event) {
+	atomic.StoreUint64(&s.accessTracker.epoch, e.(newEpoch).epoch)
+}
+
+// tierBlobStor moves the objects between the sub-storages of the blobstor
+// according to their reads: objects read at least hotReads times since the
+// last run are moved to the first (hot) sub-storage, objects of the hot
+// sub-storage not read for coldEpochs epochs are moved to the cold one (the
+// last by default). Storage IDs in the metabase are updated before the old
+// copies are removed.
+//
+// Objects are moved only if the shard is in read-write mode.
+// The tiering is interrupted on mode change.
+func (s *Shard) tierBlobStor(ctx context.Context) {
+	s.m.RLock()
+	m := s.info.Mode
+	s.m.RUnlock()
+
+	if m != mode.ReadWrite {
+		return
+	}
+
+	epoch := atomic.LoadUint64(&s.accessTracker.epoch)
+	if epoch == 0 {
+		// wait for the first epoch to mark the objects with
+		return
+	}
+
+	var (
+		reads    = s.accessTracker.reset()
+		tiers    = len(s.blobStor.DumpInfo().SubStorages)
+		coldTier = s.tieringCfg.coldTier
+		accessed = make([]oid.Address, 0, len(reads))
+		promoted int
+		demoted  int
+	)
+
+	if coldTier <= 0 || coldTier >= tiers {
+		coldTier = tiers - 1
+	}
+
+	if coldTier <= 0 {
+		return
+	}
+
+	for addr, n := range reads {
+		accessed = append(accessed, addr)
+
+		if n >= s.tieringCfg.hotReads && ctx.Err() == nil && s.moveToTier(ctx, addr, 0) {
+			promoted++
+		}
+	}
+
+	// promoted objects are marked too, so they are not demoted immediately
+	s.markAccessed(accessed, epoch)
+
+	if ctx.Err() == nil {
+		var lPrm meta.ListColdPrm
+		lPrm.SetCount(tieringBatchSize)
+		lPrm.SetCursor(s.coldCursor)
+		if epoch > s.tieringCfg.coldEpochs {
+			lPrm.SetAccessedBefore(epoch - s.tieringCfg.coldEpochs)
+		}
+
+		res, err := s.metaBase.ListColdObjects(lPrm)
+		if err != nil {
+			s.log.Warn("could not list cold objects", zap.Error(err))
+		}
+
+		// next run continues the listing, objects are listed from the
+		// beginning when the end is reached
+		s.coldCursor = res.Cursor()
+
+		// objects are tracked since the first run after they are stored
+		s.markAccessed(res.Untracked(), epoch)
+
+		cold := res.AddressList()
+		for i := range cold {
+			if ctx.Err() != nil {
+				break
+			}
+
+			if s.moveToTier(ctx, cold[i], coldTier) {
+				demoted++
+			}
+		}
+	}
+
+	if promoted > 0 || demoted > 0 {
+		if s.cfg.metricsWriter != nil {
+			s.cfg.metricsWriter.AddTierMigrations(promoted, demoted)
+		}
+
+		s.log.Info("objects moved between storage tiers",
+			zap.Int("promoted", promoted),
+			zap.Int("demoted", demoted))
+	}
+}
+
+func (s *Shard) markAccessed(addrs []oid.Address, epoch uint64) {
+	if len(addrs) == 0 {
+		return
+	}
+
+	var prm meta.MarkAccessedPrm
+	prm.SetAddresses(addrs...)
+	prm.SetEpoch(epoch)
+
+	if _, err := s.metaBase.MarkAccessed(prm); err != nil {
+		s.log.Warn("could not save object access epochs", zap.Error(err))
+	}
+}
+
+// moveToTier moves the object to the sub-storage with the index tier.
+// Returns true if the object has been moved.
+func (s *Shard) moveToTier(ctx context.Context, addr oid.Address, tier int) bool {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	if s.info.Mode != mode.ReadWrite {
+		return false
+	}
+
+	if err := s.limiter.Wait(ctx, limiter.Background, 0); err != nil {
+		return false
+	}
+
+	// object must not be removed while it is being moved, otherwise
+	// the new copy is lost
+	s.migrationLocks.lock(addr)
+	defer s.migrationLocks.unlock(addr)
+
+	var sPrm meta.StorageIDPrm
+	sPrm.SetAddress(addr)
+
+	sRes, err := s.metaBase.StorageID(sPrm)
+	if err != nil {
+		s.log.Debug("can't get storage ID from metabase",
+			zap.Stringer("address", addr),
+			zap.Error(err))
+		return false
+	}
+
+	storageID := sRes.StorageID()
+	if storageID == nil {
+		storageID = emptyStorageID
+	}
+
+	res, err := s.blobStor.Migrate(blobstor.MigratePrm{
+		Address:   addr,
+		StorageID: storageID,
+		Tier:      tier,
+		Commit: func(id []byte) error {
+			var uPrm meta.UpdateStorageIDPrm
+			uPrm.SetAddress(addr)
+			uPrm.SetStorageID(id)
+
+			_, err := s.metaBase.UpdateStorageID(uPrm)
+			return err
+		},
+	})
+	if err != nil {
+		// objects in the write-cache are moved after the flush, big
+		// objects don't fit the hot sub-storage
+		if !errors.Is(err, blobstor.ErrTierMismatch) && !errors.As(err, new(apistatus.ObjectNotFound)) {
+			s.log.Warn("could not move object to another storage tier",
+				zap.Stringer("address", addr),
+				zap.Int("tier", tier),
+				zap.Error(err))
+		}
+
+		return false
+	}
+
+	return res.Moved
+}
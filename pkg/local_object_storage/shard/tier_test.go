@@ -0,0 +1,147 @@
+package shard_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/blobovniczatree"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/panjf2000/ants/v2"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// newTieringShard creates the shard with the blobovnicza tree hot tier
+// and the FSTree sub-storages in the fsPaths.
+func newTieringShard(t *testing.T, rootPath string, fsPaths []string, opts ...shard.Option) *shard.Shard {
+	storages := []blobstor.SubStorage{
+		{
+			Storage: blobovniczatree.NewBlobovniczaTree(
+				blobovniczatree.WithRootPath(filepath.Join(rootPath, "blobovnicza")),
+				blobovniczatree.WithBlobovniczaShallowDepth(1),
+				blobovniczatree.WithBlobovniczaShallowWidth(1)),
+			Policy: func(_ *object.Object, data []byte) bool {
+				return len(data) <= 1<<10
+			},
+		},
+	}
+
+	for i := range fsPaths {
+		storages = append(storages, blobstor.SubStorage{
+			Storage: fstree.New(fstree.WithPath(fsPaths[i])),
+		})
+	}
+
+	sh := shard.New(append([]shard.Option{
+		shard.WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		shard.WithBlobStorOptions(blobstor.WithStorages(storages)),
+		shard.WithMetaBaseOptions(
+			meta.WithPath(filepath.Join(rootPath, "meta")),
+			meta.WithEpochState(epochState{})),
+		shard.WithGCWorkerPoolInitializer(func(sz int) util.WorkerPool {
+			pool, err := ants.NewPool(sz)
+			require.NoError(t, err)
+
+			return pool
+		}),
+		shard.WithTieringInterval(10 * time.Millisecond),
+		shard.WithTieringColdEpochs(1),
+	}, opts...)...)
+	require.NoError(t, sh.Open())
+	require.NoError(t, sh.Init())
+	t.Cleanup(func() { releaseShard(sh, t) })
+
+	return sh
+}
+
+func TestShard_Tiering(t *testing.T) {
+	rootPath := t.TempDir()
+	fsPath := filepath.Join(rootPath, "fstree")
+
+	sh := newTieringShard(t, rootPath, []string{fsPath}, shard.WithTieringHotReads(2))
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+	require.Equal(t, 0, countFiles(t, fsPath))
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(objectCore.AddressOf(obj))
+
+	checkGet := func(t *testing.T) {
+		res, err := sh.Get(getPrm)
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+	}
+
+	// object starts being tracked in the first epoch
+	sh.NotificationChannel() <- shard.EventNewEpoch(1)
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 0, countFiles(t, fsPath))
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(3)
+	require.Eventually(t, func() bool {
+		return countFiles(t, fsPath) == 1
+	}, 3*time.Second, 10*time.Millisecond, "cold object must be moved to the FSTree")
+
+	checkGet(t)
+
+	// single read is not enough to promote the object
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, 1, countFiles(t, fsPath))
+
+	for i := 0; i < 2; i++ {
+		checkGet(t)
+	}
+
+	require.Eventually(t, func() bool {
+		return countFiles(t, fsPath) == 0
+	}, 3*time.Second, 10*time.Millisecond, "hot object must be moved to the blobovnicza")
+
+	checkGet(t)
+}
+
+func TestShard_TieringColdTier(t *testing.T) {
+	rootPath := t.TempDir()
+	coldPath := filepath.Join(rootPath, "cold")
+	lastPath := filepath.Join(rootPath, "last")
+
+	sh := newTieringShard(t, rootPath, []string{coldPath, lastPath}, shard.WithTieringColdTier(1))
+
+	obj := generateObjectWithCID(t, cidtest.ID())
+
+	var putPrm shard.PutPrm
+	putPrm.SetObject(obj)
+
+	_, err := sh.Put(putPrm)
+	require.NoError(t, err)
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(1)
+	time.Sleep(100 * time.Millisecond)
+
+	sh.NotificationChannel() <- shard.EventNewEpoch(3)
+	require.Eventually(t, func() bool {
+		return countFiles(t, coldPath) == 1
+	}, 3*time.Second, 10*time.Millisecond, "cold object must be moved to the configured tier")
+	require.Equal(t, 0, countFiles(t, lastPath))
+
+	var getPrm shard.GetPrm
+	getPrm.SetAddress(objectCore.AddressOf(obj))
+
+	res, err := sh.Get(getPrm)
+	require.NoError(t, err)
+	require.Equal(t, obj, res.Object())
+}
@@ -0,0 +1,19 @@
+package shard
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startSpan starts the span of the shard operation as a child of the span
+// from ctx. Shard ID is added to the span attributes if it is set.
+func (s *Shard) startSpan(ctx context.Context, op string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if id := s.ID(); id != nil {
+		attrs = append(attrs, attribute.Stringer("shard_id", id))
+	}
+
+	return tracing.StartSpan(ctx, "shard."+op, attrs...)
+}
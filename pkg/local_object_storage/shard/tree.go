@@ -111,3 +111,11 @@ func (s *Shard) TreeExists(cid cidSDK.ID, treeID string) (bool, error) {
 	}
 	return s.pilorama.TreeExists(cid, treeID)
 }
+
+// TreeListAll returns the identifiers of all the trees stored in the shard.
+func (s *Shard) TreeListAll() ([]pilorama.ContainerIDTreeID, error) {
+	if s.pilorama == nil {
+		return nil, ErrPiloramaDisabled
+	}
+	return s.pilorama.TreeListAll()
+}
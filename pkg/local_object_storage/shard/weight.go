@@ -1,12 +1,79 @@
 package shard
 
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// weightValuesTTL is the time the calculated weight values are reused for.
+const weightValuesTTL = 5 * time.Second
+
 // WeightValues groups values of Shard weight parameters.
 type WeightValues struct {
 	// Amount of free disk space. Measured in kilobytes.
 	FreeSpace uint64
+
+	// Total disk space. Measured in kilobytes.
+	Capacity uint64
+}
+
+// FillRatio returns the used part of the disk space in the [0, 1] range.
+// Zero is returned if the capacity is unknown.
+func (v WeightValues) FillRatio() float64 {
+	if v.Capacity == 0 || v.FreeSpace >= v.Capacity {
+		return 0
+	}
+
+	return float64(v.Capacity-v.FreeSpace) / float64(v.Capacity)
+}
+
+// weightCache holds the last calculated weight values of the Shard.
+type weightCache struct {
+	mtx       sync.Mutex
+	updatedAt time.Time
+	values    WeightValues
 }
 
 // WeightValues returns current weight values of the Shard.
+//
+// Values are taken from the file system containing the BLOB storage
+// components. If they are located on different file systems,
+// the values of the most filled one are returned.
 func (s *Shard) WeightValues() WeightValues {
-	return s.info.WeightValues
+	s.weight.mtx.Lock()
+	defer s.weight.mtx.Unlock()
+
+	if time.Since(s.weight.updatedAt) < weightValuesTTL {
+		return s.weight.values
+	}
+
+	var (
+		res   WeightValues
+		found bool
+	)
+
+	for _, sub := range s.blobStor.DumpInfo().SubStorages {
+		v, err := diskUsage(sub.Path)
+		if err != nil {
+			s.log.Debug("could not get disk usage",
+				zap.String("path", sub.Path),
+				zap.Error(err))
+			continue
+		}
+
+		if !found || v.FillRatio() > res.FillRatio() {
+			res = v
+			found = true
+		}
+	}
+
+	// keep the previous values if none have been calculated
+	if found {
+		s.weight.values = res
+	}
+	s.weight.updatedAt = time.Now()
+
+	return s.weight.values
 }
@@ -0,0 +1,11 @@
+//go:build windows || plan9 || js
+// +build windows plan9 js
+
+package shard
+
+import "errors"
+
+// diskUsage is not supported on this platform.
+func diskUsage(string) (WeightValues, error) {
+	return WeightValues{}, errors.New("disk usage is not supported on this platform")
+}
@@ -0,0 +1,28 @@
+package shard_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_WeightValues(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	v := sh.WeightValues()
+	require.NotZero(t, v.Capacity)
+	require.LessOrEqual(t, v.FreeSpace, v.Capacity)
+	require.Equal(t, v, sh.DumpInfo().WeightValues)
+
+	ratio := v.FillRatio()
+	require.True(t, ratio >= 0 && ratio <= 1, ratio)
+
+	t.Run("fill ratio", func(t *testing.T) {
+		require.Zero(t, shard.WeightValues{}.FillRatio())
+		require.Zero(t, shard.WeightValues{FreeSpace: 10, Capacity: 10}.FillRatio())
+		require.Equal(t, 0.75, shard.WeightValues{FreeSpace: 25, Capacity: 100}.FillRatio())
+		require.Equal(t, 1.0, shard.WeightValues{Capacity: 100}.FillRatio())
+	})
+}
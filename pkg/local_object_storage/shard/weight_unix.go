@@ -0,0 +1,23 @@
+//go:build !windows && !plan9 && !js
+// +build !windows,!plan9,!js
+
+package shard
+
+import "syscall"
+
+// diskUsage returns weight values of the file system containing the path.
+func diskUsage(path string) (WeightValues, error) {
+	var st syscall.Statfs_t
+
+	err := syscall.Statfs(path, &st)
+	if err != nil {
+		return WeightValues{}, err
+	}
+
+	bsize := uint64(st.Bsize)
+
+	return WeightValues{
+		FreeSpace: uint64(st.Bavail) * bsize / 1024,
+		Capacity:  uint64(st.Blocks) * bsize / 1024,
+	}, nil
+}
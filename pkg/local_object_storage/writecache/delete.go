@@ -42,6 +42,7 @@ func (c *cache) Delete(addr oid.Address) error {
 			storagelog.OpField("db DELETE"),
 		)
 		c.objCounters.DecDB()
+		c.pending.remove(saddr)
 		return nil
 	}
 
@@ -53,6 +54,7 @@ func (c *cache) Delete(addr oid.Address) error {
 			storagelog.OpField("fstree DELETE"),
 		)
 		c.objCounters.DecFS()
+		c.pending.remove(saddr)
 	}
 
 	return err
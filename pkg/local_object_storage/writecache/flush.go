@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.etcd.io/bbolt"
@@ -42,14 +44,25 @@ func (c *cache) runFlushLoop() {
 	go func() {
 		defer c.wg.Done()
 
-		tt := time.NewTimer(defaultFlushInterval)
+		tt := time.NewTimer(c.flushInterval)
 		defer tt.Stop()
 
 		for {
 			select {
 			case <-tt.C:
-				c.flushDB()
-				tt.Reset(defaultFlushInterval)
+				next := c.flushInterval
+
+				switch c.currentFlushMode() {
+				case flushRegular:
+					c.flushDB(c.flushMinAge)
+				case flushAggressive:
+					if c.flushDB(0) > 0 {
+						next = 0
+					}
+				}
+
+				c.reportFlushBacklog()
+				tt.Reset(next)
 			case <-c.closeCh:
 				return
 			}
@@ -57,13 +70,16 @@ func (c *cache) runFlushLoop() {
 	}()
 }
 
-func (c *cache) flushDB() {
+// flushDB sends the objects from the database which have been stored for
+// at least minAge to the flush workers. Returns the number of sent objects.
+func (c *cache) flushDB(minAge time.Duration) int {
 	var lastKey []byte
 	var m []objectInfo
+	var total int
 	for {
 		select {
 		case <-c.closeCh:
-			return
+			return total
 		default:
 		}
 
@@ -107,27 +123,41 @@ func (c *cache) flushDB() {
 			return nil
 		})
 
-		var count int
+		var count, young int
 		for i := range m {
 			if c.flushed.Contains(m[i].addr) {
 				continue
 			}
 
+			if c.tooYoung(m[i].addr, minAge) {
+				young++
+				continue
+			}
+
 			obj := object.New()
 			if err := obj.Unmarshal(m[i].data); err != nil {
 				continue
 			}
 
+			// the object may still wait for the flush workers since
+			// the previous pass, don't flush it twice
+			if !c.pending.queue(m[i].addr) {
+				continue
+			}
+
 			count++
 			select {
 			case c.flushCh <- obj:
 			case <-c.closeCh:
+				c.pending.unqueue(m[i].addr)
 				c.modeMtx.RUnlock()
-				return
+				return total
 			}
 		}
 
-		if count == 0 {
+		total += count
+
+		if count == 0 && young == 0 {
 			c.modeMtx.RUnlock()
 			break
 		}
@@ -138,22 +168,42 @@ func (c *cache) flushDB() {
 			zap.Int("count", count),
 			zap.String("start", base58.Encode(lastKey)))
 	}
+
+	return total
 }
 
 func (c *cache) flushBigObjects() {
 	defer c.wg.Done()
 
-	tick := time.NewTicker(defaultFlushInterval * 10)
+	// big objects are flushed every 10th flush interval in regular mode
+	var ticks int
+
+	tick := time.NewTicker(c.flushInterval)
 	for {
 		select {
 		case <-tick.C:
+			minAge := c.flushMinAge
+
+			switch c.currentFlushMode() {
+			case flushIdle:
+				continue
+			case flushRegular:
+				if ticks++; ticks < 10 {
+					continue
+				}
+			case flushAggressive:
+				minAge = 0
+			}
+
+			ticks = 0
+
 			c.modeMtx.RLock()
 			if c.readOnly() {
 				c.modeMtx.RUnlock()
 				break
 			}
 
-			_ = c.flushFSTree(true)
+			_ = c.flushFSTree(true, minAge)
 
 			c.modeMtx.RUnlock()
 		case <-c.closeCh:
@@ -172,7 +222,9 @@ func (c *cache) reportFlushError(msg string, addr string, err error) {
 	}
 }
 
-func (c *cache) flushFSTree(ignoreErrors bool) error {
+// flushFSTree flushes the objects from the FSTree which have been stored
+// for at least minAge.
+func (c *cache) flushFSTree(ignoreErrors bool, minAge time.Duration) error {
 	var prm common.IteratePrm
 	prm.IgnoreErrors = ignoreErrors
 	prm.LazyHandler = func(addr oid.Address, f func() ([]byte, error)) error {
@@ -182,6 +234,10 @@ func (c *cache) flushFSTree(ignoreErrors bool) error {
 			return nil
 		}
 
+		if c.tooYoung(sAddr, minAge) {
+			return nil
+		}
+
 		data, err := f()
 		if err != nil {
 			c.reportFlushError("can't read a file", sAddr, err)
@@ -211,6 +267,7 @@ func (c *cache) flushFSTree(ignoreErrors bool) error {
 
 		// mark object as flushed
 		c.flushed.Add(sAddr, false)
+		c.pending.remove(sAddr)
 
 		return nil
 	}
@@ -232,9 +289,14 @@ func (c *cache) flushWorker(_ int) {
 			return
 		}
 
+		sAddr := objectCore.AddressOf(obj).EncodeToString()
+
 		err := c.flushObject(obj, nil)
 		if err == nil {
-			c.flushed.Add(objectCore.AddressOf(obj).EncodeToString(), true)
+			c.flushed.Add(sAddr, true)
+			c.pending.remove(sAddr)
+		} else {
+			c.pending.unqueue(sAddr)
 		}
 	}
 }
@@ -247,6 +309,9 @@ func (c *cache) flushObject(obj *object.Object, data []byte) error {
 	prm.Object = obj
 	prm.RawData = data
 
+	// background context never makes the limiter fail
+	_ = c.ioLimiter.Wait(context.Background(), limiter.Background, uint64(len(data)))
+
 	res, err := c.blobstor.Put(prm)
 	if err != nil {
 		if !errors.Is(err, common.ErrNoSpace) && !errors.Is(err, common.ErrReadOnly) &&
@@ -280,7 +345,7 @@ func (c *cache) Flush(ignoreErrors bool) error {
 }
 
 func (c *cache) flush(ignoreErrors bool) error {
-	if err := c.flushFSTree(ignoreErrors); err != nil {
+	if err := c.flushFSTree(ignoreErrors, 0); err != nil {
 		return err
 	}
 
@@ -315,6 +380,8 @@ func (c *cache) flush(ignoreErrors bool) error {
 			if err := c.flushObject(&obj, data); err != nil {
 				return err
 			}
+
+			c.pending.remove(sa)
 		}
 		return nil
 	})
@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"errors"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
@@ -18,7 +19,9 @@ func (c *cache) initFlushMarks() {
 	var prm common.IteratePrm
 	prm.LazyHandler = func(addr oid.Address, _ func() ([]byte, error)) error {
 		flushed, needRemove := c.flushStatus(addr)
-		if flushed {
+		if !flushed {
+			c.pending.add(addr.EncodeToString(), true, time.Time{})
+		} else {
 			c.store.flushed.Add(addr.EncodeToString(), true)
 			if needRemove {
 				var prm common.DeletePrm
@@ -65,7 +68,9 @@ func (c *cache) initFlushMarks() {
 			}
 
 			flushed, needRemove := c.flushStatus(addr)
-			if flushed {
+			if !flushed {
+				c.pending.add(m[i], false, time.Time{})
+			} else {
 				c.store.flushed.Add(addr.EncodeToString(), true)
 				if needRemove {
 					indices = append(indices, i)
@@ -6,6 +6,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
@@ -55,6 +56,28 @@ type options struct {
 	noSync bool
 	// reportError is the function called when encountering disk errors in background workers.
 	reportError func(string, error)
+	// ioLimiter limits the rate of flushing objects to the main storage.
+	ioLimiter *limiter.Limiter
+	// metrics is the write-cache metrics writer.
+	metrics MetricsWriter
+	// flushInterval is the time between the successive flushes.
+	flushInterval time.Duration
+	// flushMinAge is the minimum time the object stays in the write-cache before the flush.
+	flushMinAge time.Duration
+	// flushLowWatermark is the flush backlog fraction below which objects are not flushed.
+	flushLowWatermark float64
+	// flushHighWatermark is the flush backlog fraction above which objects are flushed aggressively.
+	flushHighWatermark float64
+}
+
+// MetricsWriter is an interface that must store write-cache metrics.
+type MetricsWriter interface {
+	// AddWriteCacheBatch must account the time d the small object PUT
+	// waited for its database batch to be persisted.
+	AddWriteCacheBatch(d time.Duration)
+	// SetFlushBacklog must set the number of objects waiting
+	// for the flush to the main storage.
+	SetFlushBacklog(count uint64)
 }
 
 // WithLogger sets logger.
@@ -78,6 +101,13 @@ func WithBlobstor(bs *blobstor.BlobStor) Option {
 	}
 }
 
+// WithIOLimiter sets I/O rate limiter of the flush operations.
+func WithIOLimiter(l *limiter.Limiter) Option {
+	return func(o *options) {
+		o.ioLimiter = l
+	}
+}
+
 // WithMetabase sets metabase.
 func WithMetabase(db *meta.DB) Option {
 	return func(o *options) {
@@ -146,6 +176,43 @@ func WithNoSync(noSync bool) Option {
 	}
 }
 
+// WithFlushInterval sets time interval between the successive flushes.
+func WithFlushInterval(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.flushInterval = d
+		}
+	}
+}
+
+// WithFlushMinAge sets the minimum time the object stays in the write-cache
+// before it is flushed to the main storage, so the objects removed shortly
+// after they are stored never reach it. Objects stored before the write-cache
+// is opened are considered old enough.
+func WithFlushMinAge(d time.Duration) Option {
+	return func(o *options) {
+		o.flushMinAge = d
+	}
+}
+
+// WithFlushWatermarks sets the fractions of the write-cache capacity occupied
+// by the objects not flushed yet: objects are not flushed below the low
+// watermark and are flushed ignoring the flush interval and the minimum age
+// above the high one. Zero values disable watermarks.
+func WithFlushWatermarks(low, high float64) Option {
+	return func(o *options) {
+		o.flushLowWatermark = low
+		o.flushHighWatermark = high
+	}
+}
+
+// WithMetrics sets the writer of the write-cache metrics.
+func WithMetrics(m MetricsWriter) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
 // WithReportErrorFunc sets error reporting function.
 func WithReportErrorFunc(f func(string, error)) Option {
 	return func(o *options) {
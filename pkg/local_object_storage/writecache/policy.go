@@ -0,0 +1,186 @@
+package writecache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// flushMode defines how intensively the objects are flushed to the main storage.
+type flushMode uint8
+
+const (
+	// flushRegular flushes the objects older than the minimum age
+	// once per flush interval.
+	flushRegular flushMode = iota
+	// flushIdle doesn't flush the objects.
+	flushIdle
+	// flushAggressive flushes all the objects without waiting
+	// for the next flush interval.
+	flushAggressive
+)
+
+// pendingObjects tracks the objects not flushed to the main storage yet.
+type pendingObjects struct {
+	mtx sync.Mutex
+	m   map[string]pendingObject
+
+	small, big uint64
+}
+
+type pendingObject struct {
+	// putAt is the time the object has been put at, zero
+	// if the object has been put before the write-cache is opened.
+	putAt time.Time
+	big   bool
+	// queued is true iff the object has been sent to the flush workers
+	// and is not handled yet.
+	queued bool
+}
+
+func newPendingObjects() *pendingObjects {
+	return &pendingObjects{m: make(map[string]pendingObject)}
+}
+
+// add tracks the object put at putAt. Returns false if the object
+// is already tracked.
+func (p *pendingObjects) add(addr string, big bool, putAt time.Time) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if _, ok := p.m[addr]; ok {
+		return false
+	}
+
+	p.m[addr] = pendingObject{putAt: putAt, big: big}
+	if big {
+		p.big++
+	} else {
+		p.small++
+	}
+
+	return true
+}
+
+func (p *pendingObjects) remove(addr string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	o, ok := p.m[addr]
+	if !ok {
+		return
+	}
+
+	delete(p.m, addr)
+	if o.big {
+		p.big--
+	} else {
+		p.small--
+	}
+}
+
+// queue marks the object as sent to the flush workers. Returns false if
+// the object is already queued.
+func (p *pendingObjects) queue(addr string) bool {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	o, ok := p.m[addr]
+	if !ok {
+		return true
+	} else if o.queued {
+		return false
+	}
+
+	o.queued = true
+	p.m[addr] = o
+
+	return true
+}
+
+// unqueue marks the object as handled by the flush workers,
+// so it can be queued again.
+func (p *pendingObjects) unqueue(addr string) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if o, ok := p.m[addr]; ok {
+		o.queued = false
+		p.m[addr] = o
+	}
+}
+
+// putAt returns the time the object has been put at. Returns zero time
+// if the object is unknown.
+func (p *pendingObjects) putAt(addr string) time.Time {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.m[addr].putAt
+}
+
+// count returns the number of the small and the big pending objects.
+func (p *pendingObjects) count() (small, big uint64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.small, p.big
+}
+
+// checkFlushWatermarks checks that the flush watermarks are either
+// disabled or set correctly.
+func (c *cache) checkFlushWatermarks() error {
+	if c.flushHighWatermark == 0 && c.flushLowWatermark == 0 {
+		return nil
+	}
+
+	if c.flushLowWatermark < 0 || c.flushHighWatermark > 1 || c.flushLowWatermark >= c.flushHighWatermark {
+		return errors.New("invalid flush watermarks: expected 0 <= low < high <= 1")
+	}
+
+	return nil
+}
+
+// flushBacklog returns the fraction of the write-cache capacity occupied
+// by the objects not flushed to the main storage yet. It is estimated the
+// same way as the occupied size of the write-cache.
+func (c *cache) flushBacklog() float64 {
+	small, big := c.pending.count()
+	return float64(small*c.smallObjectSize+big*c.maxObjectSize) / float64(c.maxCacheSize)
+}
+
+// currentFlushMode returns the flush mode according to the flush watermarks.
+func (c *cache) currentFlushMode() flushMode {
+	if c.flushHighWatermark == 0 {
+		return flushRegular
+	}
+
+	switch backlog := c.flushBacklog(); {
+	case backlog >= c.flushHighWatermark:
+		return flushAggressive
+	case backlog < c.flushLowWatermark:
+		return flushIdle
+	default:
+		return flushRegular
+	}
+}
+
+// tooYoung checks whether the object has been put less than minAge ago.
+func (c *cache) tooYoung(addr string, minAge time.Duration) bool {
+	if minAge <= 0 {
+		return false
+	}
+
+	putAt := c.pending.putAt(addr)
+	return !putAt.IsZero() && time.Since(putAt) < minAge
+}
+
+// reportFlushBacklog reports the number of objects waiting for the flush.
+func (c *cache) reportFlushBacklog() {
+	if c.metrics == nil {
+		return
+	}
+
+	small, big := c.pending.count()
+	c.metrics.SetFlushBacklog(small + big)
+}
@@ -0,0 +1,134 @@
+package writecache
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestFlushPolicy(t *testing.T) {
+	const smallSize = 1024
+
+	newCache := func(t *testing.T, opts ...Option) (Cache, *blobstor.BlobStor) {
+		dir := t.TempDir()
+		mb := meta.New(
+			meta.WithPath(filepath.Join(dir, "meta")),
+			meta.WithEpochState(dummyEpoch{}))
+		require.NoError(t, mb.Open(false))
+		require.NoError(t, mb.Init())
+
+		bs := blobstor.New(blobstor.WithStorages([]blobstor.SubStorage{
+			{Storage: fstree.New(fstree.WithPath(filepath.Join(dir, "blob")))},
+		}))
+		require.NoError(t, bs.Open(false))
+		require.NoError(t, bs.Init())
+
+		wc := New(
+			append([]Option{
+				WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+				WithPath(filepath.Join(dir, "writecache")),
+				WithSmallObjectSize(smallSize),
+				WithMaxObjectSize(2 * smallSize),
+				WithMaxCacheSize(20 * smallSize),
+				WithMetabase(mb),
+				WithBlobstor(bs),
+				WithFlushInterval(10 * time.Millisecond),
+			}, opts...)...)
+		require.NoError(t, wc.Open(false))
+		require.NoError(t, wc.Init())
+
+		t.Cleanup(func() {
+			require.NoError(t, wc.Close())
+			require.NoError(t, bs.Close())
+			require.NoError(t, mb.Close())
+		})
+
+		return wc, bs
+	}
+
+	flushed := func(t *testing.T, bs *blobstor.BlobStor, obj objectPair) bool {
+		res, err := bs.Exists(common.ExistsPrm{Address: obj.addr})
+		require.NoError(t, err)
+		return res.Exists
+	}
+
+	t.Run("min age", func(t *testing.T) {
+		const minAge = 300 * time.Millisecond
+
+		wc, bs := newCache(t, WithFlushMinAge(minAge))
+
+		obj := putObject(t, wc, 1)
+		removed := putObject(t, wc, 1)
+		require.NoError(t, wc.Delete(removed.addr))
+
+		time.Sleep(minAge / 3)
+		require.False(t, flushed(t, bs, obj))
+
+		require.Eventually(t, func() bool {
+			return flushed(t, bs, obj)
+		}, 3*time.Second, 10*time.Millisecond)
+		require.False(t, flushed(t, bs, removed))
+	})
+
+	t.Run("invalid watermarks", func(t *testing.T) {
+		wc := New(
+			WithPath(t.TempDir()),
+			WithFlushWatermarks(0.8, 0.2))
+		require.Error(t, wc.Open(false))
+	})
+
+	t.Run("watermarks", func(t *testing.T) {
+		wc, bs := newCache(t,
+			WithFlushMinAge(time.Hour),
+			WithFlushWatermarks(0.1, 0.2))
+
+		// small object takes 1/20 of the capacity, big one takes 1/10
+		objs := make([]objectPair, 3)
+		objs[0] = putObject(t, wc, 1)
+
+		// below the low watermark
+		time.Sleep(100 * time.Millisecond)
+		require.False(t, flushed(t, bs, objs[0]))
+
+		// between the watermarks, objects are too young
+		objs[1] = putObject(t, wc, 1)
+
+		time.Sleep(100 * time.Millisecond)
+		for i := range objs[:2] {
+			require.False(t, flushed(t, bs, objs[i]))
+		}
+
+		// above the high watermark, minimum age is ignored
+		objs[2] = putObject(t, wc, smallSize+1)
+		for i := range objs {
+			require.Eventually(t, func() bool {
+				return flushed(t, bs, objs[i])
+			}, 3*time.Second, 10*time.Millisecond)
+		}
+	})
+}
+
+func TestPendingObjects_Queue(t *testing.T) {
+	p := newPendingObjects()
+
+	const addr = "addr"
+	require.True(t, p.add(addr, false, time.Now()))
+	require.False(t, p.add(addr, false, time.Now()))
+
+	require.True(t, p.queue(addr))
+	require.False(t, p.queue(addr), "queued object must not be sent twice")
+
+	p.unqueue(addr)
+	require.True(t, p.queue(addr))
+
+	p.remove(addr)
+	require.True(t, p.queue(addr), "untracked objects are always sent")
+}
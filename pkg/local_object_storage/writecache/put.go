@@ -2,6 +2,7 @@ package writecache
 
 import (
 	"errors"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/common"
 	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
@@ -48,18 +49,33 @@ func (c *cache) putSmall(obj objectInfo) error {
 		return ErrOutOfSpace
 	}
 
+	// the object is tracked before it is written, so the flush
+	// workers never see it without the put time
+	added := c.pending.add(obj.addr, false, time.Now())
+
+	start := time.Now()
+
 	err := c.db.Batch(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(defaultBucket)
 		return b.Put([]byte(obj.addr), obj.data)
 	})
-	if err == nil {
-		storagelog.Write(c.log,
-			storagelog.AddressField(obj.addr),
-			storagelog.StorageTypeField(wcStorageType),
-			storagelog.OpField("db PUT"),
-		)
-		c.objCounters.IncDB()
+	if c.metrics != nil {
+		c.metrics.AddWriteCacheBatch(time.Since(start))
+	}
+	if err != nil {
+		if added {
+			c.pending.remove(obj.addr)
+		}
+		return err
 	}
+
+	storagelog.Write(c.log,
+		storagelog.AddressField(obj.addr),
+		storagelog.StorageTypeField(wcStorageType),
+		storagelog.OpField("db PUT"),
+	)
+	c.objCounters.IncDB()
+
 	return nil
 }
 
@@ -70,8 +86,13 @@ func (c *cache) putBig(addr string, prm common.PutPrm) error {
 		return ErrOutOfSpace
 	}
 
+	added := c.pending.add(addr, true, time.Now())
+
 	_, err := c.fsTree.Put(prm)
 	if err != nil {
+		if added {
+			c.pending.remove(addr)
+		}
 		return err
 	}
 
@@ -0,0 +1,73 @@
+package writecache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+type batchMetrics struct {
+	mtx     sync.Mutex
+	batches []time.Duration
+}
+
+func (m *batchMetrics) AddWriteCacheBatch(d time.Duration) {
+	m.mtx.Lock()
+	m.batches = append(m.batches, d)
+	m.mtx.Unlock()
+}
+
+func (m *batchMetrics) SetFlushBacklog(uint64) {}
+
+func TestPutBatch(t *testing.T) {
+	const (
+		objCount = 64
+		delay    = 50 * time.Millisecond
+	)
+
+	m := new(batchMetrics)
+	wc := New(
+		WithLogger(&logger.Logger{Logger: zaptest.NewLogger(t)}),
+		WithPath(t.TempDir()),
+		WithMaxBatchSize(objCount/4),
+		WithMaxBatchDelay(delay),
+		WithNoSync(true),
+		WithMetrics(m))
+	require.NoError(t, wc.Open(false))
+	t.Cleanup(func() { require.NoError(t, wc.Close()) })
+
+	var (
+		wg      sync.WaitGroup
+		objects = make([]objectPair, objCount)
+	)
+
+	for i := range objects {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			objects[i] = putObject(t, wc, 1)
+		}(i)
+	}
+	wg.Wait()
+
+	require.Len(t, m.batches, objCount)
+
+	for i := range objects {
+		obj, err := wc.Get(objects[i].addr)
+		require.NoError(t, err)
+		require.Equal(t, objects[i].obj, obj)
+	}
+
+	// a single object waits for the batch delay
+	start := time.Now()
+	obj := putObject(t, wc, 1)
+	require.GreaterOrEqual(t, time.Since(start), delay)
+
+	res, err := wc.Get(obj.addr)
+	require.NoError(t, err)
+	require.Equal(t, obj.obj, res)
+}
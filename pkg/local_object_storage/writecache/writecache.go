@@ -65,6 +65,8 @@ type cache struct {
 	store
 	// fsTree contains big files stored directly on file-system.
 	fsTree *fstree.FSTree
+	// pending contains objects not flushed to the main storage yet.
+	pending *pendingObjects
 }
 
 // wcStorageType is used for write-cache operations logging.
@@ -93,6 +95,7 @@ func New(opts ...Option) Cache {
 		mode:    mode.ReadWrite,
 
 		compressFlags: make(map[string]struct{}),
+		pending:       newPendingObjects(),
 		options: options{
 			log:             &logger.Logger{Logger: zap.NewNop()},
 			maxObjectSize:   defaultMaxObjectSize,
@@ -101,6 +104,7 @@ func New(opts ...Option) Cache {
 			maxCacheSize:    defaultMaxCacheSize,
 			maxBatchSize:    bbolt.DefaultMaxBatchSize,
 			maxBatchDelay:   bbolt.DefaultMaxBatchDelay,
+			flushInterval:   defaultFlushInterval,
 		},
 	}
 
@@ -130,7 +134,12 @@ func (c *cache) DumpInfo() Info {
 
 // Open opens and initializes database. Reads object counters from the ObjectCounters instance.
 func (c *cache) Open(readOnly bool) error {
-	err := c.openStore(readOnly)
+	err := c.checkFlushWatermarks()
+	if err != nil {
+		return err
+	}
+
+	err = c.openStore(readOnly)
 	if err != nil {
 		return err
 	}
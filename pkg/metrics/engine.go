@@ -8,171 +8,275 @@ import (
 
 type (
 	engineMetrics struct {
-		listContainersDuration        prometheus.Counter
-		estimateContainerSizeDuration prometheus.Counter
-		deleteDuration                prometheus.Counter
-		existsDuration                prometheus.Counter
-		getDuration                   prometheus.Counter
-		headDuration                  prometheus.Counter
-		inhumeDuration                prometheus.Counter
-		putDuration                   prometheus.Counter
-		rangeDuration                 prometheus.Counter
-		searchDuration                prometheus.Counter
-		listObjectsDuration           prometheus.Counter
+		operationDuration        *prometheus.HistogramVec
+		shardOperationDuration   *prometheus.HistogramVec
+		storageOperationDuration *prometheus.HistogramVec
+
+		compressionOriginalSize   *prometheus.CounterVec
+		compressionCompressedSize *prometheus.CounterVec
+		compressionSkipped        *prometheus.CounterVec
+
+		compactedFiles      *prometheus.CounterVec
+		compactionReclaimed *prometheus.CounterVec
+
+		scrubbedObjects  *prometheus.CounterVec
+		corruptedObjects *prometheus.CounterVec
+
+		promotedObjects *prometheus.CounterVec
+		demotedObjects  *prometheus.CounterVec
+
+		writeCacheBatchDuration *prometheus.HistogramVec
+
+		flushBacklog *prometheus.GaugeVec
 	}
 )
 
 const engineSubsystem = "engine"
 
+const (
+	operationLabelKey   = "operation"
+	storageTypeLabelKey = "storage"
+)
+
 func newEngineMetrics() engineMetrics {
 	var (
-		listContainersDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "Duration of engine operations",
+			Buckets:   durationBuckets,
+		}, []string{operationLabelKey})
+
+		shardOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "list_containers_duration",
-			Help:      "Accumulated duration of engine list containers operations",
-		})
+			Name:      "shard_operation_duration_seconds",
+			Help:      "Duration of shard operations",
+			Buckets:   durationBuckets,
+		}, []string{shardIDLabelKey, operationLabelKey})
 
-		estimateContainerSizeDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		storageOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "estimate_container_size_duration",
-			Help:      "Accumulated duration of engine container size estimate operations",
-		})
+			Name:      "blobstor_operation_duration_seconds",
+			Help:      "Duration of blobstor sub-storage operations",
+			Buckets:   durationBuckets,
+		}, []string{shardIDLabelKey, storageTypeLabelKey, operationLabelKey})
 
-		deleteDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		compressionOriginalSize = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "delete_duration",
-			Help:      "Accumulated duration of engine delete operations",
-		})
+			Name:      "compression_original_bytes",
+			Help:      "Accumulated size of the objects stored compressed before compression",
+		}, []string{shardIDLabelKey})
 
-		existsDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		compressionCompressedSize = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "exists_duration",
-			Help:      "Accumulated duration of engine exists operations",
-		})
+			Name:      "compression_compressed_bytes",
+			Help:      "Accumulated size of the objects stored compressed after compression",
+		}, []string{shardIDLabelKey})
 
-		getDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		compressionSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "get_duration",
-			Help:      "Accumulated duration of engine get operations",
-		})
+			Name:      "compression_skipped",
+			Help:      "Number of objects stored uncompressed because they were found incompressible",
+		}, []string{shardIDLabelKey})
 
-		headDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		compactedFiles = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "head_duration",
-			Help:      "Accumulated duration of engine head operations",
-		})
+			Name:      "compacted_files",
+			Help:      "Number of storage files (blobovniczas) rewritten by the compaction",
+		}, []string{shardIDLabelKey})
 
-		inhumeDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		compactionReclaimed = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "inhume_duration",
-			Help:      "Accumulated duration of engine inhume operations",
-		})
+			Name:      "compaction_reclaimed_bytes",
+			Help:      "Accumulated size of the disk space reclaimed by the compaction",
+		}, []string{shardIDLabelKey})
 
-		putDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		scrubbedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "put_duration",
-			Help:      "Accumulated duration of engine put operations",
-		})
+			Name:      "scrubbed_objects",
+			Help:      "Number of objects verified by the data scrubbing",
+		}, []string{shardIDLabelKey})
 
-		rangeDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		corruptedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "range_duration",
-			Help:      "Accumulated duration of engine range operations",
-		})
+			Name:      "corrupted_objects",
+			Help:      "Number of corrupted objects found by the data scrubbing",
+		}, []string{shardIDLabelKey})
 
-		searchDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		promotedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "search_duration",
-			Help:      "Accumulated duration of engine search operations",
-		})
+			Name:      "promoted_objects",
+			Help:      "Number of objects moved to the hot storage tier",
+		}, []string{shardIDLabelKey})
 
-		listObjectsDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		demotedObjects = prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: engineSubsystem,
-			Name:      "list_objects_duration",
-			Help:      "Accumulated duration of engine list objects operations",
-		})
+			Name:      "demoted_objects",
+			Help:      "Number of objects moved to the cold storage tier",
+		}, []string{shardIDLabelKey})
+
+		writeCacheBatchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "writecache_batch_duration_seconds",
+			Help:      "Time the small object PUT waits for the write-cache database batch to be persisted",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{shardIDLabelKey})
+
+		flushBacklog = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: engineSubsystem,
+			Name:      "writecache_flush_backlog",
+			Help:      "Number of objects in the write-cache waiting for the flush to the main storage",
+		}, []string{shardIDLabelKey})
 	)
 
 	return engineMetrics{
-		listContainersDuration:        listContainersDuration,
-		estimateContainerSizeDuration: estimateContainerSizeDuration,
-		deleteDuration:                deleteDuration,
-		existsDuration:                existsDuration,
-		getDuration:                   getDuration,
-		headDuration:                  headDuration,
-		inhumeDuration:                inhumeDuration,
-		putDuration:                   putDuration,
-		rangeDuration:                 rangeDuration,
-		searchDuration:                searchDuration,
-		listObjectsDuration:           listObjectsDuration,
+		operationDuration:         operationDuration,
+		shardOperationDuration:    shardOperationDuration,
+		storageOperationDuration:  storageOperationDuration,
+		compressionOriginalSize:   compressionOriginalSize,
+		compressionCompressedSize: compressionCompressedSize,
+		compressionSkipped:        compressionSkipped,
+		compactedFiles:            compactedFiles,
+		compactionReclaimed:       compactionReclaimed,
+		scrubbedObjects:           scrubbedObjects,
+		corruptedObjects:          corruptedObjects,
+		promotedObjects:           promotedObjects,
+		demotedObjects:            demotedObjects,
+		writeCacheBatchDuration:   writeCacheBatchDuration,
+		flushBacklog:              flushBacklog,
 	}
 }
 
 func (m engineMetrics) register() {
-	prometheus.MustRegister(m.listContainersDuration)
-	prometheus.MustRegister(m.estimateContainerSizeDuration)
-	prometheus.MustRegister(m.deleteDuration)
-	prometheus.MustRegister(m.existsDuration)
-	prometheus.MustRegister(m.getDuration)
-	prometheus.MustRegister(m.headDuration)
-	prometheus.MustRegister(m.inhumeDuration)
-	prometheus.MustRegister(m.putDuration)
-	prometheus.MustRegister(m.rangeDuration)
-	prometheus.MustRegister(m.searchDuration)
-	prometheus.MustRegister(m.listObjectsDuration)
+	prometheus.MustRegister(m.operationDuration)
+	prometheus.MustRegister(m.shardOperationDuration)
+	prometheus.MustRegister(m.storageOperationDuration)
+	prometheus.MustRegister(m.compressionOriginalSize)
+	prometheus.MustRegister(m.compressionCompressedSize)
+	prometheus.MustRegister(m.compressionSkipped)
+	prometheus.MustRegister(m.compactedFiles)
+	prometheus.MustRegister(m.compactionReclaimed)
+	prometheus.MustRegister(m.scrubbedObjects)
+	prometheus.MustRegister(m.corruptedObjects)
+	prometheus.MustRegister(m.promotedObjects)
+	prometheus.MustRegister(m.demotedObjects)
+	prometheus.MustRegister(m.writeCacheBatchDuration)
+	prometheus.MustRegister(m.flushBacklog)
 }
 
 func (m engineMetrics) AddListContainersDuration(d time.Duration) {
-	m.listObjectsDuration.Add(float64(d))
+	m.observeOperation("list_containers", d)
 }
 
 func (m engineMetrics) AddEstimateContainerSizeDuration(d time.Duration) {
-	m.estimateContainerSizeDuration.Add(float64(d))
+	m.observeOperation("estimate_container_size", d)
 }
 
 func (m engineMetrics) AddDeleteDuration(d time.Duration) {
-	m.deleteDuration.Add(float64(d))
+	m.observeOperation("delete", d)
 }
 
 func (m engineMetrics) AddExistsDuration(d time.Duration) {
-	m.existsDuration.Add(float64(d))
+	m.observeOperation("exists", d)
 }
 
 func (m engineMetrics) AddGetDuration(d time.Duration) {
-	m.getDuration.Add(float64(d))
+	m.observeOperation("get", d)
 }
 
 func (m engineMetrics) AddHeadDuration(d time.Duration) {
-	m.headDuration.Add(float64(d))
+	m.observeOperation("head", d)
 }
 
 func (m engineMetrics) AddInhumeDuration(d time.Duration) {
-	m.inhumeDuration.Add(float64(d))
+	m.observeOperation("inhume", d)
 }
 
 func (m engineMetrics) AddPutDuration(d time.Duration) {
-	m.putDuration.Add(float64(d))
+	m.observeOperation("put", d)
 }
 
 func (m engineMetrics) AddRangeDuration(d time.Duration) {
-	m.rangeDuration.Add(float64(d))
+	m.observeOperation("range", d)
 }
 
 func (m engineMetrics) AddSearchDuration(d time.Duration) {
-	m.searchDuration.Add(float64(d))
+	m.observeOperation("search", d)
 }
 
 func (m engineMetrics) AddListObjectsDuration(d time.Duration) {
-	m.listObjectsDuration.Add(float64(d))
+	m.observeOperation("list_objects", d)
+}
+
+func (m engineMetrics) observeOperation(op string, d time.Duration) {
+	m.operationDuration.With(prometheus.Labels{operationLabelKey: op}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) AddShardOperationDuration(shardID, op string, d time.Duration) {
+	m.shardOperationDuration.With(prometheus.Labels{
+		shardIDLabelKey:   shardID,
+		operationLabelKey: op,
+	}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) AddStorageOperationDuration(shardID, storageType, op string, d time.Duration) {
+	m.storageOperationDuration.With(prometheus.Labels{
+		shardIDLabelKey:     shardID,
+		storageTypeLabelKey: storageType,
+		operationLabelKey:   op,
+	}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) AddCompressedSize(shardID string, originalSize, compressedSize int) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+
+	m.compressionOriginalSize.With(labels).Add(float64(originalSize))
+	m.compressionCompressedSize.With(labels).Add(float64(compressedSize))
+}
+
+func (m engineMetrics) IncCompressionSkipped(shardID string) {
+	m.compressionSkipped.With(prometheus.Labels{shardIDLabelKey: shardID}).Inc()
+}
+
+func (m engineMetrics) AddCompaction(shardID string, compacted int, reclaimed uint64) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+
+	m.compactedFiles.With(labels).Add(float64(compacted))
+	m.compactionReclaimed.With(labels).Add(float64(reclaimed))
+}
+
+func (m engineMetrics) AddScrubbedObjects(shardID string, checked, corrupted int) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+
+	m.scrubbedObjects.With(labels).Add(float64(checked))
+	m.corruptedObjects.With(labels).Add(float64(corrupted))
+}
+
+func (m engineMetrics) AddTierMigrations(shardID string, promoted, demoted int) {
+	labels := prometheus.Labels{shardIDLabelKey: shardID}
+
+	m.promotedObjects.With(labels).Add(float64(promoted))
+	m.demotedObjects.With(labels).Add(float64(demoted))
+}
+
+func (m engineMetrics) AddWriteCacheBatch(shardID string, d time.Duration) {
+	m.writeCacheBatchDuration.With(prometheus.Labels{shardIDLabelKey: shardID}).Observe(d.Seconds())
+}
+
+func (m engineMetrics) SetFlushBacklog(shardID string, count uint64) {
+	m.flushBacklog.With(prometheus.Labels{shardIDLabelKey: shardID}).Set(float64(count))
 }
@@ -4,6 +4,13 @@ import "github.com/prometheus/client_golang/prometheus"
 
 const namespace = "neofs_node"
 
+// durationBuckets are the buckets of the operation latency histograms
+// in seconds. Storage operations usually take from tens of microseconds
+// to tens of milliseconds, while request processing may take seconds.
+var durationBuckets = []float64{
+	.0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10,
+}
+
 type NodeMetrics struct {
 	objectServiceMetrics
 	engineMetrics
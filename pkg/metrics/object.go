@@ -24,25 +24,25 @@ type (
 		rangeCounter     methodCount
 		rangeHashCounter methodCount
 
-		getDuration       prometheus.Counter
-		putDuration       prometheus.Counter
-		headDuration      prometheus.Counter
-		searchDuration    prometheus.Counter
-		deleteDuration    prometheus.Counter
-		rangeDuration     prometheus.Counter
-		rangeHashDuration prometheus.Counter
+		reqDuration *prometheus.HistogramVec
 
 		putPayload prometheus.Counter
 		getPayload prometheus.Counter
 
 		shardMetrics   *prometheus.GaugeVec
 		shardsReadonly *prometheus.GaugeVec
+
+		containerQuotaUsage    *prometheus.GaugeVec
+		containerQuotaExceeded *prometheus.CounterVec
 	}
 )
 
 const (
 	shardIDLabelKey     = "shard"
 	counterTypeLabelKey = "type"
+	containerIDLabelKey = "cid"
+	methodLabelKey      = "method"
+	statusLabelKey      = "status"
 )
 
 func newMethodCallCounter(name string) methodCount {
@@ -86,54 +86,15 @@ func newObjectServiceMetrics() objectServiceMetrics {
 	)
 
 	var ( // Request duration metrics.
-		getDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "get_req_duration",
-			Help:      "Accumulated get request process duration",
-		})
-
-		putDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "put_req_duration",
-			Help:      "Accumulated put request process duration",
-		})
-
-		headDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "head_req_duration",
-			Help:      "Accumulated head request process duration",
-		})
-
-		searchDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "search_req_duration",
-			Help:      "Accumulated search request process duration",
-		})
-
-		deleteDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "delete_req_duration",
-			Help:      "Accumulated delete request process duration",
-		})
-
-		rangeDuration = prometheus.NewCounter(prometheus.CounterOpts{
+		reqDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: objectSubsystem,
-			Name:      "range_req_duration",
-			Help:      "Accumulated range request process duration",
-		})
-
-		rangeHashDuration = prometheus.NewCounter(prometheus.CounterOpts{
-			Namespace: namespace,
-			Subsystem: objectSubsystem,
-			Name:      "range_hash_req_duration",
-			Help:      "Accumulated range hash request process duration",
-		})
+			Name:      "request_duration_seconds",
+			Help:      "Object service request processing duration",
+			Buckets:   durationBuckets,
+		},
+			[]string{methodLabelKey, statusLabelKey},
+		)
 	)
 
 	var ( // Object payload metrics.
@@ -168,27 +129,42 @@ func newObjectServiceMetrics() objectServiceMetrics {
 		},
 			[]string{shardIDLabelKey},
 		)
+
+		containerQuotaUsage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "container_quota_usage",
+			Help:      "Container size observed during the last quota check",
+		},
+			[]string{containerIDLabelKey},
+		)
+
+		containerQuotaExceeded = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: objectSubsystem,
+			Name:      "container_quota_exceeded",
+			Help:      "Number of soft and hard container quota breaches",
+		},
+			[]string{containerIDLabelKey, counterTypeLabelKey},
+		)
 	)
 
 	return objectServiceMetrics{
-		getCounter:        getCounter,
-		putCounter:        putCounter,
-		headCounter:       headCounter,
-		searchCounter:     searchCounter,
-		deleteCounter:     deleteCounter,
-		rangeCounter:      rangeCounter,
-		rangeHashCounter:  rangeHashCounter,
-		getDuration:       getDuration,
-		putDuration:       putDuration,
-		headDuration:      headDuration,
-		searchDuration:    searchDuration,
-		deleteDuration:    deleteDuration,
-		rangeDuration:     rangeDuration,
-		rangeHashDuration: rangeHashDuration,
-		putPayload:        putPayload,
-		getPayload:        getPayload,
-		shardMetrics:      shardsMetrics,
-		shardsReadonly:    shardsReadonly,
+		getCounter:       getCounter,
+		putCounter:       putCounter,
+		headCounter:      headCounter,
+		searchCounter:    searchCounter,
+		deleteCounter:    deleteCounter,
+		rangeCounter:     rangeCounter,
+		rangeHashCounter: rangeHashCounter,
+		reqDuration:      reqDuration,
+		putPayload:       putPayload,
+		getPayload:       getPayload,
+		shardMetrics:     shardsMetrics,
+		shardsReadonly:   shardsReadonly,
+
+		containerQuotaUsage:    containerQuotaUsage,
+		containerQuotaExceeded: containerQuotaExceeded,
 	}
 }
 
@@ -201,19 +177,16 @@ func (m objectServiceMetrics) register() {
 	m.rangeCounter.mustRegister()
 	m.rangeHashCounter.mustRegister()
 
-	prometheus.MustRegister(m.getDuration)
-	prometheus.MustRegister(m.putDuration)
-	prometheus.MustRegister(m.headDuration)
-	prometheus.MustRegister(m.searchDuration)
-	prometheus.MustRegister(m.deleteDuration)
-	prometheus.MustRegister(m.rangeDuration)
-	prometheus.MustRegister(m.rangeHashDuration)
+	prometheus.MustRegister(m.reqDuration)
 
 	prometheus.MustRegister(m.putPayload)
 	prometheus.MustRegister(m.getPayload)
 
 	prometheus.MustRegister(m.shardMetrics)
 	prometheus.MustRegister(m.shardsReadonly)
+
+	prometheus.MustRegister(m.containerQuotaUsage)
+	prometheus.MustRegister(m.containerQuotaExceeded)
 }
 
 func (m objectServiceMetrics) IncGetReqCounter(success bool) {
@@ -244,32 +217,46 @@ func (m objectServiceMetrics) IncRangeHashReqCounter(success bool) {
 	m.rangeHashCounter.Inc(success)
 }
 
-func (m objectServiceMetrics) AddGetReqDuration(d time.Duration) {
-	m.getDuration.Add(float64(d))
+func (m objectServiceMetrics) AddGetReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("get", success, d)
 }
 
-func (m objectServiceMetrics) AddPutReqDuration(d time.Duration) {
-	m.putDuration.Add(float64(d))
+func (m objectServiceMetrics) AddPutReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("put", success, d)
 }
 
-func (m objectServiceMetrics) AddHeadReqDuration(d time.Duration) {
-	m.headDuration.Add(float64(d))
+func (m objectServiceMetrics) AddHeadReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("head", success, d)
 }
 
-func (m objectServiceMetrics) AddSearchReqDuration(d time.Duration) {
-	m.searchDuration.Add(float64(d))
+func (m objectServiceMetrics) AddSearchReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("search", success, d)
 }
 
-func (m objectServiceMetrics) AddDeleteReqDuration(d time.Duration) {
-	m.deleteDuration.Add(float64(d))
+func (m objectServiceMetrics) AddDeleteReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("delete", success, d)
 }
 
-func (m objectServiceMetrics) AddRangeReqDuration(d time.Duration) {
-	m.rangeDuration.Add(float64(d))
+func (m objectServiceMetrics) AddRangeReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("range", success, d)
 }
 
-func (m objectServiceMetrics) AddRangeHashReqDuration(d time.Duration) {
-	m.rangeHashDuration.Add(float64(d))
+func (m objectServiceMetrics) AddRangeHashReqDuration(success bool, d time.Duration) {
+	m.observeReqDuration("range_hash", success, d)
+}
+
+func (m objectServiceMetrics) observeReqDuration(method string, success bool, d time.Duration) {
+	status := "success"
+	if !success {
+		status = "failure"
+	}
+
+	m.reqDuration.With(
+		prometheus.Labels{
+			methodLabelKey: method,
+			statusLabelKey: status,
+		},
+	).Observe(d.Seconds())
 }
 
 func (m objectServiceMetrics) AddPutPayload(ln int) {
@@ -309,3 +296,25 @@ func (m objectServiceMetrics) SetReadonly(shardID string, readonly bool) {
 		},
 	).Set(flag)
 }
+
+func (m objectServiceMetrics) SetContainerQuotaUsage(cnr string, size uint64) {
+	m.containerQuotaUsage.With(
+		prometheus.Labels{
+			containerIDLabelKey: cnr,
+		},
+	).Set(float64(size))
+}
+
+func (m objectServiceMetrics) IncContainerQuotaExceeded(cnr string, hard bool) {
+	quotaType := "soft"
+	if hard {
+		quotaType = "hard"
+	}
+
+	m.containerQuotaExceeded.With(
+		prometheus.Labels{
+			containerIDLabelKey: cnr,
+			counterTypeLabelKey: quotaType,
+		},
+	).Inc()
+}
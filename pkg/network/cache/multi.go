@@ -10,6 +10,7 @@ import (
 	rawclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"google.golang.org/grpc/codes"
@@ -206,6 +207,8 @@ func (s *singleClient) invalidate() {
 }
 
 func (x *multiClient) ObjectPutInit(ctx context.Context, p client.PrmObjectPutInit) (res *client.ObjectWriter, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectPutInit(ctx, p)
 		return err
@@ -215,6 +218,8 @@ func (x *multiClient) ObjectPutInit(ctx context.Context, p client.PrmObjectPutIn
 }
 
 func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, prm client.PrmAnnounceSpace) (res *client.ResAnnounceSpace, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ContainerAnnounceUsedSpace(ctx, prm)
 		return err
@@ -224,6 +229,8 @@ func (x *multiClient) ContainerAnnounceUsedSpace(ctx context.Context, prm client
 }
 
 func (x *multiClient) ObjectDelete(ctx context.Context, p client.PrmObjectDelete) (res *client.ResObjectDelete, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectDelete(ctx, p)
 		return err
@@ -233,6 +240,8 @@ func (x *multiClient) ObjectDelete(ctx context.Context, p client.PrmObjectDelete
 }
 
 func (x *multiClient) ObjectGetInit(ctx context.Context, p client.PrmObjectGet) (res *client.ObjectReader, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectGetInit(ctx, p)
 		return err
@@ -242,6 +251,8 @@ func (x *multiClient) ObjectGetInit(ctx context.Context, p client.PrmObjectGet)
 }
 
 func (x *multiClient) ObjectRangeInit(ctx context.Context, p client.PrmObjectRange) (res *client.ObjectRangeReader, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectRangeInit(ctx, p)
 		return err
@@ -251,6 +262,8 @@ func (x *multiClient) ObjectRangeInit(ctx context.Context, p client.PrmObjectRan
 }
 
 func (x *multiClient) ObjectHead(ctx context.Context, p client.PrmObjectHead) (res *client.ResObjectHead, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectHead(ctx, p)
 		return err
@@ -260,6 +273,8 @@ func (x *multiClient) ObjectHead(ctx context.Context, p client.PrmObjectHead) (r
 }
 
 func (x *multiClient) ObjectHash(ctx context.Context, p client.PrmObjectHash) (res *client.ResObjectHash, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectHash(ctx, p)
 		return err
@@ -269,6 +284,8 @@ func (x *multiClient) ObjectHash(ctx context.Context, p client.PrmObjectHash) (r
 }
 
 func (x *multiClient) ObjectSearchInit(ctx context.Context, p client.PrmObjectSearch) (res *client.ObjectListReader, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.ObjectSearchInit(ctx, p)
 		return err
@@ -278,6 +295,8 @@ func (x *multiClient) ObjectSearchInit(ctx context.Context, p client.PrmObjectSe
 }
 
 func (x *multiClient) AnnounceLocalTrust(ctx context.Context, prm client.PrmAnnounceLocalTrust) (res *client.ResAnnounceLocalTrust, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.AnnounceLocalTrust(ctx, prm)
 		return err
@@ -287,6 +306,8 @@ func (x *multiClient) AnnounceLocalTrust(ctx context.Context, prm client.PrmAnno
 }
 
 func (x *multiClient) AnnounceIntermediateTrust(ctx context.Context, prm client.PrmAnnounceIntermediateTrust) (res *client.ResAnnounceIntermediateTrust, err error) {
+	ctx = tracing.InjectOutgoingContext(ctx)
+
 	err = x.iterateClients(ctx, func(c clientcore.Client) error {
 		res, err = c.AnnounceIntermediateTrust(ctx, prm)
 		return err
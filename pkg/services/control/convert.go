@@ -200,3 +200,165 @@ func (w *flushCacheResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.FlushCacheResponse = r
 	return nil
 }
+
+type startShardEvacuationResponseWrapper struct {
+	*StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StartShardEvacuationResponse
+}
+
+func (w *startShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StartShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StartShardEvacuationResponse)(nil))
+	}
+
+	w.StartShardEvacuationResponse = r
+	return nil
+}
+
+type stopShardEvacuationResponseWrapper struct {
+	*StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.StopShardEvacuationResponse
+}
+
+func (w *stopShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*StopShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*StopShardEvacuationResponse)(nil))
+	}
+
+	w.StopShardEvacuationResponse = r
+	return nil
+}
+
+type resumeShardEvacuationResponseWrapper struct {
+	*ResumeShardEvacuationResponse
+}
+
+func (w *resumeShardEvacuationResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ResumeShardEvacuationResponse
+}
+
+func (w *resumeShardEvacuationResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ResumeShardEvacuationResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ResumeShardEvacuationResponse)(nil))
+	}
+
+	w.ResumeShardEvacuationResponse = r
+	return nil
+}
+
+type getShardEvacuationStatusResponseWrapper struct {
+	*GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.GetShardEvacuationStatusResponse
+}
+
+func (w *getShardEvacuationStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*GetShardEvacuationStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*GetShardEvacuationStatusResponse)(nil))
+	}
+
+	w.GetShardEvacuationStatusResponse = r
+	return nil
+}
+
+type addShardResponseWrapper struct {
+	*AddShardResponse
+}
+
+func (w *addShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.AddShardResponse
+}
+
+func (w *addShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*AddShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*AddShardResponse)(nil))
+	}
+
+	w.AddShardResponse = r
+	return nil
+}
+
+type removeShardResponseWrapper struct {
+	*RemoveShardResponse
+}
+
+func (w *removeShardResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.RemoveShardResponse
+}
+
+func (w *removeShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*RemoveShardResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*RemoveShardResponse)(nil))
+	}
+
+	w.RemoveShardResponse = r
+	return nil
+}
+
+type setShardIOLimitsResponseWrapper struct {
+	*SetShardIOLimitsResponse
+}
+
+func (w *setShardIOLimitsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.SetShardIOLimitsResponse
+}
+
+func (w *setShardIOLimitsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*SetShardIOLimitsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*SetShardIOLimitsResponse)(nil))
+	}
+
+	w.SetShardIOLimitsResponse = r
+	return nil
+}
+
+type listContainerQuotasResponseWrapper struct {
+	*ListContainerQuotasResponse
+}
+
+func (w *listContainerQuotasResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ListContainerQuotasResponse
+}
+
+func (w *listContainerQuotasResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ListContainerQuotasResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ListContainerQuotasResponse)(nil))
+	}
+
+	w.ListContainerQuotasResponse = r
+	return nil
+}
+
+type listOwnerUsageResponseWrapper struct {
+	*ListOwnerUsageResponse
+}
+
+func (w *listOwnerUsageResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.ListOwnerUsageResponse
+}
+
+func (w *listOwnerUsageResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*ListOwnerUsageResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*ListOwnerUsageResponse)(nil))
+	}
+
+	w.ListOwnerUsageResponse = r
+	return nil
+}
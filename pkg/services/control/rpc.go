@@ -18,6 +18,18 @@ const (
 	rpcSynchronizeTree = "SynchronizeTree"
 	rpcEvacuateShard   = "EvacuateShard"
 	rpcFlushCache      = "FlushCache"
+
+	rpcStartShardEvacuation     = "StartShardEvacuation"
+	rpcStopShardEvacuation      = "StopShardEvacuation"
+	rpcResumeShardEvacuation    = "ResumeShardEvacuation"
+	rpcGetShardEvacuationStatus = "GetShardEvacuationStatus"
+
+	rpcAddShard    = "AddShard"
+	rpcRemoveShard = "RemoveShard"
+
+	rpcSetShardIOLimits    = "SetShardIOLimits"
+	rpcListContainerQuotas = "ListContainerQuotas"
+	rpcListOwnerUsage      = "ListOwnerUsage"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -191,3 +203,120 @@ func FlushCache(cli *client.Client, req *FlushCacheRequest, opts ...client.CallO
 
 	return wResp.FlushCacheResponse, nil
 }
+
+// StartShardEvacuation executes ControlService.StartShardEvacuation RPC.
+func StartShardEvacuation(cli *client.Client, req *StartShardEvacuationRequest, opts ...client.CallOption) (*StartShardEvacuationResponse, error) {
+	wResp := &startShardEvacuationResponseWrapper{new(StartShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStartShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StartShardEvacuationResponse, nil
+}
+
+// StopShardEvacuation executes ControlService.StopShardEvacuation RPC.
+func StopShardEvacuation(cli *client.Client, req *StopShardEvacuationRequest, opts ...client.CallOption) (*StopShardEvacuationResponse, error) {
+	wResp := &stopShardEvacuationResponseWrapper{new(StopShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcStopShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.StopShardEvacuationResponse, nil
+}
+
+// ResumeShardEvacuation executes ControlService.ResumeShardEvacuation RPC.
+func ResumeShardEvacuation(cli *client.Client, req *ResumeShardEvacuationRequest, opts ...client.CallOption) (*ResumeShardEvacuationResponse, error) {
+	wResp := &resumeShardEvacuationResponseWrapper{new(ResumeShardEvacuationResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcResumeShardEvacuation), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ResumeShardEvacuationResponse, nil
+}
+
+// GetShardEvacuationStatus executes ControlService.GetShardEvacuationStatus RPC.
+func GetShardEvacuationStatus(cli *client.Client, req *GetShardEvacuationStatusRequest, opts ...client.CallOption) (*GetShardEvacuationStatusResponse, error) {
+	wResp := &getShardEvacuationStatusResponseWrapper{new(GetShardEvacuationStatusResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcGetShardEvacuationStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.GetShardEvacuationStatusResponse, nil
+}
+
+// AddShard executes ControlService.AddShard RPC.
+func AddShard(cli *client.Client, req *AddShardRequest, opts ...client.CallOption) (*AddShardResponse, error) {
+	wResp := &addShardResponseWrapper{new(AddShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcAddShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.AddShardResponse, nil
+}
+
+// RemoveShard executes ControlService.RemoveShard RPC.
+func RemoveShard(cli *client.Client, req *RemoveShardRequest, opts ...client.CallOption) (*RemoveShardResponse, error) {
+	wResp := &removeShardResponseWrapper{new(RemoveShardResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcRemoveShard), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.RemoveShardResponse, nil
+}
+
+// SetShardIOLimits executes ControlService.SetShardIOLimits RPC.
+func SetShardIOLimits(cli *client.Client, req *SetShardIOLimitsRequest, opts ...client.CallOption) (*SetShardIOLimitsResponse, error) {
+	wResp := &setShardIOLimitsResponseWrapper{new(SetShardIOLimitsResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetShardIOLimits), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.SetShardIOLimitsResponse, nil
+}
+
+// ListContainerQuotas executes ControlService.ListContainerQuotas RPC.
+func ListContainerQuotas(cli *client.Client, req *ListContainerQuotasRequest, opts ...client.CallOption) (*ListContainerQuotasResponse, error) {
+	wResp := &listContainerQuotasResponseWrapper{new(ListContainerQuotasResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListContainerQuotas), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ListContainerQuotasResponse, nil
+}
+
+// ListOwnerUsage executes ControlService.ListOwnerUsage RPC.
+func ListOwnerUsage(cli *client.Client, req *ListOwnerUsageRequest, opts ...client.CallOption) (*ListOwnerUsageResponse, error) {
+	wResp := &listOwnerUsageResponseWrapper{new(ListOwnerUsageResponse)}
+	wReq := &requestWrapper{m: req}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListOwnerUsage), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.ListOwnerUsageResponse, nil
+}
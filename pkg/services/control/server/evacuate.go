@@ -9,9 +9,11 @@ import (
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -28,7 +30,7 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 	var prm engine.EvacuateShardPrm
 	prm.WithShardIDList(s.getShardIDList(req.GetBody().GetShard_ID()))
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
-	prm.WithFaultHandler(s.replicate)
+	s.setEvacuationHandlers(&prm)
 
 	res, err := s.s.Evacuate(prm)
 	if err != nil {
@@ -37,7 +39,8 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 
 	resp := &control.EvacuateShardResponse{
 		Body: &control.EvacuateShardResponse_Body{
-			Count: uint32(res.Count()),
+			Count:      uint32(res.Count()),
+			TreesCount: uint32(res.TreesCount()),
 		},
 	}
 
@@ -48,7 +51,24 @@ func (s *Server) EvacuateShard(_ context.Context, req *control.EvacuateShardRequ
 	return resp, nil
 }
 
-func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
+// evacuationHandlers is implemented by the parameters of the evacuation
+// operations accepting the handlers of the data which cannot be saved
+// on the other local shards.
+type evacuationHandlers interface {
+	WithFaultHandler(func(context.Context, oid.Address, *objectSDK.Object) error)
+	WithTreeHandler(func(context.Context, cid.ID, string, *pilorama.Move) error)
+}
+
+// setEvacuationHandlers makes the evacuation move the data to the remote
+// container nodes if it cannot be saved on the other local shards.
+func (s *Server) setEvacuationHandlers(prm evacuationHandlers) {
+	prm.WithFaultHandler(s.replicate)
+	if s.treeService != nil {
+		prm.WithTreeHandler(s.treeService.ReplicateTreeOp)
+	}
+}
+
+func (s *Server) replicate(ctx context.Context, addr oid.Address, obj *objectSDK.Object) error {
 	cid, ok := obj.ContainerID()
 	if !ok {
 		// Return nil to prevent situations where a shard can't be evacuated
@@ -89,7 +109,7 @@ func (s *Server) replicate(addr oid.Address, obj *objectSDK.Object) error {
 	task.SetObjectAddress(addr)
 	task.SetCopiesNumber(1)
 	task.SetNodes(nodes)
-	s.replicator.HandleTask(context.TODO(), task, &res)
+	s.replicator.HandleTask(ctx, task, &res)
 
 	if res.count == 0 {
 		return errors.New("object was not replicated")
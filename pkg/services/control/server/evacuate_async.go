@@ -0,0 +1,177 @@
+package control
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) StartShardEvacuation(_ context.Context, req *control.StartShardEvacuationRequest) (*control.StartShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.EvacuateShardPrm
+	prm.WithShardIDList(s.getShardIDList(req.GetBody().GetShard_ID()))
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+	s.setEvacuationHandlers(&prm)
+
+	st, err := s.s.StartEvacuation(prm)
+	if err != nil {
+		return nil, evacuationError(err)
+	}
+
+	resp := &control.StartShardEvacuationResponse{
+		Body: &control.StartShardEvacuationResponse_Body{
+			Id: st.ID(),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) StopShardEvacuation(_ context.Context, req *control.StopShardEvacuationRequest) (*control.StopShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	err = s.s.StopEvacuation()
+	if err != nil {
+		return nil, evacuationError(err)
+	}
+
+	resp := &control.StopShardEvacuationResponse{Body: &control.StopShardEvacuationResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) ResumeShardEvacuation(_ context.Context, req *control.ResumeShardEvacuationRequest) (*control.ResumeShardEvacuationResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.ResumeEvacuationPrm
+	s.setEvacuationHandlers(&prm)
+
+	st, err := s.s.ResumeEvacuation(prm)
+	if err != nil {
+		return nil, evacuationError(err)
+	}
+
+	resp := &control.ResumeShardEvacuationResponse{
+		Body: &control.ResumeShardEvacuationResponse_Body{
+			Id: st.ID(),
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// ResumeInterruptedEvacuation resumes the evacuation job interrupted by the
+// node shutdown, if any.
+func (s *Server) ResumeInterruptedEvacuation() error {
+	st, err := s.s.EvacuationStatus()
+	if err != nil {
+		if errors.Is(err, engine.ErrEvacuationNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if !st.Interrupted() {
+		return nil
+	}
+
+	var prm engine.ResumeEvacuationPrm
+	s.setEvacuationHandlers(&prm)
+
+	_, err = s.s.ResumeEvacuation(prm)
+	return err
+}
+
+func (s *Server) GetShardEvacuationStatus(_ context.Context, req *control.GetShardEvacuationStatusRequest) (*control.GetShardEvacuationStatusResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	st, err := s.s.EvacuationStatus()
+	if err != nil {
+		return nil, evacuationError(err)
+	}
+
+	body := &control.GetShardEvacuationStatusResponse_Body{
+		Id:           st.ID(),
+		Shard_ID:     make([][]byte, 0, len(st.ShardIDs())),
+		Total:        st.Total(),
+		Evacuated:    st.Evacuated(),
+		Failed:       st.Failed(),
+		StartedAt:    st.StartedAt().Unix(),
+		ErrorMessage: st.Error(),
+
+		TreesTotal:     st.TreesTotal(),
+		TreesEvacuated: st.TreesEvacuated(),
+		TreesFailed:    st.TreesFailed(),
+	}
+
+	for _, id := range st.ShardIDs() {
+		body.Shard_ID = append(body.Shard_ID, *id)
+	}
+
+	if finished := st.FinishedAt(); !finished.IsZero() {
+		body.FinishedAt = finished.Unix()
+	}
+
+	switch st.Status() {
+	case engine.EvacuationRunning:
+		body.Status = control.GetShardEvacuationStatusResponse_Body_RUNNING
+	case engine.EvacuationStopped:
+		body.Status = control.GetShardEvacuationStatusResponse_Body_STOPPED
+	case engine.EvacuationCompleted:
+		body.Status = control.GetShardEvacuationStatusResponse_Body_COMPLETED
+	case engine.EvacuationFailed:
+		body.Status = control.GetShardEvacuationStatusResponse_Body_FAILED
+	default:
+		body.Status = control.GetShardEvacuationStatusResponse_Body_STATUS_UNDEFINED
+	}
+
+	resp := &control.GetShardEvacuationStatusResponse{Body: body}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func evacuationError(err error) error {
+	switch {
+	case errors.Is(err, engine.ErrEvacuationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, engine.ErrEvacuationInProgress),
+		errors.Is(err, engine.ErrEvacuationNotRunning),
+		errors.Is(err, engine.ErrEvacuationCompleted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,102 @@
+package control
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ioClasses lists the limited operation classes in the order they
+// are reported to the clients.
+var ioClasses = []control.IOClass{
+	control.IOClass_IO_CLASS_ALL,
+	control.IOClass_CLIENT_READ,
+	control.IOClass_CLIENT_WRITE,
+	control.IOClass_REPLICATION,
+	control.IOClass_BACKGROUND,
+}
+
+func (s *Server) SetShardIOLimits(_ context.Context, req *control.SetShardIOLimitsRequest) (*control.SetShardIOLimitsResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	body := req.GetBody()
+	if len(body.GetLimits()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing limits")
+	}
+
+	current := make(map[string]limiter.Limits)
+	for _, info := range s.s.DumpInfo().Shards {
+		current[info.ID.String()] = info.IOLimits
+	}
+
+	for _, id := range s.getShardIDList(body.GetShard_ID()) {
+		limits, ok := current[id.String()]
+		if !ok {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("shard not found: %s", id))
+		}
+
+		for _, l := range body.GetLimits() {
+			c, err := ioClassFromProto(l.GetClass())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			limits.SetClass(c, limiter.Limit{
+				Ops:   l.GetOpsPerSec(),
+				Bytes: l.GetBytesPerSec(),
+			})
+		}
+
+		err = s.s.SetShardIOLimits(id, limits)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	resp := &control.SetShardIOLimitsResponse{Body: &control.SetShardIOLimitsResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func ioClassFromProto(c control.IOClass) (limiter.Class, error) {
+	switch c {
+	case control.IOClass_IO_CLASS_ALL:
+		return limiter.Undefined, nil
+	case control.IOClass_CLIENT_READ:
+		return limiter.ClientRead, nil
+	case control.IOClass_CLIENT_WRITE:
+		return limiter.ClientWrite, nil
+	case control.IOClass_REPLICATION:
+		return limiter.Replication, nil
+	case control.IOClass_BACKGROUND:
+		return limiter.Background, nil
+	default:
+		return 0, fmt.Errorf("unknown I/O class: %s", c)
+	}
+}
+
+func ioLimitsToProto(l limiter.Limits) []*control.IOLimit {
+	res := make([]*control.IOLimit, 0, len(ioClasses))
+	for _, pc := range ioClasses {
+		c, _ := ioClassFromProto(pc)
+		v := l.Class(c)
+
+		res = append(res, &control.IOLimit{
+			Class:       pc,
+			OpsPerSec:   v.Ops,
+			BytesPerSec: v.Bytes,
+		})
+	}
+	return res
+}
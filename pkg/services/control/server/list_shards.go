@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"google.golang.org/grpc/codes"
@@ -52,6 +53,10 @@ func (s *Server) ListShards(_ context.Context, req *control.ListShardsRequest) (
 
 		si.SetMode(m)
 		si.SetErrorCount(sh.ErrorCount)
+		si.SetFreeSpace(sh.WeightValues.FreeSpace)
+		si.SetCapacity(sh.WeightValues.Capacity)
+		si.IoLimits = ioLimitsToProto(sh.IOLimits)
+		si.Scrub = scrubStatusToProto(sh.ScrubStatus)
 
 		shardInfos = append(shardInfos, si)
 	}
@@ -76,3 +81,15 @@ func blobstorInfoToProto(info blobstor.Info) []*control.BlobstorInfo {
 	}
 	return res
 }
+
+func scrubStatusToProto(st shard.ScrubStatus) *control.ScrubStatus {
+	res := &control.ScrubStatus{
+		Checked:   st.Checked,
+		Corrupted: st.Corrupted,
+		Passes:    st.Passes,
+	}
+	if !st.LastPassFinishedAt.IsZero() {
+		res.LastPassFinishedAt = st.LastPassFinishedAt.Unix()
+	}
+	return res
+}
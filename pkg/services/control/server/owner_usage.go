@@ -0,0 +1,48 @@
+package control
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListOwnerUsage(_ context.Context, req *control.ListOwnerUsageRequest) (*control.ListOwnerUsageResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	var prm engine.OwnerUsagePrm
+	prm.SetLimit(int(req.GetBody().GetLimit()))
+
+	res, err := s.s.OwnerUsage(prm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	usage := make([]*control.OwnerUsage, 0, len(res.Usage()))
+	for _, u := range res.Usage() {
+		usage = append(usage, &control.OwnerUsage{
+			Owner_ID:        u.Owner().WalletBytes(),
+			LogicalObjects:  u.LogicObjects(),
+			LogicalSize:     u.LogicSize(),
+			PhysicalObjects: u.PhyObjects(),
+			PhysicalSize:    u.PhySize(),
+		})
+	}
+
+	resp := &control.ListOwnerUsageResponse{
+		Body: &control.ListOwnerUsageResponse_Body{
+			Usage: usage,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
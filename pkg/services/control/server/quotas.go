@@ -0,0 +1,84 @@
+package control
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/quota"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuotaSource provides quota usage of the containers.
+type QuotaSource interface {
+	// Usage must return the size and the effective quotas of the container.
+	Usage(cid.ID) (quota.Usage, error)
+
+	// List must return the usage of all known containers.
+	List() ([]quota.Usage, error)
+}
+
+func (s *Server) ListContainerQuotas(_ context.Context, req *control.ListContainerQuotasRequest) (*control.ListContainerQuotasResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.quotaSource == nil {
+		return nil, status.Error(codes.Unavailable, "container quotas are not supported")
+	}
+
+	var usage []quota.Usage
+
+	rawIDs := req.GetBody().GetContainer_ID()
+	if len(rawIDs) == 0 {
+		usage, err = s.quotaSource.List()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	} else {
+		usage = make([]quota.Usage, 0, len(rawIDs))
+
+		for i := range rawIDs {
+			var id cid.ID
+			if err := id.Decode(rawIDs[i]); err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid container ID: %v", err))
+			}
+
+			u, err := s.quotaSource.Usage(id)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			usage = append(usage, u)
+		}
+	}
+
+	quotas := make([]*control.ContainerQuota, 0, len(usage))
+	for i := range usage {
+		rawID := make([]byte, sha256.Size)
+		usage[i].Container.Encode(rawID)
+
+		quotas = append(quotas, &control.ContainerQuota{
+			Container_ID: rawID,
+			Size:         usage[i].Size,
+			SoftLimit:    usage[i].Limits.Soft,
+			HardLimit:    usage[i].Limits.Hard,
+		})
+	}
+
+	resp := &control.ListContainerQuotasResponse{
+		Body: &control.ListContainerQuotasResponse_Body{
+			Quotas: quotas,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
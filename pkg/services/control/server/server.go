@@ -8,6 +8,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"go.uber.org/zap"
 )
 
 // Server is an entity that serves
@@ -49,6 +51,8 @@ type NodeState interface {
 type Option func(*cfg)
 
 type cfg struct {
+	log *logger.Logger
+
 	key *ecdsa.PrivateKey
 
 	allowedKeys [][]byte
@@ -65,11 +69,17 @@ type cfg struct {
 
 	treeService TreeService
 
+	shardManager ShardManager
+
+	quotaSource QuotaSource
+
 	s *engine.StorageEngine
 }
 
 func defaultCfg() *cfg {
-	return &cfg{}
+	return &cfg{
+		log: &logger.Logger{Logger: zap.L()},
+	}
 }
 
 // New creates, initializes and returns new Server instance.
@@ -85,6 +95,13 @@ func New(opts ...Option) *Server {
 	}
 }
 
+// WithLogger returns option to set the logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l
+	}
+}
+
 // WithKey returns option to set private key
 // used for signing responses.
 func WithKey(key *ecdsa.PrivateKey) Option {
@@ -151,3 +168,19 @@ func WithTreeService(s TreeService) Option {
 		c.treeService = s
 	}
 }
+
+// WithShardManager returns an option to set the component
+// attaching and detaching the shards at runtime.
+func WithShardManager(m ShardManager) Option {
+	return func(c *cfg) {
+		c.shardManager = m
+	}
+}
+
+// WithQuotaSource returns an option to set the source
+// of the container quota usage.
+func WithQuotaSource(src QuotaSource) Option {
+	return func(c *cfg) {
+		c.quotaSource = src
+	}
+}
@@ -0,0 +1,178 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/mode"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShardManager is an interface of the component attaching and
+// detaching the shards of the running storage node.
+type ShardManager interface {
+	// AddShard attaches a new shard described by the configuration
+	// in YAML or JSON format.
+	AddShard(config []byte) (*shard.ID, error)
+
+	// RemoveShards detaches the shards.
+	RemoveShards(ids []*shard.ID) error
+}
+
+func (s *Server) AddShard(_ context.Context, req *control.AddShardRequest) (*control.AddShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.shardManager == nil {
+		return nil, status.Error(codes.Unimplemented, "shard management is not supported")
+	}
+
+	cfg := req.GetBody().GetConfig()
+	if len(cfg) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing shard configuration")
+	}
+
+	id, err := s.shardManager.AddShard(cfg)
+	if err != nil {
+		if errors.Is(err, engine.ErrShardPathsInUse) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.AddShardResponse{
+		Body: &control.AddShardResponse_Body{
+			Shard_ID: *id,
+		},
+	}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+func (s *Server) RemoveShard(_ context.Context, req *control.RemoveShardRequest) (*control.RemoveShardResponse, error) {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.shardManager == nil {
+		return nil, status.Error(codes.Unimplemented, "shard management is not supported")
+	}
+
+	body := req.GetBody()
+	if len(body.GetShard_ID()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing shard IDs")
+	}
+
+	ids := s.getShardIDList(body.GetShard_ID())
+
+	if body.GetEvacuate() {
+		err = s.evacuateAndRemoveShards(ids, body.GetIgnoreErrors())
+	} else {
+		err = s.shardManager.RemoveShards(ids)
+	}
+	if err != nil {
+		if errors.Is(err, engine.ErrDetachAllShards) || errors.Is(err, engine.ErrEvacuationInProgress) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &control.RemoveShardResponse{Body: &control.RemoveShardResponse_Body{}}
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// evacuateAndRemoveShards switches the shards to read-only mode and starts
+// the background evacuation job. The shards are detached once the job is
+// completed, otherwise their previous modes are restored.
+func (s *Server) evacuateAndRemoveShards(ids []*shard.ID, ignoreErrors bool) error {
+	modes := make([]mode.Mode, len(ids))
+	infos := s.s.DumpInfo().Shards
+
+	if len(ids) >= len(infos) {
+		return engine.ErrDetachAllShards
+	}
+
+	for i := range ids {
+		found := false
+		for j := range infos {
+			if infos[j].ID.String() == ids[i].String() {
+				modes[i], found = infos[j].Mode, true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("shard %s not found", ids[i])
+		}
+	}
+
+	for i := range ids {
+		err := s.s.SetShardMode(ids[i], mode.ReadOnly, false)
+		if err != nil {
+			s.restoreShardModes(ids[:i], modes)
+			return err
+		}
+	}
+
+	var prm engine.EvacuateShardPrm
+	prm.WithShardIDList(ids)
+	prm.WithIgnoreErrors(ignoreErrors)
+	s.setEvacuationHandlers(&prm)
+
+	st, err := s.s.StartEvacuation(prm)
+	if err != nil {
+		s.restoreShardModes(ids, modes)
+		return err
+	}
+
+	go func() {
+		res, err := s.s.WaitEvacuation(context.Background(), st.ID())
+		if err == nil && res.Status() == engine.EvacuationCompleted {
+			err = s.shardManager.RemoveShards(ids)
+			if err == nil {
+				return
+			}
+		} else if err == nil {
+			err = fmt.Errorf("evacuation is %s", res.Status())
+		}
+
+		s.log.Error("could not remove shards after the evacuation, restoring shard modes",
+			zap.String("evacuation_id", st.ID()),
+			zap.Error(err))
+
+		s.restoreShardModes(ids, modes)
+	}()
+
+	return nil
+}
+
+// restoreShardModes sets the modes the shards had before the removal.
+func (s *Server) restoreShardModes(ids []*shard.ID, modes []mode.Mode) {
+	for i := range ids {
+		err := s.s.SetShardMode(ids[i], modes[i], false)
+		if err != nil {
+			s.log.Error("could not restore shard mode",
+				zap.Stringer("shard_id", ids[i]),
+				zap.Stringer("mode", modes[i]),
+				zap.Error(err))
+		}
+	}
+}
@@ -3,6 +3,7 @@ package control
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/pilorama"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"google.golang.org/grpc/codes"
@@ -12,6 +13,8 @@ import (
 // TreeService represents a tree service instance.
 type TreeService interface {
 	Synchronize(ctx context.Context, cnr cid.ID, treeID string) error
+	// ReplicateTreeOp sends the tree operation to the remote container nodes.
+	ReplicateTreeOp(ctx context.Context, cnr cid.ID, treeID string, op *pilorama.Move) error
 }
 
 func (s *Server) SynchronizeTree(ctx context.Context, req *control.SynchronizeTreeRequest) (*control.SynchronizeTreeResponse, error) {
@@ -142,6 +142,16 @@ func (x *DumpShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetSince sets the cursor of the previous dump for the dump shard request.
+func (x *DumpShardRequest_Body) SetSince(cursor uint64) {
+	x.Since = cursor
+}
+
+// SetTruncateChanges sets the flag to truncate the change log after the dump.
+func (x *DumpShardRequest_Body) SetTruncateChanges(truncate bool) {
+	x.TruncateChanges = truncate
+}
+
 // SetBody sets request body.
 func (x *DumpShardRequest) SetBody(v *DumpShardRequest_Body) {
 	if x != nil {
@@ -156,6 +166,16 @@ func (x *DumpShardResponse) SetBody(v *DumpShardResponse_Body) {
 	}
 }
 
+// SetCount sets number of the dumped objects.
+func (x *DumpShardResponse_Body) SetCount(v uint32) {
+	x.Count = v
+}
+
+// SetCursor sets metabase state identifier the dump corresponds to.
+func (x *DumpShardResponse_Body) SetCursor(v uint64) {
+	x.Cursor = v
+}
+
 // SetShardID sets shard ID for the restore shard request.
 func (x *RestoreShardRequest_Body) SetShardID(id []byte) {
 	x.Shard_ID = id
@@ -79,6 +79,12 @@ func equalListShardResponseBodies(b1, b2 *control.ListShardsResponse_Body) bool
 		if b1.Shards[i].GetMetabasePath() != b2.Shards[i].GetMetabasePath() ||
 			b1.Shards[i].GetWritecachePath() != b2.Shards[i].GetWritecachePath() ||
 			b1.Shards[i].GetPiloramaPath() != b2.Shards[i].GetPiloramaPath() ||
+			b1.Shards[i].GetFreeSpace() != b2.Shards[i].GetFreeSpace() ||
+			b1.Shards[i].GetCapacity() != b2.Shards[i].GetCapacity() ||
+			b1.Shards[i].GetScrub().GetChecked() != b2.Shards[i].GetScrub().GetChecked() ||
+			b1.Shards[i].GetScrub().GetCorrupted() != b2.Shards[i].GetScrub().GetCorrupted() ||
+			b1.Shards[i].GetScrub().GetPasses() != b2.Shards[i].GetScrub().GetPasses() ||
+			b1.Shards[i].GetScrub().GetLastPassFinishedAt() != b2.Shards[i].GetScrub().GetLastPassFinishedAt() ||
 			!bytes.Equal(b1.Shards[i].GetShard_ID(), b2.Shards[i].GetShard_ID()) {
 			return false
 		}
@@ -116,3 +116,13 @@ func (x *ShardInfo) SetMode(v ShardMode) {
 func (x *ShardInfo) SetErrorCount(count uint32) {
 	x.ErrorCount = count
 }
+
+// SetFreeSpace sets shard's free disk space in kilobytes.
+func (x *ShardInfo) SetFreeSpace(v uint64) {
+	x.FreeSpace = v
+}
+
+// SetCapacity sets shard's total disk space in kilobytes.
+func (x *ShardInfo) SetCapacity(v uint64) {
+	x.Capacity = v
+}
@@ -145,6 +145,14 @@ func generateShardInfo(id int) *control.ShardInfo {
 		{Type: blobovniczatree.Type, Path: filepath.Join(path, "blobtree")}}
 	si.SetWriteCachePath(filepath.Join(path, "writecache"))
 	si.SetPiloramaPath(filepath.Join(path, "pilorama"))
+	si.SetFreeSpace(uint64(id) * 1024)
+	si.SetCapacity(uint64(id) * 4096)
+	si.Scrub = &control.ScrubStatus{
+		Checked:            uint64(id) * 100,
+		Corrupted:          uint64(id),
+		Passes:             uint64(id),
+		LastPassFinishedAt: int64(id) * 3600,
+	}
 
 	return si
 }
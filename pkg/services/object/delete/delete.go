@@ -4,15 +4,20 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // Delete serves requests to remote the objects.
-func (s *Service) Delete(ctx context.Context, prm Prm) error {
+func (s *Service) Delete(ctx context.Context, prm Prm) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "deletesvc.Delete", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	// If session token is not found we will fail during tombstone PUT.
 	// Here we fail immediately to ensure no unnecessary network communication is done.
 	if tok := prm.common.SessionToken(); tok != nil {
-		_, err := s.keyStorage.GetKey(&util.SessionInfo{
+		_, err = s.keyStorage.GetKey(&util.SessionInfo{
 			ID:    tok.ID(),
 			Owner: tok.Issuer(),
 		})
@@ -31,7 +36,9 @@ func (s *Service) Delete(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
-	return exec.statusError.err
+	err = exec.statusError.err
+
+	return
 }
 
 func (exec *execCtx) execute() {
@@ -187,6 +187,11 @@ func (exec *execCtx) collectChildren() bool {
 }
 
 func (exec *execCtx) supplementBySplitID() bool {
+	if exec.splitInfo.SplitID() == nil {
+		// erasure-coded objects have no split ID
+		return true
+	}
+
 	exec.log.Debug("supplement by split ID")
 
 	chain, err := exec.svc.searcher.splitMembers(exec)
@@ -6,6 +6,7 @@ import (
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
@@ -62,9 +63,43 @@ func (w *headSvcWrapper) children(exec *execCtx) ([]oid.ID, error) {
 		return nil, err
 	}
 
+	if _, ok := erasurecode.ManifestRule(linking); ok {
+		return w.erasureCodedParts(exec, a)
+	}
+
 	return linking.Children(), nil
 }
 
+// erasureCodedParts returns IDs of the parts listed in the erasure coding
+// manifest. Part headers are carried in the manifest payload, so the full
+// object is requested.
+func (w *headSvcWrapper) erasureCodedParts(exec *execCtx, manifestAddr oid.Address) ([]oid.ID, error) {
+	wr := getsvc.NewSimpleObjectWriter()
+
+	p := getsvc.Prm{}
+	p.SetCommonParameters(exec.commonParameters())
+	p.SetObjectWriter(wr)
+	p.WithRawFlag(true)
+	p.WithAddress(manifestAddr)
+
+	err := (*getsvc.Service)(w).Get(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	_, hdrs, err := erasurecode.ParseManifest(wr.Object())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]oid.ID, len(hdrs))
+	for i := range hdrs {
+		ids[i], _ = hdrs[i].ID()
+	}
+
+	return ids, nil
+}
+
 func (w *headSvcWrapper) previous(exec *execCtx, id oid.ID) (*oid.ID, error) {
 	a := exec.newAddress(id)
 
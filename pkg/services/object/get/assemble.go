@@ -1,6 +1,7 @@
 package getsvc
 
 import (
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -44,7 +45,19 @@ func (exec *execCtx) assemble() {
 		}
 	}
 
-	prev, children := exec.initFromChild(childID)
+	exec.log.Debug("starting assembling from child", zap.Stringer("child ID", childID))
+
+	child, ok := exec.getChild(childID, nil, true)
+	if !ok {
+		return
+	}
+
+	if rule, ok := erasurecode.ManifestRule(child); ok {
+		exec.assembleErasureCoded(rule, child)
+		return
+	}
+
+	prev, children := exec.initFromChild(child)
 
 	if len(children) > 0 {
 		if exec.ctxRange() == nil {
@@ -77,21 +90,12 @@ func (exec *execCtx) assemble() {
 	}
 }
 
-func (exec *execCtx) initFromChild(obj oid.ID) (prev *oid.ID, children []oid.ID) {
-	log := exec.log.With(zap.Stringer("child ID", obj))
-
-	log.Debug("starting assembling from child")
-
-	child, ok := exec.getChild(obj, nil, true)
-	if !ok {
-		return
-	}
-
+func (exec *execCtx) initFromChild(child *objectSDK.Object) (prev *oid.ID, children []oid.ID) {
 	par := child.Parent()
 	if par == nil {
 		exec.status = statusUndefined
 
-		log.Debug("received child with empty parent")
+		exec.log.Debug("received child with empty parent")
 
 		return
 	}
@@ -0,0 +1,105 @@
+package getsvc
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+var errNotEnoughParts = errors.New("not enough erasure-coded parts to restore the object")
+
+// assembleErasureCoded restores the object from any rule.DataParts parts
+// listed in the manifest.
+func (exec *execCtx) assembleErasureCoded(rule erasurecode.Rule, manifest *objectSDK.Object) {
+	par, hdrs, err := erasurecode.ParseManifest(manifest)
+	if err != nil {
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("invalid erasure coding manifest",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	parSize := par.PayloadSize()
+
+	rng := exec.ctxRange()
+	if rng != nil {
+		seekOff := rng.GetOffset()
+		seekTo := seekOff + rng.GetLength()
+
+		if seekTo < seekOff || parSize < seekOff || parSize < seekTo {
+			var errOutOfRange apistatus.ObjectOutOfRange
+
+			exec.err = &errOutOfRange
+			exec.status = statusOutOfRange
+
+			return
+		}
+	}
+
+	exec.log.Debug("assembling erasure-coded object",
+		zap.Stringer("rule", rule))
+
+	var (
+		parts = make([]*objectSDK.Object, len(hdrs))
+		found int
+	)
+
+	for i := 0; i < len(hdrs) && found < rule.DataParts; i++ {
+		id, _ := hdrs[i].ID()
+
+		part, ok := exec.getChild(id, nil, false)
+		if !ok {
+			continue
+		}
+
+		if err := objectSDK.CheckVerificationFields(part); err != nil {
+			exec.log.Debug("erasure-coded part is invalid",
+				zap.Stringer("part ID", id),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		parts[i] = part
+		found++
+	}
+
+	if found < rule.DataParts {
+		exec.status = statusUndefined
+		exec.err = errNotEnoughParts
+
+		exec.log.Debug("could not collect enough erasure-coded parts",
+			zap.Int("found", found))
+
+		return
+	}
+
+	payload, err := erasurecode.Decode(rule, parts, parSize)
+	if err != nil {
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("could not decode erasure-coded object",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	if rng != nil {
+		payload = payload[rng.GetOffset() : rng.GetOffset()+rng.GetLength()]
+	}
+
+	exec.collectedObject = par
+	exec.collectedObject.SetPayload(payload)
+
+	exec.writeCollectedObject()
+}
@@ -4,17 +4,25 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // Get serves a request to get an object by address, and returns Streamer instance.
-func (s *Service) Get(ctx context.Context, prm Prm) error {
+func (s *Service) Get(ctx context.Context, prm Prm) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "getsvc.Get", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.get(ctx, prm.commonPrm).err
 }
 
 // GetRange serves a request to get an object by address, and returns Streamer instance.
-func (s *Service) GetRange(ctx context.Context, prm RangePrm) error {
+func (s *Service) GetRange(ctx context.Context, prm RangePrm) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "getsvc.GetRange", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.getRange(ctx, prm)
 }
 
@@ -22,7 +30,10 @@ func (s *Service) getRange(ctx context.Context, prm RangePrm, opts ...execOption
 	return s.get(ctx, prm.commonPrm, append(opts, withPayloadRange(prm.rng))...).err
 }
 
-func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHashRes, error) {
+func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (_ *RangeHashRes, err error) {
+	ctx, span := tracing.StartSpan(ctx, "getsvc.GetRangeHash", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	hashes := make([][]byte, 0, len(prm.rngs))
 
 	for _, rng := range prm.rngs {
@@ -42,7 +53,7 @@ func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHas
 			hash: util.NewSaltingWriter(h, prm.salt),
 		})
 
-		if err := s.getRange(ctx, rngPrm); err != nil {
+		if err = s.getRange(ctx, rngPrm); err != nil {
 			return nil, err
 		}
 
@@ -58,7 +69,10 @@ func (s *Service) GetRangeHash(ctx context.Context, prm RangeHashPrm) (*RangeHas
 //
 // Returns ErrNotFound if the header was not received for the call.
 // Returns SplitInfoError if object is virtual and raw flag is set.
-func (s *Service) Head(ctx context.Context, prm HeadPrm) error {
+func (s *Service) Head(ctx context.Context, prm HeadPrm) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "getsvc.Head", attribute.Stringer("address", prm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	return s.get(ctx, prm.commonPrm, headOnly()).err
 }
 
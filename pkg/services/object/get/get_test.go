@@ -8,11 +8,13 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -23,6 +25,7 @@ import (
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1115,6 +1118,103 @@ func TestGetRemoteSmall(t *testing.T) {
 				require.Equal(t, payload[off:off+ln], w.Object().Payload())
 			})
 		})
+
+		t.Run("erasure-coded", func(t *testing.T) {
+			key, err := keys.NewPrivateKey()
+			require.NoError(t, err)
+
+			payload := make([]byte, 100)
+			_, _ = rand.Read(payload)
+
+			srcObj := objectSDK.New()
+			srcObj.SetContainerID(idCnr)
+			srcObj.SetOwnerID(usertest.ID())
+			srcObj.SetPayload(payload)
+			srcObj.SetPayloadSize(uint64(len(payload)))
+			require.NoError(t, objectSDK.SetIDWithSignature(key.PrivateKey, srcObj))
+
+			rule := erasurecode.Rule{DataParts: 3, ParityParts: 2}
+
+			parts, manifest, err := erasurecode.Encode(rule, srcObj, key.PrivateKey)
+			require.NoError(t, err)
+
+			addr := object.AddressOf(srcObj)
+			manifestAddr := object.AddressOf(manifest)
+
+			splitInfo := objectSDK.NewSplitInfo()
+			splitInfo.SetLink(manifestAddr.Object())
+
+			ns, as := testNodeMatrix(t, []int{2})
+
+			c1 := newTestClient()
+			c1.addResult(addr, nil, errors.New("any error"))
+			c1.addResult(manifestAddr, nil, errors.New("any error"))
+
+			c2 := newTestClient()
+			c2.addResult(addr, nil, objectSDK.NewSplitInfoError(splitInfo))
+			c2.addResult(manifestAddr, manifest, nil)
+
+			builder := &testPlacementBuilder{
+				vectors: map[string][][]netmap.NodeInfo{
+					addr.EncodeToString():         ns,
+					manifestAddr.EncodeToString(): ns,
+				},
+			}
+
+			for i := range parts {
+				partAddr := object.AddressOf(parts[i])
+				builder.vectors[partAddr.EncodeToString()] = ns
+
+				// as many parts as there are parity ones are lost
+				if i%2 == 0 {
+					c1.addResult(partAddr, parts[i], nil)
+				}
+			}
+
+			svc := newSvc(builder, &testClientCache{
+				clients: map[string]*testClient{
+					as[0][0]: c1,
+					as[0][1]: c2,
+				},
+			})
+
+			testHeadVirtual(svc, addr, splitInfo)
+
+			w := NewSimpleObjectWriter()
+
+			p := newPrm(false, w)
+			p.WithAddress(addr)
+
+			err = svc.Get(ctx, p)
+			require.NoError(t, err)
+			require.Equal(t, addr, object.AddressOf(w.Object()))
+			require.Equal(t, payload, w.Object().Payload())
+
+			w = NewSimpleObjectWriter()
+
+			rngPrm := newRngPrm(false, w, 10, 50)
+			rngPrm.WithAddress(addr)
+
+			err = svc.GetRange(ctx, rngPrm)
+			require.NoError(t, err)
+			require.Equal(t, payload[10:60], w.Object().Payload())
+
+			rngPrm = newRngPrm(false, NewSimpleObjectWriter(), 90, 20)
+			rngPrm.WithAddress(addr)
+
+			err = svc.GetRange(ctx, rngPrm)
+			require.ErrorAs(t, err, new(*apistatus.ObjectOutOfRange))
+
+			t.Run("not enough parts", func(t *testing.T) {
+				c1.addResult(object.AddressOf(parts[0]), nil, apistatus.ObjectNotFound{})
+
+				p := newPrm(false, NewSimpleObjectWriter())
+				p.WithAddress(addr)
+
+				err = svc.Get(ctx, p)
+				require.ErrorIs(t, err, errNotEnoughParts)
+			})
+		})
 	})
 }
 
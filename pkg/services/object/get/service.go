@@ -2,6 +2,7 @@ package getsvc
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
@@ -47,6 +48,8 @@ type cfg struct {
 	}
 
 	keyStore *util.KeyStorage
+
+	cnrSource container.Source
 }
 
 func defaultCfg() *cfg {
@@ -67,6 +70,10 @@ func New(opts ...Option) *Service {
 		opts[i](c)
 	}
 
+	if w, ok := c.localStorage.(*storageEngineWrapper); ok {
+		w.cnrSource = c.cnrSource
+	}
+
 	return &Service{
 		cfg: c,
 	}
@@ -94,6 +101,14 @@ func WithLocalStorageEngine(e *engine.StorageEngine) Option {
 	}
 }
 
+// WithContainerSource returns option to set container source to look
+// for the erasure-coded objects in the local storage.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSource = src
+	}
+}
+
 type ClientConstructor interface {
 	Get(client.NodeInfo) (client.MultiAddressClient, error)
 }
@@ -1,17 +1,27 @@
 package getsvc
 
 import (
+	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	internal "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 )
 
 type SimpleObjectWriter struct {
@@ -30,6 +40,9 @@ type clientWrapper struct {
 
 type storageEngineWrapper struct {
 	engine *engine.StorageEngine
+
+	// container source to check the erasure coding rule, optional
+	cnrSource container.Source
 }
 
 type partWriter struct {
@@ -86,7 +99,11 @@ func (c *clientCacheWrapper) get(info coreclient.NodeInfo) (getClient, error) {
 	}, nil
 }
 
-func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*object.Object, error) {
+func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (_ *object.Object, err error) {
+	ctx, span := tracing.StartSpan(exec.context(), "getsvc.remote",
+		attribute.String("node_key", hex.EncodeToString(info.PublicKey())))
+	defer func() { tracing.Finish(span, err) }()
+
 	if exec.isForwardingEnabled() {
 		return exec.prm.forwarder(info, c.client)
 	}
@@ -99,7 +116,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if exec.headOnly() {
 		var prm internalclient.HeadObjectPrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -125,7 +142,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if rng := exec.ctxRange(); rng != nil {
 		var prm internalclient.PayloadRangePrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -146,7 +163,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 			if errors.As(err, &errAccessDenied) {
 				// Current spec allows other storage node to deny access,
 				// fallback to GET here.
-				obj, err := c.get(exec, key)
+				obj, err := c.get(ctx, exec, key)
 				if err != nil {
 					return nil, err
 				}
@@ -167,13 +184,13 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 		return payloadOnlyObject(res.PayloadRange()), nil
 	}
 
-	return c.get(exec, key)
+	return c.get(ctx, exec, key)
 }
 
-func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Object, error) {
+func (c *clientWrapper) get(ctx context.Context, exec *execCtx, key *ecdsa.PrivateKey) (*object.Object, error) {
 	var prm internalclient.GetObjectPrm
 
-	prm.SetContext(exec.context())
+	prm.SetContext(ctx)
 	prm.SetClient(c.client)
 	prm.SetTTL(exec.prm.common.TTL())
 	prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -196,10 +213,95 @@ func (c *clientWrapper) get(exec *execCtx, key *ecdsa.PrivateKey) (*object.Objec
 }
 
 func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
+	obj, err := e.getObject(exec)
+	if err == nil || !errors.As(err, new(apistatus.ObjectNotFound)) {
+		return obj, err
+	}
+
+	// erasure-coded objects are not stored, they are described by the
+	// manifests, so the manifests are looked for in such containers only
+	ec, ecErr := e.isErasureCoded(exec.address().Container())
+	if ecErr != nil {
+		exec.log.Debug("could not check container erasure coding rule",
+			zap.String("error", ecErr.Error()),
+		)
+
+		return nil, err
+	} else if !ec {
+		return nil, err
+	}
+
+	manifestAddr, ecErr := e.erasureCodingManifest(exec.address())
+	if ecErr != nil {
+		exec.log.Debug("could not look for erasure coding manifest",
+			zap.String("error", ecErr.Error()),
+		)
+
+		return nil, err
+	} else if manifestAddr == nil {
+		return nil, err
+	}
+
+	if exec.headOnly() && !exec.isRaw() {
+		manifest, err := engine.Get(e.engine, *manifestAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		par, _, err := erasurecode.ParseManifest(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid erasure coding manifest %s: %w", manifestAddr, err)
+		}
+
+		return par, nil
+	}
+
+	splitInfo := object.NewSplitInfo()
+	splitInfo.SetLink(manifestAddr.Object())
+
+	return nil, object.NewSplitInfoError(splitInfo)
+}
+
+// isErasureCoded checks whether the objects of the container are
+// erasure-coded. Returns false if the container source is not set.
+func (e *storageEngineWrapper) isErasureCoded(cnrID cid.ID) (bool, error) {
+	if e.cnrSource == nil {
+		return false, nil
+	}
+
+	cnr, err := e.cnrSource.Get(cnrID)
+	if err != nil {
+		return false, fmt.Errorf("could not get container: %w", err)
+	}
+
+	rule, err := erasurecode.RuleFromContainer(cnr.Value)
+	if err != nil {
+		return false, err
+	}
+
+	return rule != nil, nil
+}
+
+// erasureCodingManifest returns the address of the manifest of the
+// erasure-coded object stored locally. Manifests are broadcast to all
+// container nodes. Returns nil if there is no manifest.
+func (e *storageEngineWrapper) erasureCodingManifest(addr oid.Address) (*oid.Address, error) {
+	res, err := engine.Select(e.engine, addr.Container(), erasurecode.ManifestFilters(addr.Object()))
+	if err != nil {
+		return nil, fmt.Errorf("could not select manifest: %w", err)
+	} else if len(res) == 0 {
+		return nil, nil
+	}
+
+	return &res[0], nil
+}
+
+func (e *storageEngineWrapper) getObject(exec *execCtx) (*object.Object, error) {
 	if exec.headOnly() {
 		var headPrm engine.HeadPrm
 		headPrm.WithAddress(exec.address())
 		headPrm.WithRaw(exec.isRaw())
+		headPrm.WithContext(exec.context())
 
 		r, err := e.engine.Head(headPrm)
 		if err != nil {
@@ -211,6 +313,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		var getRange engine.RngPrm
 		getRange.WithAddress(exec.address())
 		getRange.WithPayloadRange(rng)
+		getRange.WithContext(exec.context())
 
 		r, err := e.engine.GetRange(getRange)
 		if err != nil {
@@ -221,6 +324,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	} else {
 		var getPrm engine.GetPrm
 		getPrm.WithAddress(exec.address())
+		getPrm.WithContext(exec.context())
 
 		r, err := e.engine.Get(getPrm)
 		if err != nil {
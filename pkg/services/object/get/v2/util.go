@@ -23,6 +23,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	neofscrypto "github.com/nspcc-dev/neofs-sdk-go/crypto"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
@@ -102,7 +103,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 			// open stream
 			var getStream *rpc.GetResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				getStream, err = rpc.GetObject(cli, req, rpcclient.WithContext(stream.Context()))
+				getStream, err = rpc.GetObject(cli, req, rpcclient.WithContext(tracing.InjectOutgoingContext(stream.Context())))
 				return err
 			})
 			if err != nil {
@@ -272,7 +273,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 			// open stream
 			var rangeStream *rpc.ObjectRangeResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				rangeStream, err = rpc.GetObjectRange(cli, req, rpcclient.WithContext(stream.Context()))
+				rangeStream, err = rpc.GetObjectRange(cli, req, rpcclient.WithContext(tracing.InjectOutgoingContext(stream.Context())))
 				return err
 			})
 			if err != nil {
@@ -491,7 +492,7 @@ func (s *Service) toHeadPrm(ctx context.Context, req *objectV2.HeadRequest, resp
 			// send Head request
 			var headResp *objectV2.HeadResponse
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				headResp, err = rpc.HeadObject(cli, req, rpcclient.WithContext(ctx))
+				headResp, err = rpc.HeadObject(cli, req, rpcclient.WithContext(tracing.InjectOutgoingContext(ctx)))
 				return err
 			})
 			if err != nil {
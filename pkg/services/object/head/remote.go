@@ -9,9 +9,11 @@ import (
 	netmapCore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type ClientConstructor interface {
@@ -64,7 +66,10 @@ func (p *RemoteHeadPrm) WithObjectAddress(v oid.Address) *RemoteHeadPrm {
 }
 
 // Head requests object header from the remote node.
-func (h *RemoteHeader) Head(ctx context.Context, prm *RemoteHeadPrm) (*object.Object, error) {
+func (h *RemoteHeader) Head(ctx context.Context, prm *RemoteHeadPrm) (_ *object.Object, err error) {
+	ctx, span := tracing.StartSpan(ctx, "headsvc.remote", attribute.Stringer("address", prm.commonHeadPrm.addr))
+	defer func() { tracing.Finish(span, err) }()
+
 	key, err := h.keyStorage.GetKey(nil)
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not receive private key: %w", h, err)
@@ -459,6 +459,8 @@ type SearchObjectsPrm struct {
 	readPrmCommon
 
 	cliPrm client.PrmObjectSearch
+
+	handler func([]oid.ID) error
 }
 
 // SetContainerID sets identifier of the container to search the objects.
@@ -473,6 +475,14 @@ func (x *SearchObjectsPrm) SetFilters(fs object.SearchFilters) {
 	x.cliPrm.SetFilters(fs)
 }
 
+// SetIDHandler sets the handler of the object identifiers received from
+// the server. If set, identifiers are passed to the handler as they arrive
+// instead of being collected in the result. Any handler error aborts
+// the operation.
+func (x *SearchObjectsPrm) SetIDHandler(f func([]oid.ID) error) {
+	x.handler = f
+}
+
 // SearchObjectsRes groups the resulting values of SearchObjects operation.
 type SearchObjectsRes struct {
 	ids []oid.ID
@@ -518,9 +528,15 @@ func SearchObjects(prm SearchObjectsPrm) (*SearchObjectsRes, error) {
 	for {
 		n, ok = rdr.Read(buf)
 		if n > 0 {
-			for i := range buf[:n] {
-				v := buf[i]
-				ids = append(ids, v)
+			if prm.handler != nil {
+				// buffer is reused, so the handler receives a copy
+				err = prm.handler(append([]oid.ID(nil), buf[:n]...))
+				if err != nil {
+					_, _ = rdr.Close()
+					return nil, err
+				}
+			} else {
+				ids = append(ids, buf[:n]...)
 			}
 		}
 
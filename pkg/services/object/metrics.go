@@ -35,13 +35,13 @@ type (
 		IncRangeReqCounter(success bool)
 		IncRangeHashReqCounter(success bool)
 
-		AddGetReqDuration(time.Duration)
-		AddPutReqDuration(time.Duration)
-		AddHeadReqDuration(time.Duration)
-		AddSearchReqDuration(time.Duration)
-		AddDeleteReqDuration(time.Duration)
-		AddRangeReqDuration(time.Duration)
-		AddRangeHashReqDuration(time.Duration)
+		AddGetReqDuration(success bool, d time.Duration)
+		AddPutReqDuration(success bool, d time.Duration)
+		AddHeadReqDuration(success bool, d time.Duration)
+		AddSearchReqDuration(success bool, d time.Duration)
+		AddDeleteReqDuration(success bool, d time.Duration)
+		AddRangeReqDuration(success bool, d time.Duration)
+		AddRangeHashReqDuration(success bool, d time.Duration)
 
 		AddPutPayload(int)
 		AddGetPayload(int)
@@ -59,7 +59,7 @@ func (m MetricCollector) Get(req *object.GetRequest, stream GetObjectStream) (er
 	t := time.Now()
 	defer func() {
 		m.metrics.IncGetReqCounter(err == nil)
-		m.metrics.AddGetReqDuration(time.Since(t))
+		m.metrics.AddGetReqDuration(err == nil, time.Since(t))
 	}()
 
 	err = m.next.Get(req, &getStreamMetric{
@@ -91,7 +91,7 @@ func (m MetricCollector) Head(ctx context.Context, request *object.HeadRequest)
 	res, err := m.next.Head(ctx, request)
 
 	m.metrics.IncHeadReqCounter(err == nil)
-	m.metrics.AddHeadReqDuration(time.Since(t))
+	m.metrics.AddHeadReqDuration(err == nil, time.Since(t))
 
 	return res, err
 }
@@ -102,7 +102,7 @@ func (m MetricCollector) Search(req *object.SearchRequest, stream SearchStream)
 	err := m.next.Search(req, stream)
 
 	m.metrics.IncSearchReqCounter(err == nil)
-	m.metrics.AddSearchReqDuration(time.Since(t))
+	m.metrics.AddSearchReqDuration(err == nil, time.Since(t))
 
 	return err
 }
@@ -113,7 +113,7 @@ func (m MetricCollector) Delete(ctx context.Context, request *object.DeleteReque
 	res, err := m.next.Delete(ctx, request)
 
 	m.metrics.IncDeleteReqCounter(err == nil)
-	m.metrics.AddDeleteReqDuration(time.Since(t))
+	m.metrics.AddDeleteReqDuration(err == nil, time.Since(t))
 
 	return res, err
 }
@@ -124,7 +124,7 @@ func (m MetricCollector) GetRange(req *object.GetRangeRequest, stream GetObjectR
 	err := m.next.GetRange(req, stream)
 
 	m.metrics.IncRangeReqCounter(err == nil)
-	m.metrics.AddRangeReqDuration(time.Since(t))
+	m.metrics.AddRangeReqDuration(err == nil, time.Since(t))
 
 	return err
 }
@@ -135,7 +135,7 @@ func (m MetricCollector) GetRangeHash(ctx context.Context, request *object.GetRa
 	res, err := m.next.GetRangeHash(ctx, request)
 
 	m.metrics.IncRangeHashReqCounter(err == nil)
-	m.metrics.AddRangeHashReqDuration(time.Since(t))
+	m.metrics.AddRangeHashReqDuration(err == nil, time.Since(t))
 
 	return res, err
 }
@@ -162,7 +162,7 @@ func (s putStreamMetric) CloseAndRecv() (*object.PutResponse, error) {
 	res, err := s.stream.CloseAndRecv()
 
 	s.metrics.IncPutReqCounter(err == nil)
-	s.metrics.AddPutReqDuration(time.Since(s.start))
+	s.metrics.AddPutReqDuration(err == nil, time.Since(s.start))
 
 	return res, err
 }
@@ -38,6 +38,9 @@ type distributedTarget struct {
 
 	fmt *object.FormatValidator
 
+	// erasure coding parameters, nil if the object is replicated fully
+	ec *ecParams
+
 	log *logger.Logger
 }
 
@@ -141,6 +144,10 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.ec != nil && erasureCodable(t.obj) {
+		return t.putErasureCoded()
+	}
+
 	if len(t.obj.Children()) > 0 {
 		// enabling extra broadcast for linking objects
 		t.traversal.extraBroadcastEnabled = true
@@ -154,9 +161,13 @@ func (t *distributedTarget) sendObject(node nodeDesc) error {
 		return t.relay(node)
 	}
 
+	return t.writeObject(node, t.obj, t.objMeta)
+}
+
+func (t *distributedTarget) writeObject(node nodeDesc, obj *objectSDK.Object, meta object.ContentMeta) error {
 	target := t.nodeTargetInitializer(node)
 
-	if err := target.WriteObject(t.obj, t.objMeta); err != nil {
+	if err := target.WriteObject(obj, meta); err != nil {
 		return fmt.Errorf("could not write header: %w", err)
 	} else if _, err := target.Close(); err != nil {
 		return fmt.Errorf("could not close object stream: %w", err)
@@ -0,0 +1,169 @@
+package putsvc
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.uber.org/zap"
+)
+
+// erasure coding parameters of the container objects formed by the node.
+type ecParams struct {
+	rule erasurecode.Rule
+
+	// key to sign the parts and the manifest with
+	key *ecdsa.PrivateKey
+}
+
+// checks if the object can be erasure-coded. Objects of the split-chain and
+// system objects are replicated fully.
+func erasureCodable(obj *objectSDK.Object) bool {
+	return obj.Type() == objectSDK.TypeRegular &&
+		obj.PayloadSize() > 0 &&
+		obj.SplitID() == nil &&
+		obj.Parent() == nil &&
+		len(obj.Children()) == 0
+}
+
+// putErasureCoded saves the erasure-coded parts of the object on the distinct
+// nodes of the object placement: the i-th part is saved on the i-th node.
+// The object is saved once any rule.DataParts parts are saved, the missing
+// parts are restored by the policer. After that, the manifest is broadcast
+// to all container nodes.
+func (t *distributedTarget) putErasureCoded() (*transformer.AccessIdentifiers, error) {
+	parts, manifest, err := erasurecode.Encode(t.ec.rule, t.obj, *t.ec.key)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not encode object: %w", t, err)
+	}
+
+	nodes, err := t.erasureCodedPlacement()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) < len(parts) {
+		return nil, fmt.Errorf("(%T) not enough container nodes for erasure coding rule %s: %d",
+			t, t.ec.rule, len(nodes))
+	}
+
+	var (
+		wg     sync.WaitGroup
+		resErr atomic.Value
+		failed uint32
+	)
+
+	for i := range parts {
+		node := nodeDesc{
+			local: t.isLocalKey(nodes[i].PublicKey()),
+			info:  nodes[i],
+		}
+
+		workerPool := t.remotePool
+		if node.local {
+			workerPool = t.localPool
+		}
+
+		part := parts[i]
+
+		wg.Add(1)
+
+		if err := workerPool.Submit(func() {
+			defer wg.Done()
+
+			err := t.writeObject(node, part, object.ContentMeta{})
+			if err != nil {
+				atomic.AddUint32(&failed, 1)
+				resErr.Store(err)
+				svcutil.LogServiceError(t.log, "PUT", node.info.Addresses(), err)
+			}
+		}); err != nil {
+			wg.Done()
+			atomic.AddUint32(&failed, 1)
+
+			svcutil.LogWorkerPoolError(t.log, "PUT", err)
+		}
+	}
+
+	wg.Wait()
+
+	if stored := len(parts) - int(failed); stored < t.ec.rule.DataParts {
+		var err errIncompletePut
+
+		err.singleErr, _ = resErr.Load().(error)
+
+		return nil, err
+	} else if failed > 0 {
+		t.log.Debug("not all erasure-coded parts are saved, leaving them to the policer",
+			zap.Int("saved", stored),
+			zap.Int("total", len(parts)),
+		)
+	}
+
+	mt := &distributedTarget{
+		traversal: traversal{
+			opts: t.traversal.opts,
+
+			// manifest is needed on every container node to assemble the object
+			extraBroadcastEnabled: true,
+		},
+		remotePool:            t.remotePool,
+		localPool:             t.localPool,
+		obj:                   manifest,
+		nodeTargetInitializer: t.nodeTargetInitializer,
+		isLocalKey:            t.isLocalKey,
+		fmt:                   t.fmt,
+		log:                   t.log,
+	}
+
+	if _, err := mt.iteratePlacement(mt.sendObject); err != nil {
+		return nil, fmt.Errorf("(%T) could not save erasure coding manifest: %w", t, err)
+	}
+
+	id, _ := t.obj.ID()
+
+	return new(transformer.AccessIdentifiers).
+		WithSelfID(id), nil
+}
+
+// erasureCodedPlacement returns distinct nodes of the object placement in
+// the traversal order.
+func (t *distributedTarget) erasureCodedPlacement() ([]placement.Node, error) {
+	id, _ := t.obj.ID()
+
+	traverser, err := placement.NewTraverser(
+		append(t.traversal.opts, placement.ForObject(id), placement.WithoutSuccessTracking())...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var (
+		res  []placement.Node
+		seen = make(map[string]struct{})
+	)
+
+	for {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			key := string(addrs[i].PublicKey())
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				res = append(res, addrs[i])
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -1,28 +1,33 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // ObjectStorage is an object storage interface.
 type ObjectStorage interface {
 	// Put must save passed object
 	// and return any appeared error.
-	Put(*object.Object) error
+	Put(ctx context.Context, obj *object.Object) error
 	// Delete must delete passed objects
 	// and return any appeared error.
-	Delete(tombstone oid.Address, toDelete []oid.ID) error
+	Delete(ctx context.Context, tombstone oid.Address, toDelete []oid.ID) error
 	// Lock must lock passed objects
 	// and return any appeared error.
-	Lock(locker oid.Address, toLock []oid.ID) error
+	Lock(ctx context.Context, locker oid.Address, toLock []oid.ID) error
 }
 
 type localTarget struct {
+	ctx context.Context
+
 	storage ObjectStorage
 
 	obj  *object.Object
@@ -36,15 +41,18 @@ func (t *localTarget) WriteObject(obj *object.Object, meta objectCore.ContentMet
 	return nil
 }
 
-func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
+func (t *localTarget) Close() (_ *transformer.AccessIdentifiers, err error) {
+	ctx, span := tracing.StartSpan(t.ctx, "putsvc.local", attribute.Stringer("address", objectCore.AddressOf(t.obj)))
+	defer func() { tracing.Finish(span, err) }()
+
 	switch t.meta.Type() {
 	case object.TypeTombstone:
-		err := t.storage.Delete(objectCore.AddressOf(t.obj), t.meta.Objects())
+		err := t.storage.Delete(ctx, objectCore.AddressOf(t.obj), t.meta.Objects())
 		if err != nil {
 			return nil, fmt.Errorf("could not delete objects from tombstone locally: %w", err)
 		}
 	case object.TypeLock:
-		err := t.storage.Lock(objectCore.AddressOf(t.obj), t.meta.Objects())
+		err := t.storage.Lock(ctx, objectCore.AddressOf(t.obj), t.meta.Objects())
 		if err != nil {
 			return nil, fmt.Errorf("could not lock object from lock objects locally: %w", err)
 		}
@@ -52,7 +60,7 @@ func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
 		// objects that do not change meta storage
 	}
 
-	if err := t.storage.Put(t.obj); err != nil {
+	if err := t.storage.Put(ctx, t.obj); err != nil {
 		return nil, fmt.Errorf("(%T) could not put object to local storage: %w", t, err)
 	}
 
@@ -1,6 +1,8 @@
 package putsvc
 
 import (
+	"context"
+
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
@@ -17,7 +19,9 @@ type PutInitPrm struct {
 
 	traverseOpts []placement.Option
 
-	relay func(client.NodeInfo, client.MultiAddressClient) error
+	ec *ecParams
+
+	relay func(context.Context, client.NodeInfo, client.MultiAddressClient) error
 }
 
 type PutChunkPrm struct {
@@ -40,7 +44,7 @@ func (p *PutInitPrm) WithObject(v *object.Object) *PutInitPrm {
 	return p
 }
 
-func (p *PutInitPrm) WithRelay(f func(client.NodeInfo, client.MultiAddressClient) error) *PutInitPrm {
+func (p *PutInitPrm) WithRelay(f func(context.Context, client.NodeInfo, client.MultiAddressClient) error) *PutInitPrm {
 	if p != nil {
 		p.relay = f
 	}
@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -10,8 +11,10 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type remoteTarget struct {
@@ -49,7 +52,12 @@ func (t *remoteTarget) WriteObject(obj *object.Object, _ objectcore.ContentMeta)
 	return nil
 }
 
-func (t *remoteTarget) Close() (*transformer.AccessIdentifiers, error) {
+func (t *remoteTarget) Close() (_ *transformer.AccessIdentifiers, err error) {
+	ctx, span := tracing.StartSpan(t.ctx, "putsvc.remote",
+		attribute.Stringer("address", objectcore.AddressOf(t.obj)),
+		attribute.String("node_key", hex.EncodeToString(t.nodeInfo.PublicKey())))
+	defer func() { tracing.Finish(span, err) }()
+
 	var sessionInfo *util.SessionInfo
 
 	if tok := t.commonPrm.SessionToken(); tok != nil {
@@ -71,7 +79,7 @@ func (t *remoteTarget) Close() (*transformer.AccessIdentifiers, error) {
 
 	var prm internalclient.PutObjectPrm
 
-	prm.SetContext(t.ctx)
+	prm.SetContext(ctx)
 	prm.SetClient(c)
 	prm.SetPrivateKey(key)
 	prm.SetSessionToken(t.commonPrm.SessionToken())
@@ -10,6 +10,7 @@ import (
 	objutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"go.uber.org/zap"
 )
 
@@ -21,6 +22,13 @@ type MaxSizeSource interface {
 	MaxObjectSize() uint64
 }
 
+// QuotaChecker checks the container quotas.
+type QuotaChecker interface {
+	// Check must return an error if the object of the given payload
+	// size can not be stored in the container.
+	Check(cnr cid.ID, size uint64) error
+}
+
 type Service struct {
 	*cfg
 }
@@ -54,6 +62,8 @@ type cfg struct {
 
 	clientConstructor ClientConstructor
 
+	quota QuotaChecker
+
 	log *logger.Logger
 }
 
@@ -141,6 +151,14 @@ func WithClientConstructor(v ClientConstructor) Option {
 	}
 }
 
+// WithQuotaChecker returns option to check container quotas
+// before storing new objects.
+func WithQuotaChecker(v QuotaChecker) Option {
+	return func(c *cfg) {
+		c.quota = v
+	}
+}
+
 func WithLogger(l *logger.Logger) Option {
 	return func(c *cfg) {
 		c.log = l
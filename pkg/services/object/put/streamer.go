@@ -8,11 +8,15 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	"github.com/nspcc-dev/neofs-sdk-go/user"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Streamer struct {
@@ -22,16 +26,26 @@ type Streamer struct {
 
 	target transformer.ObjectTarget
 
-	relay func(client.NodeInfo, client.MultiAddressClient) error
+	relay func(context.Context, client.NodeInfo, client.MultiAddressClient) error
 
 	maxPayloadSz uint64 // network config
+
+	// quotaCnr is the container which quota is checked, nil if the object is not checked
+	quotaCnr *cid.ID
+	// quotaChecked is the payload size the quota has been checked for
+	quotaChecked uint64
+	// payloadSz is the size of the payload received so far
+	payloadSz uint64
 }
 
 var errNotInit = errors.New("stream not initialized")
 
 var errInitRecall = errors.New("init recall")
 
-func (p *Streamer) Init(prm *PutInitPrm) error {
+func (p *Streamer) Init(prm *PutInitPrm) (err error) {
+	_, span := tracing.StartSpan(p.ctx, "putsvc.Init")
+	defer func() { tracing.Finish(span, err) }()
+
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
 		return fmt.Errorf("(%T) could not initialize object target: %w", p, err)
@@ -61,6 +75,10 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		return fmt.Errorf("(%T) could not prepare put parameters: %w", p, err)
 	}
 
+	if err := p.checkQuota(prm); err != nil {
+		return fmt.Errorf("(%T) container quota check failed: %w", p, err)
+	}
+
 	p.maxPayloadSz = p.maxSizeSrc.MaxObjectSize()
 	if p.maxPayloadSz == 0 {
 		return fmt.Errorf("(%T) could not obtain max object size parameter", p)
@@ -115,6 +133,20 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		}
 	}
 
+	if !prm.common.LocalOnly() {
+		rule, err := erasurecode.RuleFromContainer(prm.cnr)
+		if err != nil {
+			return fmt.Errorf("(%T) could not read container erasure coding rule: %w", p, err)
+		}
+
+		if rule != nil {
+			prm.ec = &ecParams{
+				rule: *rule,
+				key:  sessionKey,
+			}
+		}
+	}
+
 	p.target = &validatingTarget{
 		fmt:              p.fmtValidator,
 		unpreparedObject: true,
@@ -187,6 +219,40 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 	return nil
 }
 
+// checkQuota checks that the object fits into the container quotas. Tombstones
+// and locks are always accepted since they do not increase the container size
+// significantly and tombstones are needed to free the space.
+//
+// The payload size from the header is checked, it is unknown for the objects
+// streamed to be formed by the node, so the quota is checked again on Close.
+func (p *Streamer) checkQuota(prm *PutInitPrm) error {
+	if p.quota == nil {
+		return nil
+	}
+
+	switch prm.hdr.Type() {
+	case object.TypeTombstone, object.TypeLock:
+		return nil
+	}
+
+	idCnr, _ := prm.hdr.ContainerID()
+
+	p.quotaCnr = &idCnr
+	p.quotaChecked = prm.hdr.PayloadSize()
+
+	return p.quota.Check(idCnr, p.quotaChecked)
+}
+
+// recheckQuota checks the container quotas again if the received payload
+// is bigger than the one checked on Init.
+func (p *Streamer) recheckQuota() error {
+	if p.quotaCnr == nil || p.payloadSz <= p.quotaChecked {
+		return nil
+	}
+
+	return p.quota.Check(*p.quotaCnr, p.payloadSz)
+}
+
 func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 	var relay func(nodeDesc) error
 	if p.relay != nil {
@@ -200,7 +266,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 				return fmt.Errorf("could not create SDK client %s: %w", info.AddressGroup(), err)
 			}
 
-			return p.relay(info, c)
+			return p.relay(p.ctx, info, c)
 		}
 	}
 
@@ -221,6 +287,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		nodeTargetInitializer: func(node nodeDesc) preparedObjectTarget {
 			if node.local {
 				return &localTarget{
+					ctx:     p.ctx,
 					storage: p.localStore,
 				}
 			}
@@ -238,6 +305,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		},
 		relay: relay,
 		fmt:   p.fmtValidator,
+		ec:    prm.ec,
 		log:   p.log,
 
 		isLocalKey: p.netmapKeys.IsLocalKey,
@@ -253,14 +321,26 @@ func (p *Streamer) SendChunk(prm *PutChunkPrm) error {
 		return fmt.Errorf("(%T) could not write payload chunk to target: %w", p, err)
 	}
 
+	p.payloadSz += uint64(len(prm.chunk))
+
 	return nil
 }
 
-func (p *Streamer) Close() (*PutResponse, error) {
+func (p *Streamer) Close() (_ *PutResponse, err error) {
 	if p.target == nil {
 		return nil, errNotInit
 	}
 
+	// object is distributed on close, so the span is
+	// passed to the node targets via the streamer context
+	var span trace.Span
+	p.ctx, span = tracing.StartSpan(p.ctx, "putsvc.Close")
+	defer func() { tracing.Finish(span, err) }()
+
+	if err := p.recheckQuota(); err != nil {
+		return nil, fmt.Errorf("(%T) container quota check failed: %w", p, err)
+	}
+
 	ids, err := p.target.Close()
 	if err != nil {
 		return nil, fmt.Errorf("(%T) could not close object target: %w", p, err)
@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -14,6 +15,7 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 )
 
 type streamer struct {
@@ -119,7 +121,7 @@ func (s *streamer) CloseAndRecv() (*object.PutResponse, error) {
 	return fromPutResponse(resp), nil
 }
 
-func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClient) error {
+func (s *streamer) relayRequest(ctx context.Context, info client.NodeInfo, c client.MultiAddressClient) error {
 	// open stream
 	resp := new(object.PutResponse)
 
@@ -143,7 +145,7 @@ func (s *streamer) relayRequest(info client.NodeInfo, c client.MultiAddressClien
 		var stream *rpc.PutRequestWriter
 
 		err = c.RawForAddress(addr, func(cli *rawclient.Client) error {
-			stream, err = rpc.PutObject(cli, resp)
+			stream, err = rpc.PutObject(cli, resp, rawclient.WithContext(tracing.InjectOutgoingContext(ctx)))
 			return err
 		})
 		if err != nil {
@@ -0,0 +1,251 @@
+package quota
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"go.uber.org/zap"
+)
+
+// UsageSource provides the amount of the container data stored locally.
+type UsageSource interface {
+	// ContainerSize must return the total size of the container
+	// objects stored locally in bytes.
+	ContainerSize(cid.ID) (uint64, error)
+
+	// ListContainers must return the identifiers of the containers
+	// with objects stored locally.
+	ListContainers() ([]cid.ID, error)
+}
+
+// MetricsWriter is an interface that must store container quota metrics.
+type MetricsWriter interface {
+	// SetContainerQuotaUsage must set the container size observed
+	// during the quota check.
+	SetContainerQuotaUsage(cnr string, size uint64)
+
+	// IncContainerQuotaExceeded must increment the number of soft or
+	// hard container quota breaches.
+	IncContainerQuotaExceeded(cnr string, hard bool)
+}
+
+// Usage describes the size and the effective quotas of the container.
+type Usage struct {
+	Container cid.ID
+
+	Size uint64
+
+	Limits Limits
+}
+
+// Checker checks that the new objects fit into the container quotas. The
+// quotas are taken from the container attributes and the local node
+// configuration, the strictest ones are applied.
+type Checker struct {
+	*cfg
+}
+
+// Option is a Checker's constructor option.
+type Option func(*cfg)
+
+type cfg struct {
+	log *logger.Logger
+
+	cnrSrc container.Source
+
+	usage UsageSource
+
+	metrics MetricsWriter
+
+	defaultLimits Limits
+
+	limits map[cid.ID]Limits
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log:    &logger.Logger{Logger: zap.L()},
+		limits: make(map[cid.ID]Limits),
+	}
+}
+
+// NewChecker creates, initializes and returns new Checker instance.
+//
+// Container and usage sources must be set.
+func NewChecker(opts ...Option) *Checker {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Checker{
+		cfg: c,
+	}
+}
+
+// Limits returns the effective quotas of the container.
+func (c *Checker) Limits(cnr cid.ID) (Limits, error) {
+	res, ok := c.limits[cnr]
+	if !ok {
+		res = c.defaultLimits
+	}
+
+	info, err := c.cnrSrc.Get(cnr)
+	if err != nil {
+		return Limits{}, fmt.Errorf("could not get container: %w", err)
+	}
+
+	fromAttr, err := LimitsFromContainer(info.Value)
+	if err != nil {
+		// a broken attribute must not make the container unusable
+		c.log.Warn("ignore container quota attribute",
+			zap.Stringer("cid", cnr),
+			zap.String("error", err.Error()))
+
+		return res, nil
+	}
+
+	return res.Merge(fromAttr), nil
+}
+
+// Usage returns the size and the effective quotas of the container.
+func (c *Checker) Usage(cnr cid.ID) (Usage, error) {
+	limits, err := c.Limits(cnr)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	size, err := c.usage.ContainerSize(cnr)
+	if err != nil {
+		return Usage{}, fmt.Errorf("could not get container size: %w", err)
+	}
+
+	return Usage{
+		Container: cnr,
+		Size:      size,
+		Limits:    limits,
+	}, nil
+}
+
+// List returns the usage of the containers stored locally and of the
+// containers with quotas in the local configuration.
+func (c *Checker) List() ([]Usage, error) {
+	ids, err := c.usage.ListContainers()
+	if err != nil {
+		return nil, fmt.Errorf("could not list containers: %w", err)
+	}
+
+	seen := make(map[cid.ID]struct{}, len(ids))
+	for i := range ids {
+		seen[ids[i]] = struct{}{}
+	}
+
+	for id := range c.limits {
+		if _, ok := seen[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+
+	res := make([]Usage, 0, len(ids))
+
+	for i := range ids {
+		u, err := c.Usage(ids[i])
+		if err != nil {
+			return nil, fmt.Errorf("container %s: %w", ids[i], err)
+		}
+
+		res = append(res, u)
+	}
+
+	return res, nil
+}
+
+// Check checks that the object of the given size can be stored in the
+// container. Returns apistatus.ObjectAccessDenied if the hard quota is exceeded. Exceeding
+// the soft quota is only logged.
+func (c *Checker) Check(cnr cid.ID, size uint64) error {
+	u, err := c.Usage(cnr)
+	if err != nil {
+		return err
+	}
+
+	if u.Limits.IsZero() {
+		return nil
+	}
+
+	strCnr := cnr.EncodeToString()
+	if c.metrics != nil {
+		c.metrics.SetContainerQuotaUsage(strCnr, u.Size)
+	}
+
+	newSize := u.Size + size
+
+	if u.Limits.Hard != 0 && newSize > u.Limits.Hard {
+		if c.metrics != nil {
+			c.metrics.IncContainerQuotaExceeded(strCnr, true)
+		}
+
+		return exceededError(u.Size, u.Limits.Hard)
+	}
+
+	if u.Limits.Soft != 0 && newSize > u.Limits.Soft {
+		if c.metrics != nil {
+			c.metrics.IncContainerQuotaExceeded(strCnr, false)
+		}
+
+		c.log.Warn("container soft quota exceeded",
+			zap.Stringer("cid", cnr),
+			zap.Uint64("usage", newSize),
+			zap.Uint64("limit", u.Limits.Soft))
+	}
+
+	return nil
+}
+
+// WithLogger returns option to specify Checker's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		c.log = l
+	}
+}
+
+// WithContainerSource returns option to specify the source of the
+// containers to read quota attributes from.
+func WithContainerSource(v container.Source) Option {
+	return func(c *cfg) {
+		c.cnrSrc = v
+	}
+}
+
+// WithUsageSource returns option to specify the source of the container sizes.
+func WithUsageSource(v UsageSource) Option {
+	return func(c *cfg) {
+		c.usage = v
+	}
+}
+
+// WithMetrics returns option to specify the container quota metrics writer.
+func WithMetrics(v MetricsWriter) Option {
+	return func(c *cfg) {
+		c.metrics = v
+	}
+}
+
+// WithDefaultLimits returns option to specify quotas applied to all
+// containers without explicitly configured ones.
+func WithDefaultLimits(l Limits) Option {
+	return func(c *cfg) {
+		c.defaultLimits = l
+	}
+}
+
+// WithContainerLimits returns option to specify quotas of the particular
+// container. They override the default limits.
+func WithContainerLimits(cnr cid.ID, l Limits) Option {
+	return func(c *cfg) {
+		c.limits[cnr] = l
+	}
+}
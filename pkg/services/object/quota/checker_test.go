@@ -0,0 +1,147 @@
+package quota
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	containertest "github.com/nspcc-dev/neofs-sdk-go/container/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testContainers map[cid.ID]containerSDK.Container
+
+func (s testContainers) Get(id cid.ID) (*container.Container, error) {
+	cnr, ok := s[id]
+	if !ok {
+		return nil, errors.New("container not found")
+	}
+
+	return &container.Container{Value: cnr}, nil
+}
+
+type testUsage map[cid.ID]uint64
+
+func (u testUsage) ContainerSize(id cid.ID) (uint64, error) {
+	return u[id], nil
+}
+
+func (u testUsage) ListContainers() ([]cid.ID, error) {
+	res := make([]cid.ID, 0, len(u))
+	for id := range u {
+		res = append(res, id)
+	}
+
+	return res, nil
+}
+
+type testMetrics struct {
+	usage      map[string]uint64
+	soft, hard int
+}
+
+func (m *testMetrics) SetContainerQuotaUsage(cnr string, size uint64) {
+	m.usage[cnr] = size
+}
+
+func (m *testMetrics) IncContainerQuotaExceeded(_ string, hard bool) {
+	if hard {
+		m.hard++
+	} else {
+		m.soft++
+	}
+}
+
+func newContainer(soft, hard uint64) containerSDK.Container {
+	cnr := containertest.Container()
+	if soft != 0 {
+		cnr.SetAttribute(AttributeSoft, strconv.FormatUint(soft, 10))
+	}
+	if hard != 0 {
+		cnr.SetAttribute(AttributeHard, strconv.FormatUint(hard, 10))
+	}
+
+	return cnr
+}
+
+func TestChecker(t *testing.T) {
+	var (
+		unlimited = cidtest.ID()
+		withAttr  = cidtest.ID()
+		withCfg   = cidtest.ID()
+		broken    = cidtest.ID()
+	)
+
+	brokenCnr := newContainer(0, 0)
+	brokenCnr.SetAttribute(AttributeHard, "many")
+
+	cnrs := testContainers{
+		unlimited: newContainer(0, 0),
+		withAttr:  newContainer(100, 200),
+		withCfg:   newContainer(0, 500),
+		broken:    brokenCnr,
+	}
+	usage := testUsage{
+		unlimited: 1000,
+		withAttr:  150,
+		withCfg:   150,
+		broken:    150,
+	}
+	m := &testMetrics{usage: make(map[string]uint64)}
+
+	c := NewChecker(
+		WithContainerSource(cnrs),
+		WithUsageSource(usage),
+		WithMetrics(m),
+		WithContainerLimits(withCfg, Limits{Soft: 100, Hard: 1000}),
+		WithContainerLimits(broken, Limits{Hard: 160}))
+
+	require.NoError(t, c.Check(unlimited, 1<<30))
+	require.Empty(t, m.usage)
+
+	// soft quota is exceeded, but the object is accepted
+	require.NoError(t, c.Check(withAttr, 10))
+	require.Equal(t, 1, m.soft)
+	require.EqualValues(t, 150, m.usage[withAttr.EncodeToString()])
+
+	err := c.Check(withAttr, 51)
+	require.ErrorAs(t, err, new(apistatus.ObjectAccessDenied))
+	require.Equal(t, 1, m.hard)
+
+	// the strictest limits are applied
+	l, err := c.Limits(withCfg)
+	require.NoError(t, err)
+	require.Equal(t, Limits{Soft: 100, Hard: 500}, l)
+	require.NoError(t, c.Check(withCfg, 350))
+	require.ErrorAs(t, c.Check(withCfg, 351), new(apistatus.ObjectAccessDenied))
+
+	// invalid attribute is ignored
+	require.NoError(t, c.Check(broken, 10))
+	require.ErrorAs(t, c.Check(broken, 11), new(apistatus.ObjectAccessDenied))
+
+	list, err := c.List()
+	require.NoError(t, err)
+	require.Len(t, list, len(usage))
+
+	t.Run("default limits", func(t *testing.T) {
+		c := NewChecker(
+			WithContainerSource(cnrs),
+			WithUsageSource(usage),
+			WithDefaultLimits(Limits{Hard: 100}),
+			WithContainerLimits(withCfg, Limits{Hard: 1000}))
+
+		require.ErrorAs(t, c.Check(unlimited, 1), new(apistatus.ObjectAccessDenied))
+		require.NoError(t, c.Check(withCfg, 1))
+	})
+
+	t.Run("status", func(t *testing.T) {
+		var errAccessDenied apistatus.ObjectAccessDenied
+		require.ErrorAs(t, c.Check(withAttr, 51), &errAccessDenied)
+		require.Equal(t, "container quota exceeded: usage 150, limit 200", errAccessDenied.Reason())
+	})
+}
@@ -0,0 +1,18 @@
+package quota
+
+import (
+	"fmt"
+
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+)
+
+const exceededReasonFmt = "container quota exceeded: usage %d, limit %d"
+
+// exceededError returns the access denied status describing the breached
+// hard container quota.
+func exceededError(usage, limit uint64) error {
+	var errAccessDenied apistatus.ObjectAccessDenied
+	errAccessDenied.WriteReason(fmt.Sprintf(exceededReasonFmt, usage, limit))
+
+	return errAccessDenied
+}
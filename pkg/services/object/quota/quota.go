@@ -0,0 +1,81 @@
+package quota
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+)
+
+const (
+	// AttributeSoft is a container attribute which sets the soft quota of the
+	// container in bytes. Exceeding the soft quota is reported, but new objects
+	// are still accepted.
+	AttributeSoft = "__NEOFS__QUOTA_SOFT"
+
+	// AttributeHard is a container attribute which sets the hard quota of the
+	// container in bytes. New objects are rejected once the hard quota is
+	// exceeded.
+	AttributeHard = "__NEOFS__QUOTA_HARD"
+)
+
+// Limits describes the soft and the hard container quotas in bytes.
+// Zero value means no limit.
+type Limits struct {
+	Soft uint64
+
+	Hard uint64
+}
+
+// IsZero checks whether there are no limits set.
+func (l Limits) IsZero() bool {
+	return l.Soft == 0 && l.Hard == 0
+}
+
+// Merge returns the strictest of the l and x limits. The limits which are
+// not set are taken from the other argument.
+func (l Limits) Merge(x Limits) Limits {
+	return Limits{
+		Soft: minLimit(l.Soft, x.Soft),
+		Hard: minLimit(l.Hard, x.Hard),
+	}
+}
+
+func minLimit(a, b uint64) uint64 {
+	if a == 0 || b != 0 && b < a {
+		return b
+	}
+
+	return a
+}
+
+// LimitsFromContainer reads the container quotas from the AttributeSoft and
+// AttributeHard container attributes. Returns zero Limits if the attributes
+// are missing.
+func LimitsFromContainer(cnr container.Container) (Limits, error) {
+	soft, err := parseLimit(cnr, AttributeSoft)
+	if err != nil {
+		return Limits{}, err
+	}
+
+	hard, err := parseLimit(cnr, AttributeHard)
+	if err != nil {
+		return Limits{}, err
+	}
+
+	return Limits{Soft: soft, Hard: hard}, nil
+}
+
+func parseLimit(cnr container.Container, key string) (uint64, error) {
+	val := cnr.Attribute(key)
+	if val == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseUint(val, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s container attribute: %w", key, err)
+	}
+
+	return v, nil
+}
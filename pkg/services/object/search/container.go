@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"go.uber.org/zap"
 )
 
@@ -99,17 +100,26 @@ func (exec *execCtx) processCurrentEpoch() bool {
 					return
 				}
 
-				ids, err := c.searchObjects(exec, info)
+				// identifiers are written as they are received,
+				// so the results of the fast nodes are not delayed
+				// by the slow ones
+				err = c.searchObjects(exec, info, idListWriterFunc(func(ids []oid.ID) error {
+					mtx.Lock()
+					defer mtx.Unlock()
+
+					exec.writeIDList(ids)
+					if exec.status != statusOK {
+						return exec.err
+					}
+
+					return nil
+				}))
 				if err != nil {
 					exec.log.Debug("remote operation failed",
 						zap.String("error", err.Error()))
 
 					return
 				}
-
-				mtx.Lock()
-				exec.writeIDList(ids)
-				mtx.Unlock()
 			}(i)
 		}
 
@@ -19,8 +19,25 @@ type Prm struct {
 	filters object.SearchFilters
 
 	forwarder RequestForwarder
+
+	cursor *oid.ID
+
+	limit uint32
 }
 
+// X-Headers of the paged Search requests. The headers are passed
+// to the container nodes along with the request, so each node
+// returns the page of its own objects.
+const (
+	// XHeaderCursor is a key of the X-Header carrying the encoded
+	// identifier of the last object of the previous page.
+	XHeaderCursor = "__NEOFS__SEARCH_CURSOR"
+
+	// XHeaderLimit is a key of the X-Header carrying the decimal
+	// maximum number of objects to return from each node.
+	XHeaderLimit = "__NEOFS__SEARCH_LIMIT"
+)
+
 // IDListWriter is an interface of target component
 // to write list of object identifiers.
 type IDListWriter interface {
@@ -28,8 +45,9 @@ type IDListWriter interface {
 }
 
 // RequestForwarder is a callback for forwarding of the
-// original Search requests.
-type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient) ([]oid.ID, error)
+// original Search requests. Received object identifiers
+// are passed to the writer as they arrive.
+type RequestForwarder func(coreclient.NodeInfo, coreclient.MultiAddressClient, IDListWriter) error
 
 // SetCommonParameters sets common parameters of the operation.
 func (p *Prm) SetCommonParameters(common *util.CommonPrm) {
@@ -56,3 +74,14 @@ func (p *Prm) WithContainerID(id cid.ID) {
 func (p *Prm) WithSearchFilters(fs object.SearchFilters) {
 	p.filters = fs
 }
+
+// SetCursor sets identifier of the object to continue the search after.
+func (p *Prm) SetCursor(id *oid.ID) {
+	p.cursor = id
+}
+
+// SetLimit sets maximum number of the objects to select on each node.
+// Zero means no limit.
+func (p *Prm) SetLimit(l uint32) {
+	p.limit = l
+}
@@ -3,11 +3,16 @@ package searchsvc
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 // Search serves a request to select the objects.
-func (s *Service) Search(ctx context.Context, prm Prm) error {
+func (s *Service) Search(ctx context.Context, prm Prm) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "searchsvc.Search", attribute.Stringer("container", prm.cnr))
+	defer func() { tracing.Finish(span, err) }()
+
 	exec := &execCtx{
 		svc: s,
 		ctx: ctx,
@@ -20,7 +25,9 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.execute()
 
-	return exec.statusError.err
+	err = exec.statusError.err
+
+	return
 }
 
 func (exec *execCtx) execute() {
@@ -111,13 +111,17 @@ func (s *testStorage) search(exec *execCtx) ([]oid.ID, error) {
 	return v.ids, v.err
 }
 
-func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo) ([]oid.ID, error) {
+func (c *testStorage) searchObjects(exec *execCtx, _ clientcore.NodeInfo, w IDListWriter) error {
 	v, ok := c.items[exec.containerID().EncodeToString()]
 	if !ok {
-		return nil, nil
+		return nil
 	}
 
-	return v.ids, v.err
+	if v.err != nil {
+		return v.err
+	}
+
+	return w.WriteIDs(v.ids)
 }
 
 func (c *testStorage) addResult(addr cid.ID, ids []oid.ID, err error) {
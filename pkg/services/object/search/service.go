@@ -22,9 +22,10 @@ type Service struct {
 type Option func(*cfg)
 
 type searchClient interface {
-	// searchObjects searches objects on the specified node.
+	// searchObjects searches objects on the specified node and
+	// writes them to IDListWriter as they are received.
 	// MUST NOT modify execCtx as it can be accessed concurrently.
-	searchObjects(*execCtx, client.NodeInfo) ([]oid.ID, error)
+	searchObjects(*execCtx, client.NodeInfo, IDListWriter) error
 }
 
 type ClientConstructor interface {
@@ -1,6 +1,7 @@
 package searchsvc
 
 import (
+	"encoding/hex"
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -9,8 +10,10 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type uniqueIDWriter struct {
@@ -77,9 +80,13 @@ func (c *clientConstructorWrapper) get(info client.NodeInfo) (searchClient, erro
 	}, nil
 }
 
-func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oid.ID, error) {
+func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo, w IDListWriter) (err error) {
+	ctx, span := tracing.StartSpan(exec.context(), "searchsvc.remote",
+		attribute.String("node_key", hex.EncodeToString(info.PublicKey())))
+	defer func() { tracing.Finish(span, err) }()
+
 	if exec.prm.forwarder != nil {
-		return exec.prm.forwarder(info, c.client)
+		return exec.prm.forwarder(info, c.client, w)
 	}
 
 	var sessionInfo *util.SessionInfo
@@ -93,12 +100,12 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 
 	key, err := exec.svc.keyStore.GetKey(sessionInfo)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var prm internalclient.SearchObjectsPrm
 
-	prm.SetContext(exec.context())
+	prm.SetContext(ctx)
 	prm.SetClient(c.client)
 	prm.SetPrivateKey(key)
 	prm.SetSessionToken(exec.prm.common.SessionToken())
@@ -108,19 +115,20 @@ func (c *clientWrapper) searchObjects(exec *execCtx, info client.NodeInfo) ([]oi
 	prm.SetNetmapEpoch(exec.curProcEpoch)
 	prm.SetContainerID(exec.containerID())
 	prm.SetFilters(exec.searchFilters())
+	prm.SetIDHandler(w.WriteIDs)
 
-	res, err := internalclient.SearchObjects(prm)
-	if err != nil {
-		return nil, err
-	}
+	_, err = internalclient.SearchObjects(prm)
 
-	return res.IDList(), nil
+	return err
 }
 
 func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	var selectPrm engine.SelectPrm
 	selectPrm.WithFilters(exec.searchFilters())
 	selectPrm.WithContainerID(exec.containerID())
+	selectPrm.WithContext(exec.context())
+	selectPrm.WithCursor(exec.prm.cursor)
+	selectPrm.WithLimit(exec.prm.limit)
 
 	r, err := e.storage.Select(selectPrm)
 	if err != nil {
@@ -130,6 +138,13 @@ func (e *storageEngineWrapper) search(exec *execCtx) ([]oid.ID, error) {
 	return idsFromAddresses(r.AddressList()), nil
 }
 
+// idListWriterFunc is a function adapter of IDListWriter.
+type idListWriterFunc func([]oid.ID) error
+
+func (f idListWriterFunc) WriteIDs(ids []oid.ID) error {
+	return f(ids)
+}
+
 func idsFromAddresses(addrs []oid.Address) []oid.ID {
 	ids := make([]oid.ID, len(addrs))
 
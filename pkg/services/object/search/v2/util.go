@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
 	"sync"
 
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
@@ -12,13 +13,14 @@ import (
 	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-api-go/v2/signature"
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
-	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
@@ -59,7 +61,7 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 			return nil, err
 		}
 
-		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte) ([]oid.ID, error) {
+		p.SetRequestForwarder(groupAddressRequestForwarder(func(addr network.Address, c client.MultiAddressClient, pubkey []byte, w searchsvc.IDListWriter) error {
 			var err error
 
 			// once compose and resign forwarding request
@@ -76,24 +78,21 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 			})
 
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			var searchStream *rpc.SearchResponseReader
 			err = c.RawForAddress(addr, func(cli *rpcclient.Client) error {
-				searchStream, err = rpc.SearchObjects(cli, req, rpcclient.WithContext(stream.Context()))
+				searchStream, err = rpc.SearchObjects(cli, req, rpcclient.WithContext(tracing.InjectOutgoingContext(stream.Context())))
 				return err
 			})
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			// code below is copy-pasted from c.SearchObjects implementation,
 			// perhaps it is worth highlighting the utility function in neofs-api-go
-			var (
-				searchResult []oid.ID
-				resp         = new(objectV2.SearchResponse)
-			)
+			resp := new(objectV2.SearchResponse)
 
 			for {
 				// receive message from server stream
@@ -103,47 +102,81 @@ func (s *Service) toPrm(req *objectV2.SearchRequest, stream objectSvc.SearchStre
 						break
 					}
 
-					return nil, fmt.Errorf("reading the response failed: %w", err)
+					return fmt.Errorf("reading the response failed: %w", err)
 				}
 
 				// verify response key
 				if err = internal.VerifyResponseKeyV2(pubkey, resp); err != nil {
-					return nil, err
+					return err
 				}
 
 				// verify response structure
 				if err := signature.VerifyServiceMessage(resp); err != nil {
-					return nil, fmt.Errorf("could not verify %T: %w", resp, err)
+					return fmt.Errorf("could not verify %T: %w", resp, err)
 				}
 
 				chunk := resp.GetBody().GetIDList()
-				var id oid.ID
+				ids := make([]oid.ID, len(chunk))
 
 				for i := range chunk {
-					err = id.ReadFromV2(chunk[i])
+					err = ids[i].ReadFromV2(chunk[i])
 					if err != nil {
-						return nil, fmt.Errorf("invalid object ID: %w", err)
+						return fmt.Errorf("invalid object ID: %w", err)
 					}
+				}
 
-					searchResult = append(searchResult, id)
+				// pass the chunk on without waiting for the whole response
+				if err = w.WriteIDs(ids); err != nil {
+					return err
 				}
 			}
 
-			return searchResult, nil
+			return nil
 		}))
 	}
 
+	err = readPagingParameters(p, commonPrm.XHeaders())
+	if err != nil {
+		return nil, err
+	}
+
 	p.WithContainerID(id)
-	p.WithSearchFilters(object.NewSearchFiltersFromV2(body.GetFilters()))
+	p.WithSearchFilters(objectcore.SearchFiltersFromV2(body.GetFilters()))
 
 	return p, nil
 }
 
-func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte) ([]oid.ID, error)) searchsvc.RequestForwarder {
-	return func(info client.NodeInfo, c client.MultiAddressClient) ([]oid.ID, error) {
+// readPagingParameters sets cursor and limit of the Search
+// from the X-Headers of the request.
+func readPagingParameters(p *searchsvc.Prm, xhdrs []string) error {
+	for i := 0; i+1 < len(xhdrs); i += 2 {
+		switch xhdrs[i] {
+		case searchsvc.XHeaderCursor:
+			var id oid.ID
+
+			err := id.DecodeString(xhdrs[i+1])
+			if err != nil {
+				return fmt.Errorf("invalid search cursor: %w", err)
+			}
+
+			p.SetCursor(&id)
+		case searchsvc.XHeaderLimit:
+			l, err := strconv.ParseUint(xhdrs[i+1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid search limit: %w", err)
+			}
+
+			p.SetLimit(uint32(l))
+		}
+	}
+
+	return nil
+}
+
+func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressClient, []byte, searchsvc.IDListWriter) error) searchsvc.RequestForwarder {
+	return func(info client.NodeInfo, c client.MultiAddressClient, w searchsvc.IDListWriter) error {
 		var (
 			firstErr error
-			res      []oid.ID
 
 			key = info.PublicKey()
 		)
@@ -161,11 +194,11 @@ func groupAddressRequestForwarder(f func(network.Address, client.MultiAddressCli
 				// would be nice to log otherwise
 			}()
 
-			res, err = f(addr, c, key)
+			err = f(addr, c, key, w)
 
 			return
 		})
 
-		return res, firstErr
+		return firstErr
 	}
 }
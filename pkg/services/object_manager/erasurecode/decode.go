@@ -0,0 +1,187 @@
+package erasurecode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/klauspost/reedsolomon"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// PartInfo returns the ID of the original object and the index of the part
+// if the object is an erasure-coded part.
+func PartInfo(obj *objectSDK.Object) (oid.ID, int, bool) {
+	var (
+		par            oid.ID
+		idx            = -1
+		parSet, idxSet bool
+	)
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			parSet = par.DecodeString(a.Value()) == nil
+		case AttributeIndex:
+			v, err := strconv.Atoi(a.Value())
+			idx, idxSet = v, err == nil && v >= 0
+		}
+	}
+
+	return par, idx, parSet && idxSet
+}
+
+// ManifestRule returns the rule of the erasure-coded object if the object is
+// its manifest. Only the header of the manifest is required.
+func ManifestRule(obj *objectSDK.Object) (Rule, bool) {
+	var (
+		r              Rule
+		parSet, ruleOK bool
+	)
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeManifest:
+			parSet = new(oid.ID).DecodeString(a.Value()) == nil
+		case AttributeRule:
+			ruleOK = r.DecodeString(a.Value()) == nil
+		}
+	}
+
+	return r, parSet && ruleOK
+}
+
+// ManifestFilters returns the search filters to select the manifest of the
+// erasure-coded object with the given ID.
+func ManifestFilters(id oid.ID) objectSDK.SearchFilters {
+	var fs objectSDK.SearchFilters
+	fs.AddFilter(AttributeManifest, id.EncodeToString(), objectSDK.MatchStringEqual)
+
+	return fs
+}
+
+// ParseManifest returns the header of the original object and the headers
+// of all its parts stored in the manifest payload. The part headers are
+// sorted by the part index.
+func ParseManifest(manifest *objectSDK.Object) (*objectSDK.Object, []*objectSDK.Object, error) {
+	r, ok := ManifestRule(manifest)
+	if !ok {
+		return nil, nil, errors.New("object is not an erasure coding manifest")
+	}
+
+	var (
+		hdrs []*objectSDK.Object
+		rd   = bytes.NewReader(manifest.Payload())
+	)
+
+	for rd.Len() > 0 {
+		ln, err := binary.ReadUvarint(rd)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read header length: %w", err)
+		}
+
+		if ln > uint64(rd.Len()) {
+			return nil, nil, errors.New("header length overflows the manifest payload")
+		}
+
+		data := make([]byte, ln)
+		_, _ = rd.Read(data)
+
+		hdr := objectSDK.New()
+		if err := hdr.Unmarshal(data); err != nil {
+			return nil, nil, fmt.Errorf("could not unmarshal header #%d: %w", len(hdrs), err)
+		}
+
+		hdrs = append(hdrs, hdr)
+	}
+
+	if len(hdrs) != r.Total()+1 {
+		return nil, nil, fmt.Errorf("number of part headers %d differs from rule %s", len(hdrs)-1, r)
+	}
+
+	par := hdrs[0]
+	parID, _ := par.ID()
+
+	for _, a := range manifest.Attributes() {
+		if a.Key() == AttributeManifest && a.Value() != parID.EncodeToString() {
+			return nil, nil, errors.New("original object header does not match the manifest")
+		}
+	}
+
+	return par, hdrs[1:], nil
+}
+
+// Decode restores the payload of the original object of the given size.
+//
+// Parts must be sorted by the part index with nil values in place of the
+// missing ones. At least Rule.DataParts parts are required.
+func Decode(r Rule, parts []*objectSDK.Object, size uint64) ([]byte, error) {
+	enc, shards, err := shardsFromParts(r, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enc.ReconstructData(shards); err != nil {
+		return nil, fmt.Errorf("could not reconstruct payload: %w", err)
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, size))
+
+	if err := enc.Join(buf, shards, int(size)); err != nil {
+		return nil, fmt.Errorf("could not join payload: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Restore restores the part with the given index from the other parts and the
+// part headers stored in the manifest (see ParseManifest).
+//
+// Parts must be sorted by the part index with nil values in place of the
+// missing ones. At least Rule.DataParts parts are required.
+func Restore(r Rule, hdrs []*objectSDK.Object, parts []*objectSDK.Object, idx int) (*objectSDK.Object, error) {
+	if len(hdrs) != r.Total() || idx < 0 || idx >= len(hdrs) {
+		return nil, fmt.Errorf("part #%d is out of the rule %s", idx, r)
+	}
+
+	enc, shards, err := shardsFromParts(r, parts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enc.Reconstruct(shards); err != nil {
+		return nil, fmt.Errorf("could not reconstruct parts: %w", err)
+	}
+
+	res := objectSDK.NewFromV2(hdrs[idx].ToV2())
+	res.SetPayload(shards[idx])
+
+	if err := objectSDK.CheckVerificationFields(res); err != nil {
+		return nil, fmt.Errorf("restored part #%d is invalid: %w", idx, err)
+	}
+
+	return res, nil
+}
+
+func shardsFromParts(r Rule, parts []*objectSDK.Object) (reedsolomon.Encoder, [][]byte, error) {
+	if len(parts) != r.Total() {
+		return nil, nil, fmt.Errorf("number of parts %d differs from rule %s", len(parts), r)
+	}
+
+	enc, err := reedsolomon.New(r.DataParts, r.ParityParts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create decoder: %w", err)
+	}
+
+	shards := make([][]byte, len(parts))
+	for i := range parts {
+		if parts[i] != nil {
+			shards[i] = parts[i].Payload()
+		}
+	}
+
+	return enc, shards, nil
+}
@@ -0,0 +1,179 @@
+package erasurecode
+
+import (
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/klauspost/reedsolomon"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+const (
+	// AttributeParent is an attribute of the part object which holds
+	// the ID of the original object.
+	AttributeParent = "__NEOFS__EC_PARENT"
+
+	// AttributeIndex is an attribute of the part object which holds
+	// the index of the part.
+	AttributeIndex = "__NEOFS__EC_INDEX"
+
+	// AttributeManifest is an attribute of the manifest object which holds
+	// the ID of the original object.
+	AttributeManifest = "__NEOFS__EC_MANIFEST"
+
+	// AttributeRule is an attribute of the manifest object which holds
+	// the rule the original object has been encoded with.
+	AttributeRule = "__NEOFS__EC_RULE"
+)
+
+// Encode splits the payload of the prepared object into the erasure-coded
+// parts and forms the manifest object.
+//
+// Each part is a standalone regular object which references the original one
+// via AttributeParent and AttributeIndex attributes. The manifest is a regular
+// object too, it references the original one via AttributeManifest attribute
+// and has AttributeRule attribute. Its payload contains the header of the
+// original object followed by the headers of all parts, so the original object
+// is described without the payload and any missing part can be restored
+// byte-to-byte, see ParseManifest.
+//
+// All formed objects are signed with the provided key.
+func Encode(r Rule, obj *objectSDK.Object, key ecdsa.PrivateKey) ([]*objectSDK.Object, *objectSDK.Object, error) {
+	if err := r.verify(); err != nil {
+		return nil, nil, err
+	}
+
+	parID, ok := obj.ID()
+	if !ok {
+		return nil, nil, errors.New("missing object ID")
+	}
+
+	payload := obj.Payload()
+	if len(payload) == 0 {
+		return nil, nil, errors.New("empty payload")
+	}
+
+	enc, err := reedsolomon.New(r.DataParts, r.ParityParts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create encoder: %w", err)
+	}
+
+	// Split uses the spare capacity of the passed slice
+	data := make([]byte, len(payload))
+	copy(data, payload)
+
+	shards, err := enc.Split(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not split payload: %w", err)
+	}
+
+	if err := enc.Encode(shards); err != nil {
+		return nil, nil, fmt.Errorf("could not encode payload: %w", err)
+	}
+
+	_, homomorphic := obj.PayloadHomomorphicHash()
+
+	parHdr, err := obj.CutPayload().Marshal()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not marshal object header: %w", err)
+	}
+
+	var (
+		parts  = make([]*objectSDK.Object, len(shards))
+		lenBuf = make([]byte, binary.MaxVarintLen64)
+		hdrs   = appendHeader(nil, lenBuf, parHdr)
+	)
+
+	for i := range shards {
+		part := deriveObject(obj)
+		part.SetAttributes(append(part.Attributes(),
+			newAttribute(AttributeParent, parID.EncodeToString()),
+			newAttribute(AttributeIndex, strconv.Itoa(i)),
+		)...)
+		setPayload(part, shards[i], homomorphic)
+
+		if err := objectSDK.SetIDWithSignature(key, part); err != nil {
+			return nil, nil, fmt.Errorf("could not finalize part #%d: %w", i, err)
+		}
+
+		hdr, err := part.CutPayload().Marshal()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not marshal part #%d header: %w", i, err)
+		}
+
+		hdrs = appendHeader(hdrs, lenBuf, hdr)
+		parts[i] = part
+	}
+
+	manifest := deriveObject(obj)
+	manifest.SetAttributes(append(manifest.Attributes(),
+		newAttribute(AttributeManifest, parID.EncodeToString()),
+		newAttribute(AttributeRule, r.String()),
+	)...)
+	setPayload(manifest, hdrs, homomorphic)
+
+	if err := objectSDK.SetIDWithSignature(key, manifest); err != nil {
+		return nil, nil, fmt.Errorf("could not finalize manifest: %w", err)
+	}
+
+	return parts, manifest, nil
+}
+
+// deriveObject returns new regular object with the same container, owner,
+// version, creation epoch, session token and expiration as the original one.
+func deriveObject(obj *objectSDK.Object) *objectSDK.Object {
+	res := objectSDK.New()
+	res.SetType(objectSDK.TypeRegular)
+	res.SetVersion(obj.Version())
+	res.SetOwnerID(obj.OwnerID())
+	res.SetCreationEpoch(obj.CreationEpoch())
+	res.SetSessionToken(obj.SessionToken())
+
+	if cnr, ok := obj.ContainerID(); ok {
+		res.SetContainerID(cnr)
+	}
+
+	// parts must not outlive the original object
+	for _, a := range obj.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			res.SetAttributes(a)
+			break
+		}
+	}
+
+	return res
+}
+
+// appendHeader appends the length-prefixed header to the manifest payload.
+func appendHeader(dst, lenBuf, hdr []byte) []byte {
+	dst = append(dst, lenBuf[:binary.PutUvarint(lenBuf, uint64(len(hdr)))]...)
+	return append(dst, hdr...)
+}
+
+func newAttribute(key, val string) objectSDK.Attribute {
+	var a objectSDK.Attribute
+	a.SetKey(key)
+	a.SetValue(val)
+
+	return a
+}
+
+func setPayload(obj *objectSDK.Object, payload []byte, homomorphic bool) {
+	var cs checksum.Checksum
+
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	checksum.Calculate(&cs, checksum.SHA256, payload)
+	obj.SetPayloadChecksum(cs)
+
+	if homomorphic {
+		checksum.Calculate(&cs, checksum.TZ, payload)
+		obj.SetPayloadHomomorphicHash(cs)
+	}
+}
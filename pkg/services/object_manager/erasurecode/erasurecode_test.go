@@ -0,0 +1,186 @@
+package erasurecode
+
+import (
+	"crypto/rand"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	usertest "github.com/nspcc-dev/neofs-sdk-go/user/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRule_DecodeString(t *testing.T) {
+	var r Rule
+
+	require.NoError(t, r.DecodeString("3+2"))
+	require.Equal(t, Rule{DataParts: 3, ParityParts: 2}, r)
+	require.Equal(t, "3+2", r.String())
+	require.Equal(t, 5, r.Total())
+
+	for _, s := range []string{"", "3", "3+", "+2", "a+2", "3+b", "0+2", "3+0", "3+2+1", "200+100"} {
+		require.Error(t, r.DecodeString(s), s)
+	}
+}
+
+func TestRuleFromContainer(t *testing.T) {
+	var cnr container.Container
+
+	r, err := RuleFromContainer(cnr)
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	cnr.SetAttribute(ContainerAttribute, "4+2")
+
+	r, err = RuleFromContainer(cnr)
+	require.NoError(t, err)
+	require.Equal(t, &Rule{DataParts: 4, ParityParts: 2}, r)
+
+	cnr.SetAttribute(ContainerAttribute, "4")
+
+	_, err = RuleFromContainer(cnr)
+	require.Error(t, err)
+}
+
+func TestNodes(t *testing.T) {
+	nodes := make([]netmap.NodeInfo, 4)
+	for i := range nodes {
+		nodes[i].SetPublicKey([]byte{byte(i)})
+	}
+
+	res := Nodes([][]netmap.NodeInfo{
+		{nodes[2], nodes[0]},
+		{nodes[0], nodes[3]},
+		{nodes[1], nodes[2]},
+	})
+	require.Equal(t, []netmap.NodeInfo{nodes[2], nodes[0], nodes[3], nodes[1]}, res)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	r := Rule{DataParts: 3, ParityParts: 2}
+	obj := generateObject(t, key, 1000)
+
+	parts, manifest, err := Encode(r, obj, key.PrivateKey)
+	require.NoError(t, err)
+	require.Len(t, parts, r.Total())
+
+	parID, _ := obj.ID()
+
+	for i := range parts {
+		require.NoError(t, objectSDK.CheckVerificationFields(parts[i]))
+
+		id, idx, ok := PartInfo(parts[i])
+		require.True(t, ok)
+		require.Equal(t, parID, id)
+		require.Equal(t, i, idx)
+		require.Equal(t, expirationOf(obj), expirationOf(parts[i]))
+
+		_, ok = ManifestRule(parts[i])
+		require.False(t, ok)
+	}
+
+	require.NoError(t, objectSDK.CheckVerificationFields(manifest))
+
+	mr, ok := ManifestRule(manifest)
+	require.True(t, ok)
+	require.Equal(t, r, mr)
+
+	require.Nil(t, manifest.Parent())
+	require.Empty(t, manifest.Children())
+	require.Nil(t, manifest.SplitID())
+
+	_, ok = ManifestRule(manifest.CutPayload())
+	require.True(t, ok)
+
+	par, hdrs, err := ParseManifest(manifest)
+	require.NoError(t, err)
+	require.Equal(t, obj.CutPayload(), par)
+	require.Len(t, hdrs, r.Total())
+
+	for i := range hdrs {
+		require.Equal(t, parts[i].CutPayload(), hdrs[i])
+	}
+
+	_, _, err = ParseManifest(parts[0])
+	require.Error(t, err)
+
+	fs := ManifestFilters(parID)
+	require.Len(t, fs, 1)
+	require.Equal(t, AttributeManifest, fs[0].Header())
+	require.Equal(t, parID.EncodeToString(), fs[0].Value())
+
+	t.Run("decode", func(t *testing.T) {
+		available := make([]*objectSDK.Object, len(parts))
+		copy(available, parts)
+
+		// lose as many parts as there are parity ones
+		available[0] = nil
+		available[3] = nil
+
+		payload, err := Decode(r, available, obj.PayloadSize())
+		require.NoError(t, err)
+		require.Equal(t, obj.Payload(), payload)
+
+		available[4] = nil
+
+		_, err = Decode(r, available, obj.PayloadSize())
+		require.Error(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		for i := range parts {
+			available := make([]*objectSDK.Object, len(parts))
+			copy(available, parts)
+
+			available[i] = nil
+			available[(i+1)%len(parts)] = nil
+
+			restored, err := Restore(r, hdrs, available, i)
+			require.NoError(t, err)
+			require.Equal(t, parts[i], restored)
+		}
+	})
+
+	t.Run("empty payload", func(t *testing.T) {
+		_, _, err := Encode(r, generateObject(t, key, 0), key.PrivateKey)
+		require.Error(t, err)
+	})
+}
+
+func generateObject(t *testing.T, key *keys.PrivateKey, size int) *objectSDK.Object {
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	var exp objectSDK.Attribute
+	exp.SetKey(objectV2.SysAttributeExpEpoch)
+	exp.SetValue("100")
+
+	obj := objectSDK.New()
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(usertest.ID())
+	obj.SetAttributes(exp)
+	setPayload(obj, payload, true)
+
+	require.NoError(t, objectSDK.SetIDWithSignature(key.PrivateKey, obj))
+
+	return obj
+}
+
+func expirationOf(obj *objectSDK.Object) uint64 {
+	for _, a := range obj.Attributes() {
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			v, _ := strconv.ParseUint(a.Value(), 10, 64)
+			return v
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,117 @@
+package erasurecode
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// ContainerAttribute is a container attribute which enables erasure coding
+// of the container objects. The value has "<data parts>+<parity parts>"
+// format, e.g. "3+2".
+const ContainerAttribute = "__NEOFS__ERASURE_CODE"
+
+// maxParts is the maximum total number of parts supported by the
+// Reed-Solomon encoder.
+const maxParts = 256
+
+// Rule describes erasure coding scheme: the object payload is split into
+// DataParts parts which are complemented by ParityParts parity parts. Any
+// DataParts of all parts are enough to restore the payload.
+type Rule struct {
+	DataParts int
+
+	ParityParts int
+}
+
+var errInvalidRule = errors.New("invalid erasure coding rule")
+
+// Total returns the total number of parts of the single object.
+func (r Rule) Total() int {
+	return r.DataParts + r.ParityParts
+}
+
+// String returns the rule in "<data parts>+<parity parts>" format.
+func (r Rule) String() string {
+	return strconv.Itoa(r.DataParts) + "+" + strconv.Itoa(r.ParityParts)
+}
+
+// DecodeString parses the rule from "<data parts>+<parity parts>" format.
+func (r *Rule) DecodeString(s string) error {
+	ss := strings.Split(s, "+")
+	if len(ss) != 2 {
+		return fmt.Errorf("%w: %q", errInvalidRule, s)
+	}
+
+	data, err := strconv.Atoi(ss[0])
+	if err != nil {
+		return fmt.Errorf("%w: data parts: %v", errInvalidRule, err)
+	}
+
+	parity, err := strconv.Atoi(ss[1])
+	if err != nil {
+		return fmt.Errorf("%w: parity parts: %v", errInvalidRule, err)
+	}
+
+	res := Rule{DataParts: data, ParityParts: parity}
+	if err := res.verify(); err != nil {
+		return err
+	}
+
+	*r = res
+
+	return nil
+}
+
+func (r Rule) verify() error {
+	if r.DataParts <= 0 || r.ParityParts <= 0 {
+		return fmt.Errorf("%w: non-positive number of parts in %s", errInvalidRule, r)
+	}
+
+	if r.Total() > maxParts {
+		return fmt.Errorf("%w: more than %d parts in %s", errInvalidRule, maxParts, r)
+	}
+
+	return nil
+}
+
+// RuleFromContainer returns the erasure coding rule of the container.
+// Returns nil if the container objects are replicated fully.
+func RuleFromContainer(cnr container.Container) (*Rule, error) {
+	val := cnr.Attribute(ContainerAttribute)
+	if val == "" {
+		return nil, nil
+	}
+
+	var r Rule
+	if err := r.DecodeString(val); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// Nodes returns the distinct nodes of the object placement vectors in the
+// order the parts are placed in: the i-th part is stored on the i-th node.
+func Nodes(vectors [][]netmap.NodeInfo) []netmap.NodeInfo {
+	var (
+		res  []netmap.NodeInfo
+		seen = make(map[string]struct{})
+	)
+
+	for i := range vectors {
+		for j := range vectors[i] {
+			key := string(vectors[i][j].PublicKey())
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				res = append(res, vectors[i][j])
+			}
+		}
+	}
+
+	return res
+}
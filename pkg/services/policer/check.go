@@ -8,6 +8,7 @@ import (
 	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
@@ -105,6 +106,26 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 		return
 	}
 
+	var manifest bool
+
+	rule, err := erasurecode.RuleFromContainer(cnr.Value)
+	if err != nil {
+		p.log.Error("could not get container erasure coding rule",
+			zap.Stringer("cid", idCnr),
+			zap.String("error", err.Error()),
+		)
+	} else if rule != nil && addrWithType.Type == object.TypeRegular {
+		hdr, err := engine.HeadRaw(p.jobQueue.localStorage, addr, true)
+		if err == nil {
+			if parID, idx, ok := erasurecode.PartInfo(hdr); ok {
+				p.processErasureCodedPart(ctx, *rule, cnr.Value, addr, parID, idx)
+				return
+			}
+
+			_, manifest = erasurecode.ManifestRule(hdr)
+		}
+	}
+
 	policy := cnr.Value.PlacementPolicy()
 
 	nn, err := p.placementBuilder.BuildPlacement(idCnr, &idObj, policy)
@@ -120,6 +141,7 @@ func (p *Policer) processObject(ctx context.Context, addrWithType objectcore.Add
 	c := &processPlacementContext{
 		Context:      ctx,
 		object:       addrWithType,
+		manifest:     manifest,
 		checkedNodes: newNodeCache(),
 	}
 
@@ -197,6 +219,9 @@ type processPlacementContext struct {
 	// descriptor of the object for which the policy is being checked
 	object objectcore.AddressWithType
 
+	// whether the object is the manifest of the erasure-coded object
+	manifest bool
+
 	// caches nodes which has been already processed in previous iterations
 	checkedNodes *nodeCache
 }
@@ -228,6 +253,11 @@ func (p *Policer) processNodes(ctx *processPlacementContext, nodes []netmap.Node
 		//   - `LOCK` objects are broadcast on their PUT requests;
 		//   - `LOCK` object removal is a prohibited action in the GC.
 		shortage = uint32(len(nodes))
+	} else if ctx.manifest {
+		// erasure coding manifests are broadcast on their PUT requests
+		// since any container node may need them to assemble the object
+		// or to restore the missing parts
+		shortage = uint32(len(nodes))
 	}
 
 	for i := 0; (!ctx.localNodeInContainer || shortage > 0) && i < len(nodes); i++ {
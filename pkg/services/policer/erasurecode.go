@@ -0,0 +1,247 @@
+package policer
+
+import (
+	"context"
+	"errors"
+
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/erasurecode"
+	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oid "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.uber.org/zap"
+)
+
+// ObjectSource provides objects stored in the container.
+type ObjectSource interface {
+	// Get returns the object looking for it on the container nodes.
+	Get(ctx context.Context, addr oid.Address) (*objectSDK.Object, error)
+}
+
+// processErasureCodedPart checks the placement of the erasure-coded part
+// stored locally.
+//
+// The i-th part must be stored on the i-th node of the original object
+// placement. Misplaced parts are moved to their nodes. Each part holder
+// checks the next part in the ring and restores it from the other parts
+// if it is missing, so the lost parts are restored one by one.
+func (p *Policer) processErasureCodedPart(ctx context.Context, rule erasurecode.Rule, cnr containerSDK.Container,
+	addr oid.Address, parID oid.ID, idx int) {
+	log := p.log.With(
+		zap.Stringer("object", addr),
+		zap.Stringer("parent", parID),
+		zap.Int("index", idx),
+	)
+
+	idCnr := addr.Container()
+
+	vectors, err := p.placementBuilder.BuildPlacement(idCnr, &parID, cnr.PlacementPolicy())
+	if err != nil {
+		log.Error("could not build placement vector for erasure-coded object",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	nodes := erasurecode.Nodes(vectors)
+	if len(nodes) < rule.Total() || idx >= rule.Total() {
+		log.Warn("erasure-coded part does not match the container nodes, holding the part...",
+			zap.Stringer("rule", rule),
+			zap.Int("nodes", len(nodes)),
+		)
+
+		return
+	}
+
+	if !p.netmapKeys.IsLocalKey(nodes[idx].PublicKey()) {
+		p.moveErasureCodedPart(ctx, addr, nodes[idx])
+		return
+	}
+
+	next := (idx + 1) % rule.Total()
+
+	manifest, err := p.erasureCodingManifest(idCnr, parID)
+	if err != nil {
+		// manifest is saved after the parts, it may not be received yet
+		log.Debug("could not get erasure coding manifest",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	_, hdrs, err := erasurecode.ParseManifest(manifest)
+	if err != nil {
+		log.Warn("invalid erasure coding manifest",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	} else if len(hdrs) != rule.Total() {
+		log.Warn("erasure coding manifest does not match the container rule",
+			zap.Stringer("rule", rule),
+			zap.Int("parts", len(hdrs)),
+		)
+
+		return
+	}
+
+	nextAddr := objectcore.AddressOf(hdrs[next])
+
+	callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+
+	_, err = p.remoteHeader.Head(callCtx, new(headsvc.RemoteHeadPrm).
+		WithObjectAddress(nextAddr).
+		WithNodeInfo(nodes[next]))
+
+	cancel()
+
+	if err == nil || !client.IsErrObjectNotFound(err) {
+		if err != nil {
+			log.Debug("could not check erasure-coded part",
+				zap.Stringer("part", nextAddr),
+				zap.String("error", err.Error()),
+			)
+		}
+
+		return
+	}
+
+	log.Info("erasure-coded part is missing, restoring...",
+		zap.Stringer("part", nextAddr),
+	)
+
+	part, err := p.restoreErasureCodedPart(ctx, rule, hdrs, idx, next)
+	if err != nil {
+		log.Error("could not restore erasure-coded part",
+			zap.Stringer("part", nextAddr),
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	var task replicator.Task
+	task.SetObjectAddress(nextAddr)
+	task.SetObject(part)
+	task.SetNodes([]netmap.NodeInfo{nodes[next]})
+	task.SetCopiesNumber(1)
+
+	p.replicator.HandleTask(ctx, task, newNodeCache())
+}
+
+// moveErasureCodedPart replicates the local part to the node it must be
+// stored on and removes the local copy after it is done.
+func (p *Policer) moveErasureCodedPart(ctx context.Context, addr oid.Address, node netmap.NodeInfo) {
+	callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+
+	_, err := p.remoteHeader.Head(callCtx, new(headsvc.RemoteHeadPrm).
+		WithObjectAddress(addr).
+		WithNodeInfo(node))
+
+	cancel()
+
+	switch {
+	case err == nil:
+		p.log.Info("erasure-coded part is stored on its node, removing the local copy...",
+			zap.Stringer("object", addr),
+		)
+
+		p.cbRedundantCopy(addr)
+	case client.IsErrObjectNotFound(err):
+		var task replicator.Task
+		task.SetObjectAddress(addr)
+		task.SetNodes([]netmap.NodeInfo{node})
+		task.SetCopiesNumber(1)
+
+		p.replicator.HandleTask(ctx, task, newNodeCache())
+	default:
+		p.log.Debug("could not check erasure-coded part",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// erasureCodingManifest returns the manifest of the erasure-coded object.
+// Manifests are broadcast to all container nodes, so it is looked for in
+// the local storage only.
+func (p *Policer) erasureCodingManifest(idCnr cid.ID, parID oid.ID) (*objectSDK.Object, error) {
+	res, err := engine.Select(p.jobQueue.localStorage, idCnr, erasurecode.ManifestFilters(parID))
+	if err != nil {
+		return nil, err
+	} else if len(res) == 0 {
+		return nil, errors.New("manifest is not found")
+	}
+
+	manifest, err := engine.Get(p.jobQueue.localStorage, res[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := erasurecode.ManifestRule(manifest); !ok {
+		return nil, errors.New("object is not an erasure coding manifest")
+	}
+
+	return manifest, nil
+}
+
+// restoreErasureCodedPart restores the missing part from the local part
+// and the ones received from the container nodes.
+func (p *Policer) restoreErasureCodedPart(ctx context.Context, rule erasurecode.Rule, hdrs []*objectSDK.Object, local, missing int) (*objectSDK.Object, error) {
+	if p.objSource == nil {
+		return nil, errors.New("object source is not configured")
+	}
+
+	var (
+		parts = make([]*objectSDK.Object, len(hdrs))
+		found int
+		err   error
+	)
+
+	for i := local; found < rule.DataParts && i < local+len(hdrs); i++ {
+		j := i % len(hdrs)
+		if j == missing {
+			continue
+		}
+
+		addr := objectcore.AddressOf(hdrs[j])
+
+		var part *objectSDK.Object
+
+		if j == local {
+			part, err = engine.Get(p.jobQueue.localStorage, addr)
+		} else {
+			part, err = p.objSource.Get(ctx, addr)
+		}
+
+		if err == nil {
+			err = objectSDK.CheckVerificationFields(part)
+		}
+
+		if err != nil {
+			p.log.Debug("could not get erasure-coded part",
+				zap.Stringer("part", addr),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		parts[j] = part
+		found++
+	}
+
+	if found < rule.DataParts {
+		return nil, errors.New("not enough erasure-coded parts")
+	}
+
+	return erasurecode.Restore(rule, hdrs, parts, missing)
+}
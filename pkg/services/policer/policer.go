@@ -103,6 +103,8 @@ type cfg struct {
 	rebalanceFreq, evictDuration time.Duration
 
 	network Network
+
+	objSource ObjectSource
 }
 
 func defaultCfg() *cfg {
@@ -233,3 +235,11 @@ func WithNetwork(n Network) Option {
 		c.network = n
 	}
 }
+
+// WithObjectSource returns option to set the source of the erasure-coded
+// parts needed to restore the missing ones.
+func WithObjectSource(v ObjectSource) Option {
+	return func(c *cfg) {
+		c.objSource = v
+	}
+}
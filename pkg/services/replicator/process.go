@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard/limiter"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"go.uber.org/zap"
@@ -26,8 +27,11 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 	}()
 
 	if task.obj == nil {
-		var err error
-		task.obj, err = engine.Get(p.localStorage, task.addr)
+		var getPrm engine.GetPrm
+		getPrm.WithAddress(task.addr)
+		getPrm.WithIOClass(limiter.Replication)
+
+		getRes, err := p.localStorage.Get(getPrm)
 		if err != nil {
 			p.log.Error("could not get object from local storage",
 				zap.Stringer("object", task.addr),
@@ -35,6 +39,8 @@ func (p *Replicator) HandleTask(ctx context.Context, task Task, res TaskResult)
 
 			return
 		}
+
+		task.obj = getRes.Object()
 	}
 
 	prm := new(putsvc.RemotePutPrm).
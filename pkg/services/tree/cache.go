@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
@@ -84,8 +85,11 @@ func dialTreeService(ctx context.Context, netmapAddr string) (*grpc.ClientConn,
 		return nil, err
 	}
 
-	opts := make([]grpc.DialOption, 1, 2)
-	opts[0] = grpc.WithBlock()
+	opts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithChainUnaryInterceptor(tracing.NewUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.NewStreamClientInterceptor()),
+	}
 
 	// FIXME(@fyrchik): ugly hack #1322
 	if !strings.HasPrefix(netAddr.URIAddr(), "grpcs:") {
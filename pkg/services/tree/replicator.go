@@ -58,25 +58,7 @@ func (s *Service) replicationWorker() {
 		case <-s.closeCh:
 			return
 		case task := <-s.replicationTasks:
-			var lastErr error
-			var lastAddr string
-
-			task.n.IterateNetworkEndpoints(func(addr string) bool {
-				lastAddr = addr
-
-				c, err := s.cache.get(context.Background(), addr)
-				if err != nil {
-					lastErr = fmt.Errorf("can't create client: %w", err)
-					return false
-				}
-
-				ctx, cancel := context.WithTimeout(context.Background(), s.replicatorTimeout)
-				_, lastErr = c.Apply(ctx, task.req)
-				cancel()
-
-				return lastErr == nil
-			})
-
+			lastAddr, lastErr := s.sendApply(context.Background(), task.n, task.req)
 			if lastErr != nil {
 				if errors.Is(lastErr, errRecentlyFailed) {
 					s.log.Debug("do not send update to the node",
@@ -92,6 +74,31 @@ func (s *Service) replicationWorker() {
 	}
 }
 
+// sendApply sends the request to the node trying all its network endpoints
+// until one of them succeeds. Returns the last tried address and the last error.
+func (s *Service) sendApply(ctx context.Context, n netmapSDK.NodeInfo, req *ApplyRequest) (string, error) {
+	var lastErr error
+	var lastAddr string
+
+	n.IterateNetworkEndpoints(func(addr string) bool {
+		lastAddr = addr
+
+		c, err := s.cache.get(ctx, addr)
+		if err != nil {
+			lastErr = fmt.Errorf("can't create client: %w", err)
+			return false
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, s.replicatorTimeout)
+		_, lastErr = c.Apply(ctx, req)
+		cancel()
+
+		return lastErr == nil
+	})
+
+	return lastAddr, lastErr
+}
+
 func (s *Service) replicateLoop(ctx context.Context) {
 	for i := 0; i < s.replicatorWorkerCount; i++ {
 		go s.replicationWorker()
@@ -141,6 +148,40 @@ func (s *Service) replicate(op movePair) error {
 	return nil
 }
 
+// ReplicateTreeOp synchronously sends the operation to all the container
+// nodes except the local one. Returns an error if no node has accepted it.
+func (s *Service) ReplicateTreeOp(ctx context.Context, cid cidSDK.ID, treeID string, op *pilorama.Move) error {
+	req := newApplyRequest(&movePair{cid: cid, treeID: treeID, op: op})
+	err := SignMessage(req, s.key)
+	if err != nil {
+		return fmt.Errorf("can't sign data: %w", err)
+	}
+
+	nodes, localIndex, err := s.getContainerNodes(cid)
+	if err != nil {
+		return fmt.Errorf("can't get container nodes: %w", err)
+	}
+
+	var replicated bool
+	lastErr := errors.New("no remote container nodes")
+	for i := range nodes {
+		if i == localIndex {
+			continue
+		}
+
+		if _, err := s.sendApply(ctx, nodes[i], req); err != nil {
+			lastErr = err
+			continue
+		}
+		replicated = true
+	}
+
+	if !replicated {
+		return fmt.Errorf("operation was not replicated: %w", lastErr)
+	}
+	return nil
+}
+
 func (s *Service) pushToQueue(cid cidSDK.ID, treeID string, op *pilorama.LogMove) {
 	select {
 	case s.replicateCh <- movePair{
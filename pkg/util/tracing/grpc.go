@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// propagator transfers the span context between the nodes in the W3C
+// Trace Context format. It doesn't depend on the global tracer provider,
+// so the nodes with disabled tracing pass the context of the remote
+// spans through.
+var propagator propagation.TraceContext
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	vs := metadata.MD(c).Get(key)
+	if len(vs) == 0 {
+		return ""
+	}
+
+	return vs[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	res := make([]string, 0, len(c))
+	for k := range c {
+		res = append(res, k)
+	}
+
+	return res
+}
+
+// InjectOutgoingContext returns the context with the gRPC metadata carrying
+// the span context from ctx. Returns ctx as is if it carries no span.
+func InjectOutgoingContext(ctx context.Context) context.Context {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = make(metadata.MD)
+	}
+
+	propagator.Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// ExtractIncomingContext returns the context with the remote span context
+// from the incoming gRPC metadata of ctx. Returns ctx as is if the metadata
+// carries no span context.
+func ExtractIncomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return propagator.Extract(ctx, metadataCarrier(md))
+}
+
+// NewUnaryServerInterceptor returns gRPC server interceptor starting the
+// span of each unary call as a child of the remote span from the request
+// metadata.
+func NewUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+
+		Finish(span, err)
+
+		return resp, err
+	}
+}
+
+// NewStreamServerInterceptor returns gRPC server interceptor starting the
+// span of each streaming call as a child of the remote span from the
+// request metadata.
+func NewStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+
+		err := handler(srv, serverStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+
+		Finish(span, err)
+
+		return err
+	}
+}
+
+// NewUnaryClientInterceptor returns gRPC client interceptor passing the
+// span context of the unary call to the server.
+func NewUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(InjectOutgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// NewStreamClientInterceptor returns gRPC client interceptor passing the
+// span context of the streaming call to the server.
+func NewStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(InjectOutgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+func startServerSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return startSpan(ExtractIncomingContext(ctx), method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("rpc.method", method)),
+	)
+}
+
+// serverStream overrides the context of the gRPC server stream.
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,128 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// JSONExporter is a span exporter writing each span as a separate JSON
+// object on a new line. It is intended for debugging and tests.
+type JSONExporter struct {
+	mtx sync.Mutex
+
+	enc *json.Encoder
+
+	// closer is closed on shutdown if set
+	closer io.Closer
+}
+
+// JSONSpan is a JSON representation of the exported span.
+type JSONSpan struct {
+	TraceID       string                 `json:"traceID"`
+	SpanID        string                 `json:"spanID"`
+	ParentSpanID  string                 `json:"parentSpanID,omitempty"`
+	Name          string                 `json:"name"`
+	Kind          string                 `json:"kind"`
+	Start         time.Time              `json:"start"`
+	End           time.Time              `json:"end"`
+	Attributes    map[string]interface{} `json:"attributes,omitempty"`
+	Events        []JSONEvent            `json:"events,omitempty"`
+	Status        string                 `json:"status"`
+	StatusMessage string                 `json:"statusMessage,omitempty"`
+}
+
+// JSONEvent is a JSON representation of the span event.
+type JSONEvent struct {
+	Name       string                 `json:"name"`
+	Time       time.Time              `json:"time"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// NewJSONExporter creates new JSONExporter writing spans to w.
+func NewJSONExporter(w io.Writer) *JSONExporter {
+	return &JSONExporter{
+		enc: json.NewEncoder(w),
+	}
+}
+
+// ExportSpans writes spans to the underlying writer.
+func (e *JSONExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	for i := range spans {
+		if err := e.enc.Encode(newJSONSpan(spans[i])); err != nil {
+			return fmt.Errorf("could not write span: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Shutdown closes the underlying file if the exporter writes to the file.
+func (e *JSONExporter) Shutdown(context.Context) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.closer == nil {
+		return nil
+	}
+
+	err := e.closer.Close()
+	e.closer = nil
+
+	return err
+}
+
+func newJSONSpan(s sdktrace.ReadOnlySpan) JSONSpan {
+	res := JSONSpan{
+		TraceID:       s.SpanContext().TraceID().String(),
+		SpanID:        s.SpanContext().SpanID().String(),
+		Name:          s.Name(),
+		Kind:          s.SpanKind().String(),
+		Start:         s.StartTime(),
+		End:           s.EndTime(),
+		Attributes:    jsonAttributes(s.Attributes()),
+		Status:        s.Status().Code.String(),
+		StatusMessage: s.Status().Description,
+	}
+
+	if p := s.Parent(); p.IsValid() {
+		res.ParentSpanID = p.SpanID().String()
+	}
+
+	events := s.Events()
+	if len(events) != 0 {
+		res.Events = make([]JSONEvent, len(events))
+
+		for i := range events {
+			res.Events[i] = JSONEvent{
+				Name:       events[i].Name,
+				Time:       events[i].Time,
+				Attributes: jsonAttributes(events[i].Attributes),
+			}
+		}
+	}
+
+	return res
+}
+
+func jsonAttributes(attrs []attribute.KeyValue) map[string]interface{} {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	res := make(map[string]interface{}, len(attrs))
+	for i := range attrs {
+		res[string(attrs[i].Key)] = attrs[i].Value.AsInterface()
+	}
+
+	return res
+}
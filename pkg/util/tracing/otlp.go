@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpTracesPath is a default path of the OTLP/HTTP traces receiver.
+const otlpTracesPath = "/v1/traces"
+
+const defaultOTLPTimeout = 10 * time.Second
+
+// NewOTLPExporter creates new OTLP span exporter sending spans to the
+// collector at the endpoint using OTLP over HTTP with protobuf encoding.
+// If the endpoint has no path, the default one is used. Non-positive
+// timeout is replaced with the default one.
+func NewOTLPExporter(endpoint string, timeout time.Duration) (*otlptrace.Exporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid OTLP endpoint scheme %q", u.Scheme)
+	}
+
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpTracesPath
+	}
+
+	if timeout <= 0 {
+		timeout = defaultOTLPTimeout
+	}
+
+	return otlptrace.New(context.Background(), &otlpHTTPClient{
+		url:    u.String(),
+		client: &http.Client{Timeout: timeout},
+	})
+}
+
+// otlpHTTPClient is an otlptrace.Client sending spans over HTTP. Upstream
+// OTLP clients depend on the gRPC gateway, so the transport is implemented
+// here while the spans are converted by the upstream exporter.
+type otlpHTTPClient struct {
+	url string
+
+	client *http.Client
+}
+
+// Start implements otlptrace.Client, there is nothing to start.
+func (c *otlpHTTPClient) Start(context.Context) error {
+	return nil
+}
+
+// Stop implements otlptrace.Client, it closes idle connections to the collector.
+func (c *otlpHTTPClient) Stop(context.Context) error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// UploadTraces implements otlptrace.Client, it sends the spans to the collector.
+func (c *otlpHTTPClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	// ExportTraceServiceRequest has the only field: repeated resource spans
+	var body []byte
+	for i := range protoSpans {
+		rs, err := proto.Marshal(protoSpans[i])
+		if err != nil {
+			return fmt.Errorf("could not encode spans: %w", err)
+		}
+
+		body = protowire.AppendTag(body, 1, protowire.BytesType)
+		body = protowire.AppendBytes(body, rs)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create OTLP request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send spans: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// the body is read to reuse the connection
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with status %d: %s", resp.StatusCode, msg)
+	}
+
+	return nil
+}
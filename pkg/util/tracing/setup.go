@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Supported span exporters.
+const (
+	// ExporterOTLP sends spans to the OpenTelemetry collector
+	// using OTLP over HTTP with protobuf encoding.
+	ExporterOTLP = "otlp"
+
+	// ExporterFile writes spans to the file as JSON lines.
+	ExporterFile = "file"
+
+	// ExporterStdout writes spans to the standard output as JSON lines.
+	ExporterStdout = "stdout"
+)
+
+// Config groups the tracing parameters.
+type Config struct {
+	// Exporter is a kind of the span exporter, see Exporter* constants.
+	Exporter string
+
+	// Endpoint is a URL of the OTLP/HTTP collector, e.g. http://localhost:4318.
+	// Used by ExporterOTLP only.
+	Endpoint string
+
+	// Timeout limits the time of a single OTLP export request.
+	// Used by ExporterOTLP only.
+	Timeout time.Duration
+
+	// Path is a path to the file to write spans to.
+	// Used by ExporterFile only.
+	Path string
+
+	// SamplingRatio is a fraction of the traces started by the node
+	// to be sampled. Traces started by the remote nodes are sampled
+	// according to the decision of the remote side.
+	SamplingRatio float64
+
+	// Service is a name of the service producing the spans.
+	Service string
+
+	// Version is a version of the service producing the spans.
+	Version string
+}
+
+// Setup creates span exporter and registers the global tracer provider
+// according to the configuration.
+//
+// Returned function flushes all pending spans and stops the provider. It
+// must be called on application shutdown.
+func Setup(c Config) (func(context.Context) error, error) {
+	exp, err := newExporter(c)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := []attribute.KeyValue{attribute.String("service.name", c.Service)}
+	if c.Version != "" {
+		attrs = append(attrs, attribute.String("service.version", c.Version))
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SamplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attrs...)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func newExporter(c Config) (sdktrace.SpanExporter, error) {
+	switch c.Exporter {
+	case ExporterOTLP:
+		return NewOTLPExporter(c.Endpoint, c.Timeout)
+	case ExporterFile:
+		f, err := os.OpenFile(c.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+		if err != nil {
+			return nil, fmt.Errorf("could not open trace file: %w", err)
+		}
+
+		exp := NewJSONExporter(f)
+		exp.closer = f
+
+		return exp, nil
+	case ExporterStdout:
+		return NewJSONExporter(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("unknown span exporter %q", c.Exporter)
+	}
+}
@@ -0,0 +1,46 @@
+// Package tracing provides distributed tracing of the storage node operations.
+//
+// Spans are created with the OpenTelemetry API and are exported only if the
+// global tracer provider is configured via Setup. Otherwise, all spans are
+// no-op, so the instrumentation can be left in the hot paths.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is a name of the tracer used by all node components.
+const instrumentationName = "github.com/nspcc-dev/neofs-node"
+
+// StartSpan starts a new span with the given name and attributes. If ctx
+// carries a span, the new span becomes its child. Nil ctx is treated as
+// context.Background.
+//
+// Returned context carries the new span. The span must be ended by the
+// caller, see Finish.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return startSpan(ctx, name, trace.WithAttributes(attrs...))
+}
+
+func startSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// Finish records the operation error in the span, if any, and ends it.
+func Finish(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,216 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func setTestProvider(t *testing.T, exp sdktrace.SpanExporter) {
+	prev := otel.GetTracerProvider()
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	otel.SetTracerProvider(provider)
+
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		require.NoError(t, provider.Shutdown(context.Background()))
+	})
+}
+
+func readJSONSpans(t *testing.T, buf *bytes.Buffer) []JSONSpan {
+	var res []JSONSpan
+
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var s JSONSpan
+		require.NoError(t, dec.Decode(&s))
+
+		res = append(res, s)
+	}
+
+	return res
+}
+
+func TestJSONExporter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	setTestProvider(t, NewJSONExporter(buf))
+
+	ctx, parent := StartSpan(context.Background(), "parent", attribute.String("key", "value"))
+	_, child := StartSpan(ctx, "child")
+	Finish(child, errors.New("some error"))
+	Finish(parent, nil)
+
+	spans := readJSONSpans(t, buf)
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "Error", spans[0].Status)
+	require.Equal(t, "some error", spans[0].StatusMessage)
+	require.Len(t, spans[0].Events, 1)
+
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, "Unset", spans[1].Status)
+	require.Equal(t, "value", spans[1].Attributes["key"])
+	require.Empty(t, spans[1].ParentSpanID)
+
+	require.Equal(t, spans[1].TraceID, spans[0].TraceID)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+}
+
+func TestPropagation(t *testing.T) {
+	buf := new(bytes.Buffer)
+	setTestProvider(t, NewJSONExporter(buf))
+
+	// no span, no metadata
+	ctx := InjectOutgoingContext(context.Background())
+	_, ok := metadata.FromOutgoingContext(ctx)
+	require.False(t, ok)
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "key", "value")
+	ctx, span := StartSpan(ctx, "client")
+
+	md, ok := metadata.FromOutgoingContext(InjectOutgoingContext(ctx))
+	require.True(t, ok)
+	require.Equal(t, []string{"value"}, md.Get("key"))
+	require.Len(t, md.Get("traceparent"), 1)
+
+	// the server side receives the client metadata
+	srvCtx := metadata.NewIncomingContext(context.Background(), md)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/neo.fs.v2.object.ObjectService/Head"}
+	_, err := NewUnaryServerInterceptor()(srvCtx, nil, info, func(ctx context.Context, _ interface{}) (interface{}, error) {
+		sc := trace.SpanContextFromContext(ctx)
+		require.Equal(t, span.SpanContext().TraceID(), sc.TraceID())
+		require.NotEqual(t, span.SpanContext().SpanID(), sc.SpanID())
+
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	Finish(span, nil)
+
+	spans := readJSONSpans(t, buf)
+	require.Len(t, spans, 2)
+	require.Equal(t, info.FullMethod, spans[0].Name)
+	require.Equal(t, "server", spans[0].Kind)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+}
+
+func TestOTLPExporter(t *testing.T) {
+	type received struct {
+		path, contentType string
+		spans             []*tracepb.ResourceSpans
+	}
+
+	ch := make(chan received, 2)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res received
+
+		res.path = r.URL.Path
+		res.contentType = r.Header.Get("Content-Type")
+
+		body, err := io.ReadAll(r.Body)
+		if err == nil {
+			res.spans, err = decodeOTLPRequest(body)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		ch <- res
+	}))
+	defer srv.Close()
+
+	exp, err := NewOTLPExporter(srv.URL, 0)
+	require.NoError(t, err)
+
+	setTestProvider(t, exp)
+
+	ctx, parent := StartSpan(context.Background(), "parent",
+		attribute.Int64("int", 42),
+		attribute.StringSlice("strings", []string{"a", "b"}))
+	_, child := StartSpan(ctx, "child")
+	Finish(child, errors.New("some error"))
+	Finish(parent, nil)
+
+	// spans are exported one by one by the syncer
+	childReq := <-ch
+	require.Equal(t, otlpTracesPath, childReq.path)
+	require.Equal(t, "application/x-protobuf", childReq.contentType)
+	require.Equal(t, tracepb.Status_STATUS_CODE_ERROR, childReq.spans[0].ScopeSpans[0].Spans[0].Status.Code)
+
+	req := (<-ch).spans
+	require.Len(t, req, 1)
+	require.Len(t, req[0].ScopeSpans, 1)
+	require.Equal(t, instrumentationName, req[0].ScopeSpans[0].Scope.Name)
+
+	spans := req[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 1)
+	require.Equal(t, "parent", spans[0].Name)
+	require.Equal(t, tracepb.Status_STATUS_CODE_UNSET, spans[0].Status.Code)
+
+	traceID := parent.SpanContext().TraceID()
+	require.Equal(t, traceID[:], spans[0].TraceId)
+
+	attrs := make(map[string]*commonpb.AnyValue)
+	for _, a := range spans[0].Attributes {
+		attrs[a.Key] = a.Value
+	}
+
+	require.EqualValues(t, 42, attrs["int"].GetIntValue())
+	require.Len(t, attrs["strings"].GetArrayValue().Values, 2)
+
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := NewOTLPExporter("localhost:4318", 0)
+		require.Error(t, err)
+	})
+}
+
+// decodeOTLPRequest decodes resource spans from the protobuf-encoded
+// ExportTraceServiceRequest.
+func decodeOTLPRequest(body []byte) ([]*tracepb.ResourceSpans, error) {
+	var res []*tracepb.ResourceSpans
+
+	for len(body) > 0 {
+		num, typ, n := protowire.ConsumeTag(body)
+		if n < 0 || num != 1 || typ != protowire.BytesType {
+			return nil, errors.New("invalid request field")
+		}
+		body = body[n:]
+
+		b, n := protowire.ConsumeBytes(body)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		body = body[n:]
+
+		var rs tracepb.ResourceSpans
+		if err := proto.Unmarshal(b, &rs); err != nil {
+			return nil, err
+		}
+
+		res = append(res, &rs)
+	}
+
+	return res, nil
+}